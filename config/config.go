@@ -21,6 +21,8 @@ type Config struct {
 type BrowserConfig struct {
 	Args                          string `env:"ARGS,default=[--disable-blink-features=AutomationControlled --disable-features=VizDisplayCompositor --no-first-run --disable-default-apps --disable-extensions --disable-plugins --disable-sync --disable-translate --hide-scrollbars --mute-audio --no-zygote --disable-background-timer-throttling --disable-backgrounding-occluded-windows --disable-renderer-backgrounding --disable-ipc-flooding-protection]"`
 	CDPURL                        string `env:"CDP_URL"`
+	ConsoleLogSize                string `env:"CONSOLE_LOG_SIZE,default=1000"`
+	CredentialsPath               string `env:"CREDENTIALS_PATH"`
 	DataDir                       string `env:"DATA_DIR,default=/tmp/playwright/artifacts"`
 	Engine                        string `env:"ENGINE,default=chromium"`
 	HeaderAccept                  string `env:"HEADER_ACCEPT,default=text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8,application/signed-exchange;v=b3;q=0.7"`
@@ -30,8 +32,23 @@ type BrowserConfig struct {
 	HeaderDnt                     string `env:"HEADER_DNT,default=1"`
 	HeaderUpgradeInsecureRequests string `env:"HEADER_UPGRADE_INSECURE_REQUESTS,default=1"`
 	Headless                      bool   `env:"HEADLESS,default=true"`
+	NetworkLogSize                string `env:"NETWORK_LOG_SIZE,default=500"`
+	PoolMaxContexts               string `env:"POOL_MAX_CONTEXTS,default=50"`
+	PoolSize                      string `env:"POOL_SIZE,default=2"`
+	ProxyAllowed                  string `env:"PROXY_ALLOWED"`
+	ProxyBypass                   string `env:"PROXY_BYPASS"`
+	ProxyPassword                 string `env:"PROXY_PASSWORD"`
+	ProxyRotation                 string `env:"PROXY_ROTATION"`
+	ProxyServer                   string `env:"PROXY_SERVER"`
+	ProxyUsername                 string `env:"PROXY_USERNAME"`
 	SessionTimeout                string `env:"SESSION_TIMEOUT,default=2m"`
+	StateEncryptionKey            string `env:"STATE_ENCRYPTION_KEY"`
+	StateEncryptionKeyFile        string `env:"STATE_ENCRYPTION_KEY_FILE"`
+	StateEncryptionPreviousKeys   string `env:"STATE_ENCRYPTION_PREVIOUS_KEYS"`
 	StealthMode                   bool   `env:"STEALTH_MODE,default=false"`
+	TaskSessionCloseOn            string `env:"TASK_SESSION_CLOSE_ON,default=completed,failed,canceled"`
+	TaskSessionPersist            bool   `env:"TASK_SESSION_PERSIST,default=false"`
+	TaskSessionProfile            string `env:"TASK_SESSION_PROFILE"`
 	UserAgent                     string `env:"USER_AGENT,default=Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Safari/537.36"`
 	ViewportHeight                string `env:"VIEWPORT_HEIGHT,default=1080"`
 	ViewportWidth                 string `env:"VIEWPORT_WIDTH,default=1920"`
@@ -0,0 +1,708 @@
+// Package markdown converts rendered HTML into Markdown that reads well for
+// a language model: page chrome such as navigation, footers and scripts is
+// dropped, links keep their targets and tables stay tables.
+package markdown
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	html "golang.org/x/net/html"
+	atom "golang.org/x/net/html/atom"
+)
+
+// Options controls Convert
+type Options struct {
+	// BaseURL resolves relative link and image targets
+	BaseURL string
+	// Readability keeps only the main content of the document, picked the
+	// way reader modes do, instead of the whole body
+	Readability bool
+	// Images renders images as ![alt](src); otherwise they are dropped
+	Images bool
+	// Fragment marks source as the markup of an element the caller picked,
+	// which is kept even when it looks like page chrome
+	Fragment bool
+}
+
+// Document is the result of a conversion
+type Document struct {
+	Title    string
+	Markdown string
+}
+
+var (
+	// unlikelyCandidates matches class and id values of page chrome, after
+	// the list used by Mozilla's Readability
+	unlikelyCandidates = regexp.MustCompile(`(?i)-ad-|\bads?\b|advert|agegate|banner|breadcrumb|combx|comment|community|consent|cookie|disqus|footer|gdpr|header|menu|modal|nav|newsletter|pager|pagination|popup|promo|related|remark|replies|rss|share|shoutbox|sidebar|skip|social|sponsor|subscribe|toolbar`)
+	// maybeCandidate rescues elements whose class or id also marks content
+	maybeCandidate = regexp.MustCompile(`(?i)and|article|body|column|content|main|shadow`)
+	whitespace     = regexp.MustCompile(`[ \t\r\n\f]+`)
+	blankLines     = regexp.MustCompile(`\n{3,}`)
+)
+
+// noiseTags never carry readable content
+var noiseTags = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Noscript: true,
+	atom.Template: true,
+	atom.Svg:      true,
+	atom.Canvas:   true,
+	atom.Iframe:   true,
+	atom.Object:   true,
+	atom.Embed:    true,
+	atom.Nav:      true,
+	atom.Footer:   true,
+	atom.Aside:    true,
+	atom.Form:     true,
+	atom.Button:   true,
+	atom.Input:    true,
+	atom.Select:   true,
+	atom.Textarea: true,
+	atom.Dialog:   true,
+	atom.Head:     true,
+}
+
+// noiseRoles are ARIA landmark and widget roles of page chrome
+var noiseRoles = map[string]bool{
+	"banner":        true,
+	"complementary": true,
+	"contentinfo":   true,
+	"dialog":        true,
+	"alertdialog":   true,
+	"menu":          true,
+	"menubar":       true,
+	"navigation":    true,
+	"search":        true,
+	"toolbar":       true,
+}
+
+// Convert parses an HTML document or fragment and renders it as Markdown
+func Convert(source string, options Options) (*Document, error) {
+	root, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse HTML: %w", err)
+	}
+
+	var base *url.URL
+	if options.BaseURL != "" {
+		if base, err = url.Parse(options.BaseURL); err != nil {
+			return nil, fmt.Errorf("invalid base URL %q: %w", options.BaseURL, err)
+		}
+	}
+
+	title := documentTitle(root)
+	body := findFirst(root, func(n *html.Node) bool { return n.DataAtom == atom.Body })
+	if body == nil {
+		body = root
+	}
+
+	if options.Fragment {
+		for child := body.FirstChild; child != nil; child = child.NextSibling {
+			removeNoise(child)
+		}
+	} else {
+		removeNoise(body)
+	}
+	content := body
+	if options.Readability {
+		content = mainContent(body)
+	}
+
+	r := &renderer{base: base, images: options.Images}
+	text := tidy(r.children(content))
+	if title == "" {
+		if h1 := findFirst(content, func(n *html.Node) bool { return n.DataAtom == atom.H1 }); h1 != nil {
+			title = collapse(textContent(h1))
+		}
+	}
+
+	return &Document{Title: title, Markdown: text}, nil
+}
+
+// Page returns the part of markdown starting offset characters in and at
+// most maxChars long, and the offset of the part after it, or -1 when the
+// end was reached. Where possible the cut is made at a paragraph break, line
+// break or space in the last fifth of the window, so parts do not end
+// mid-sentence or mid-word.
+func Page(markdown string, offset, maxChars int) (string, int) {
+	runes := []rune(markdown)
+	if offset >= len(runes) {
+		return "", -1
+	}
+	offset = max(offset, 0)
+	end := offset + maxChars
+	if maxChars <= 0 || end >= len(runes) {
+		return string(runes[offset:]), -1
+	}
+
+	window := string(runes[offset:end])
+	floor := len(window) * 4 / 5
+	for _, sep := range []string{"\n\n", "\n", " "} {
+		if cut := strings.LastIndex(window, sep); cut >= floor {
+			window = window[:cut+len(sep)]
+			break
+		}
+	}
+	return window, offset + len([]rune(window))
+}
+
+// documentTitle returns the text of the <title> element
+func documentTitle(root *html.Node) string {
+	title := findFirst(root, func(n *html.Node) bool { return n.DataAtom == atom.Title })
+	if title == nil {
+		return ""
+	}
+	return collapse(textContent(title))
+}
+
+// removeNoise deletes page chrome and hidden elements below root
+func removeNoise(root *html.Node) {
+	for child := root.FirstChild; child != nil; {
+		next := child.NextSibling
+		switch {
+		case child.Type == html.CommentNode:
+			root.RemoveChild(child)
+		case child.Type == html.ElementNode && isNoise(child):
+			root.RemoveChild(child)
+		default:
+			removeNoise(child)
+		}
+		child = next
+	}
+}
+
+// isNoise reports whether an element is chrome rather than content
+func isNoise(n *html.Node) bool {
+	if noiseTags[n.DataAtom] {
+		return true
+	}
+	if n.DataAtom == atom.Header && !hasAncestor(n, atom.Article, atom.Main) {
+		return true
+	}
+	if _, hidden := attr(n, "hidden"); hidden {
+		return true
+	}
+	if value, _ := attr(n, "aria-hidden"); value == "true" {
+		return true
+	}
+	if style, _ := attr(n, "style"); style != "" {
+		compact := strings.ReplaceAll(strings.ToLower(style), " ", "")
+		if strings.Contains(compact, "display:none") || strings.Contains(compact, "visibility:hidden") {
+			return true
+		}
+	}
+	if role, _ := attr(n, "role"); noiseRoles[role] {
+		return true
+	}
+
+	// Class and id hints are only trusted on generic containers
+	switch n.DataAtom {
+	case atom.Div, atom.Section, atom.Span, atom.Ul, atom.Ol, atom.Li:
+	default:
+		return false
+	}
+	class, _ := attr(n, "class")
+	id, _ := attr(n, "id")
+	hint := class + " " + id
+	return unlikelyCandidates.MatchString(hint) && !maybeCandidate.MatchString(hint)
+}
+
+// mainContent picks the element holding the main content: an explicit
+// <main> or role=main, a lone <article>, or else the container that
+// paragraph scoring favours. Falls back to body when nothing stands out.
+func mainContent(body *html.Node) *html.Node {
+	if main := findFirst(body, func(n *html.Node) bool {
+		role, _ := attr(n, "role")
+		return n.DataAtom == atom.Main || role == "main"
+	}); main != nil {
+		return main
+	}
+
+	articles := findAll(body, func(n *html.Node) bool { return n.DataAtom == atom.Article })
+	if len(articles) == 1 {
+		return articles[0]
+	}
+
+	// Each paragraph scores its parent fully and its grandparent by half;
+	// longer paragraphs and those with more commas count for more.
+	scores := map[*html.Node]float64{}
+	for _, p := range findAll(body, func(n *html.Node) bool {
+		return n.DataAtom == atom.P || n.DataAtom == atom.Pre || n.DataAtom == atom.Td || n.DataAtom == atom.Blockquote
+	}) {
+		text := collapse(textContent(p))
+		if len(text) < 25 {
+			continue
+		}
+		score := 1 + float64(strings.Count(text, ",")) + min(float64(len(text))/100, 3)
+		if parent := p.Parent; parent != nil && parent != body.Parent {
+			scores[parent] += score
+			if grandparent := parent.Parent; grandparent != nil && grandparent != body.Parent {
+				scores[grandparent] += score / 2
+			}
+		}
+	}
+
+	var (
+		best      *html.Node
+		bestScore float64
+	)
+	for _, candidate := range findAll(body, func(n *html.Node) bool { return scores[n] > 0 }) {
+		if scores[candidate] > bestScore {
+			best, bestScore = candidate, scores[candidate]
+		}
+	}
+	if best == nil || bestScore < 10 {
+		return body
+	}
+	return gatherSiblings(best, scores, bestScore)
+}
+
+// gatherSiblings collects the best candidate together with the siblings
+// that belong to the same content: headings such as the article title,
+// well-scored containers and long paragraphs.
+func gatherSiblings(best *html.Node, scores map[*html.Node]float64, bestScore float64) *html.Node {
+	parent := best.Parent
+	if parent == nil {
+		return best
+	}
+
+	container := &html.Node{Type: html.ElementNode, DataAtom: atom.Div, Data: "div"}
+	threshold := max(10, bestScore/5)
+	for sibling := parent.FirstChild; sibling != nil; {
+		next := sibling.NextSibling
+		keep := sibling == best || scores[sibling] >= threshold
+		if !keep && sibling.Type == html.ElementNode {
+			switch sibling.DataAtom {
+			case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+				keep = true
+			case atom.P:
+				keep = len(collapse(textContent(sibling))) >= 80
+			}
+		}
+		if keep {
+			parent.RemoveChild(sibling)
+			container.AppendChild(sibling)
+		}
+		sibling = next
+	}
+	return container
+}
+
+// renderer writes Markdown for a cleaned node tree
+type renderer struct {
+	base   *url.URL
+	images bool
+}
+
+func (r *renderer) children(n *html.Node) string {
+	var b strings.Builder
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		b.WriteString(r.node(child))
+	}
+	return b.String()
+}
+
+func (r *renderer) node(n *html.Node) string {
+	switch n.Type {
+	case html.TextNode:
+		return whitespace.ReplaceAllString(n.Data, " ")
+	case html.ElementNode:
+	default:
+		return r.children(n)
+	}
+
+	switch n.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		text := inline(r.children(n))
+		if text == "" {
+			return ""
+		}
+		level := int(n.Data[1] - '0')
+		return block(strings.Repeat("#", level) + " " + text)
+
+	case atom.P, atom.Div, atom.Section, atom.Article, atom.Main, atom.Header,
+		atom.Figure, atom.Figcaption, atom.Address, atom.Details, atom.Summary,
+		atom.Center, atom.Fieldset, atom.Caption:
+		return block(strings.TrimSpace(r.children(n)))
+
+	case atom.Br:
+		return "\n"
+
+	case atom.Hr:
+		return block("---")
+
+	case atom.A:
+		return r.link(n)
+
+	case atom.Img:
+		return r.image(n)
+
+	case atom.Strong, atom.B:
+		return wrapInline(r.children(n), "**")
+
+	case atom.Em, atom.I:
+		return wrapInline(r.children(n), "*")
+
+	case atom.Del, atom.S, atom.Strike:
+		return wrapInline(r.children(n), "~~")
+
+	case atom.Code, atom.Kbd, atom.Samp:
+		text := collapse(textContent(n))
+		if text == "" {
+			return ""
+		}
+		fence := "`"
+		if strings.Contains(text, "`") {
+			fence = "``"
+		}
+		return fence + text + fence
+
+	case atom.Pre:
+		code := strings.Trim(textContent(n), "\n")
+		if strings.TrimSpace(code) == "" {
+			return ""
+		}
+		return block("```\n" + code + "\n```")
+
+	case atom.Blockquote:
+		text := tidy(r.children(n))
+		if text == "" {
+			return ""
+		}
+		lines := strings.Split(text, "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight("> "+line, " ")
+		}
+		return block(strings.Join(lines, "\n"))
+
+	case atom.Ul, atom.Ol:
+		return r.list(n)
+
+	case atom.Li:
+		// A list item outside a list
+		return block("- " + inline(r.children(n)))
+
+	case atom.Dt:
+		return block("**" + inline(r.children(n)) + "**")
+
+	case atom.Dd:
+		return block(strings.TrimSpace(r.children(n)))
+
+	case atom.Table:
+		return r.table(n)
+	}
+
+	return r.children(n)
+}
+
+// link renders an anchor as [text](href), or as plain text when the target
+// is not a navigable URL
+func (r *renderer) link(n *html.Node) string {
+	text := inline(r.children(n))
+	if text == "" {
+		text = linkLabel(n)
+	}
+	if text == "" {
+		return ""
+	}
+	href, _ := attr(n, "href")
+	target := r.resolve(href)
+	if target == "" || strings.HasPrefix(target, "#") {
+		return text
+	}
+	return "[" + strings.ReplaceAll(text, "]", `\]`) + "](" + escapeURL(target) + ")"
+}
+
+// linkLabel names a link without text content, such as an icon or image
+// link, from its aria-label, title or image alt text
+func linkLabel(n *html.Node) string {
+	for _, key := range []string{"aria-label", "title"} {
+		if label, _ := attr(n, key); label != "" {
+			return collapse(label)
+		}
+	}
+	if img := findFirst(n, func(m *html.Node) bool { return m.DataAtom == atom.Img }); img != nil {
+		alt, _ := attr(img, "alt")
+		return collapse(alt)
+	}
+	return ""
+}
+
+// image renders an image as ![alt](src). Inline data URIs are dropped since
+// they cost far more than they tell.
+func (r *renderer) image(n *html.Node) string {
+	if !r.images {
+		return ""
+	}
+	alt, _ := attr(n, "alt")
+	alt = collapse(alt)
+	src, _ := attr(n, "src")
+	target := r.resolve(src)
+	if target == "" {
+		if alt == "" {
+			return ""
+		}
+		return "![" + alt + "]"
+	}
+	return "![" + alt + "](" + escapeURL(target) + ")"
+}
+
+// resolve makes a link target absolute. Script and data URLs resolve to "".
+func (r *renderer) resolve(ref string) string {
+	ref = strings.TrimSpace(ref)
+	lower := strings.ToLower(ref)
+	if ref == "" || strings.HasPrefix(lower, "javascript:") || strings.HasPrefix(lower, "data:") {
+		return ""
+	}
+	if strings.HasPrefix(ref, "#") || r.base == nil {
+		return ref
+	}
+	parsed, err := url.Parse(ref)
+	if err != nil {
+		return ref
+	}
+	return r.base.ResolveReference(parsed).String()
+}
+
+// list renders ul and ol items with nested lists indented under their item
+func (r *renderer) list(n *html.Node) string {
+	ordered := n.DataAtom == atom.Ol
+	number := 1
+	if start, ok := attr(n, "start"); ok && ordered {
+		if _, err := fmt.Sscanf(start, "%d", &number); err != nil {
+			number = 1
+		}
+	}
+
+	var items []string
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type != html.ElementNode || child.DataAtom != atom.Li {
+			continue
+		}
+		text := tidy(r.children(child))
+		if text == "" {
+			continue
+		}
+		// Paragraph breaks inside an item would end the list
+		text = blankLines.ReplaceAllString(strings.ReplaceAll(text, "\n\n", "\n"), "\n")
+
+		marker := "- "
+		if ordered {
+			marker = fmt.Sprintf("%d. ", number)
+			number++
+		}
+		indent := strings.Repeat(" ", len(marker))
+		lines := strings.Split(text, "\n")
+		for i := range lines {
+			if i == 0 {
+				lines[i] = marker + lines[i]
+			} else if lines[i] != "" {
+				lines[i] = indent + lines[i]
+			}
+		}
+		items = append(items, strings.Join(lines, "\n"))
+	}
+	if len(items) == 0 {
+		return ""
+	}
+	return block(strings.Join(items, "\n"))
+}
+
+// table renders a table as a Markdown table with its first row as header.
+// Single-column tables are layout rather than data and render as blocks.
+func (r *renderer) table(n *html.Node) string {
+	var rows [][]string
+	columns := 0
+	for _, tr := range findAll(n, func(m *html.Node) bool {
+		return m.DataAtom == atom.Tr && closestTable(m) == n
+	}) {
+		var cells []string
+		for cell := tr.FirstChild; cell != nil; cell = cell.NextSibling {
+			if cell.Type != html.ElementNode || (cell.DataAtom != atom.Td && cell.DataAtom != atom.Th) {
+				continue
+			}
+			text := strings.ReplaceAll(inline(r.children(cell)), "|", `\|`)
+			cells = append(cells, text)
+			if span, ok := attr(cell, "colspan"); ok {
+				var extra int
+				if _, err := fmt.Sscanf(span, "%d", &extra); err == nil {
+					for i := 1; i < min(extra, 50); i++ {
+						cells = append(cells, "")
+					}
+				}
+			}
+		}
+		if len(cells) == 0 {
+			continue
+		}
+		rows = append(rows, cells)
+		columns = max(columns, len(cells))
+	}
+
+	if len(rows) == 0 {
+		return ""
+	}
+	if columns == 1 {
+		return block(strings.TrimSpace(r.children(n)))
+	}
+
+	var b strings.Builder
+	if caption := findFirst(n, func(m *html.Node) bool { return m.DataAtom == atom.Caption }); caption != nil {
+		if text := inline(r.children(caption)); text != "" {
+			b.WriteString("**" + text + "**\n\n")
+		}
+	}
+	writeRow := func(cells []string) {
+		b.WriteString("|")
+		for i := range columns {
+			cell := ""
+			if i < len(cells) {
+				cell = cells[i]
+			}
+			b.WriteString(" " + cell + " |")
+		}
+		b.WriteString("\n")
+	}
+	writeRow(rows[0])
+	b.WriteString("|" + strings.Repeat(" --- |", columns) + "\n")
+	for _, row := range rows[1:] {
+		writeRow(row)
+	}
+	return block(strings.TrimRight(b.String(), "\n"))
+}
+
+// closestTable returns the nearest table enclosing n
+func closestTable(n *html.Node) *html.Node {
+	for parent := n.Parent; parent != nil; parent = parent.Parent {
+		if parent.DataAtom == atom.Table {
+			return parent
+		}
+	}
+	return nil
+}
+
+// block surrounds text with blank lines
+func block(text string) string {
+	if text == "" {
+		return ""
+	}
+	return "\n\n" + text + "\n\n"
+}
+
+// inline flattens rendered content onto one line
+func inline(text string) string {
+	return collapse(strings.ReplaceAll(text, "\n", " "))
+}
+
+// wrapInline puts markers around text, keeping surrounding spaces outside
+// them as Markdown requires
+func wrapInline(text, marker string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	if strings.Contains(trimmed, "\n") {
+		return text
+	}
+	leading := text[:len(text)-len(strings.TrimLeft(text, " "))]
+	trailing := text[len(strings.TrimRight(text, " ")):]
+	return leading + marker + trimmed + marker + trailing
+}
+
+// tidy normalises whitespace between blocks: lines lose trailing spaces and
+// the single space left over from collapsed source indentation, and runs of
+// blank lines shrink to one. Fenced code is left as it is.
+func tidy(text string) string {
+	lines := strings.Split(text, "\n")
+	inFence := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			lines[i] = strings.TrimSpace(line)
+			continue
+		}
+		if inFence {
+			continue
+		}
+		line = strings.TrimRight(line, " ")
+		if strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "  ") {
+			line = line[1:]
+		}
+		if strings.TrimSpace(line) == "" {
+			line = ""
+		}
+		lines[i] = line
+	}
+	return strings.TrimSpace(blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
+
+// escapeURL keeps a link target from ending the Markdown link early
+func escapeURL(target string) string {
+	return strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29").Replace(target)
+}
+
+func collapse(text string) string {
+	return strings.TrimSpace(whitespace.ReplaceAllString(text, " "))
+}
+
+func textContent(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var b strings.Builder
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		b.WriteString(textContent(child))
+	}
+	return b.String()
+}
+
+func attr(n *html.Node, key string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return strings.TrimSpace(a.Val), true
+		}
+	}
+	return "", false
+}
+
+func hasAncestor(n *html.Node, tags ...atom.Atom) bool {
+	for parent := n.Parent; parent != nil; parent = parent.Parent {
+		for _, tag := range tags {
+			if parent.DataAtom == tag {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// findFirst returns the first element below n, in document order, that
+// satisfies match
+func findFirst(n *html.Node, match func(*html.Node) bool) *html.Node {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.ElementNode && match(child) {
+			return child
+		}
+		if found := findFirst(child, match); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// findAll returns every element below n, in document order, that satisfies
+// match
+func findAll(n *html.Node, match func(*html.Node) bool) []*html.Node {
+	var found []*html.Node
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.ElementNode && match(child) {
+			found = append(found, child)
+		}
+		found = append(found, findAll(child, match)...)
+	}
+	return found
+}
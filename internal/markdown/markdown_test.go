@@ -0,0 +1,183 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+const articlePage = `<!doctype html>
+<html><head><title>Release notes</title><style>body{color:red}</style></head>
+<body>
+  <header><a href="/">Home</a> <a href="/blog">Blog</a></header>
+  <nav><ul><li><a href="/docs">Docs</a></li></ul></nav>
+  <div class="cookie-banner">We use cookies</div>
+  <main>
+    <h1>Version 2.0</h1>
+    <p>The <strong>new engine</strong> is faster. See the <a href="/changelog#v2">changelog</a>
+       or the <a href="javascript:void(0)">interactive demo</a>.</p>
+    <ul>
+      <li>Parallel tabs
+        <ul><li>Popups are followed</li></ul>
+      </li>
+      <li>Snapshots</li>
+    </ul>
+    <pre><code>go install ./...
+  indented</code></pre>
+    <table>
+      <thead><tr><th>Engine</th><th>Time | s</th></tr></thead>
+      <tbody><tr><td>chromium</td><td>1.2</td></tr><tr><td colspan="2">n/a</td></tr></tbody>
+    </table>
+    <div style="display: none">hidden text</div>
+    <script>track()</script>
+  </main>
+  <footer>Copyright</footer>
+</body></html>`
+
+func TestConvertReadableContent(t *testing.T) {
+	doc, err := Convert(articlePage, Options{BaseURL: "https://example.com/news/", Readability: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Release notes", doc.Title)
+	expected := strings.Join([]string{
+		"# Version 2.0",
+		"",
+		"The **new engine** is faster. See the [changelog](https://example.com/changelog#v2) or the interactive demo.",
+		"",
+		"- Parallel tabs",
+		"  - Popups are followed",
+		"- Snapshots",
+		"",
+		"```",
+		"go install ./...",
+		"  indented",
+		"```",
+		"",
+		"| Engine | Time \\| s |",
+		"| --- | --- |",
+		"| chromium | 1.2 |",
+		"| n/a |  |",
+	}, "\n")
+	assert.Equal(t, expected, doc.Markdown)
+}
+
+func TestConvertWholePageDropsChrome(t *testing.T) {
+	doc, err := Convert(articlePage, Options{})
+	require.NoError(t, err)
+
+	assert.Contains(t, doc.Markdown, "# Version 2.0")
+	for _, noise := range []string{"Home", "Docs", "cookies", "Copyright", "track()", "hidden text", "color:red"} {
+		assert.NotContains(t, doc.Markdown, noise)
+	}
+	assert.Contains(t, doc.Markdown, "[changelog](/changelog#v2)", "without a base URL links stay as written")
+}
+
+func TestConvertScoresContentWithoutLandmarks(t *testing.T) {
+	paragraph := "<p>Browsers render pages, run scripts, and lay out text, which is why a reader view needs the DOM after rendering.</p>"
+	page := `<body>
+		<div class="sidebar"><p>Popular posts, trending tags, and more links you did not ask for.</p></div>
+		<div id="links"><a href="/a">A</a> <a href="/b">B</a></div>
+		<div><h1>Why render first</h1><div class="entry">` + strings.Repeat(paragraph, 4) + `</div></div>
+	</body>`
+
+	doc, err := Convert(page, Options{Readability: true})
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(doc.Markdown, "# Why render first\n\nBrowsers render pages"), doc.Markdown)
+	assert.NotContains(t, doc.Markdown, "Popular posts")
+	assert.NotContains(t, doc.Markdown, "[A]")
+	assert.Equal(t, "Why render first", doc.Title, "falls back to the first heading")
+}
+
+func TestConvertFragmentKeepsSelectedElement(t *testing.T) {
+	doc, err := Convert(`<nav><a href="/docs">Docs</a><script>x()</script></nav>`, Options{Fragment: true})
+	require.NoError(t, err)
+	assert.Equal(t, "[Docs](/docs)", doc.Markdown)
+}
+
+func TestConvertInlineElements(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		options  Options
+		expected string
+	}{
+		{
+			name:     "image link uses alt text",
+			html:     `<a href="/home"><img src="/logo.png" alt="Acme"></a>`,
+			expected: "[Acme](/home)",
+		},
+		{
+			name:     "images rendered when enabled",
+			html:     `<p><img src="chart.png" alt="Sales chart"></p>`,
+			options:  Options{BaseURL: "https://example.com/r/", Images: true},
+			expected: "![Sales chart](https://example.com/r/chart.png)",
+		},
+		{
+			name:     "data URI images keep only alt text",
+			html:     `<img src="data:image/png;base64,AAAA" alt="dot">`,
+			options:  Options{Images: true},
+			expected: "![dot]",
+		},
+		{
+			name:     "emphasis keeps spaces outside markers",
+			html:     `<p>a<em> very </em>big <code>deal</code></p>`,
+			expected: "a *very* big `deal`",
+		},
+		{
+			name:     "ordered list honours start",
+			html:     `<ol start="3"><li>three</li><li>four</li></ol>`,
+			expected: "3. three\n4. four",
+		},
+		{
+			name:     "blockquote",
+			html:     `<blockquote><p>one</p><p>two</p></blockquote>`,
+			expected: "> one\n>\n> two",
+		},
+		{
+			name:     "layout table renders as blocks",
+			html:     `<table><tr><td><p>only cell</p></td></tr></table>`,
+			expected: "only cell",
+		},
+		{
+			name:     "link target with spaces and parentheses",
+			html:     `<a href="https://en.wikipedia.org/wiki/Go_(language) x">Go</a>`,
+			expected: "[Go](https://en.wikipedia.org/wiki/Go_%28language%29%20x)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Convert(tt.html, tt.options)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, doc.Markdown)
+		})
+	}
+}
+
+func TestPage(t *testing.T) {
+	first := strings.Repeat("a", 45) + "\n\n"
+	text := first + "second paragraph"
+
+	chunk, next := Page(text, 0, 50)
+	assert.Equal(t, first, chunk, "cut at the paragraph break")
+	assert.Equal(t, 47, next)
+
+	chunk, next = Page(text, next, 50)
+	assert.Equal(t, "second paragraph", chunk)
+	assert.Equal(t, -1, next)
+
+	chunk, next = Page(text, 0, 10)
+	assert.Equal(t, "aaaaaaaaaa", chunk, "no break near the end of the window")
+	assert.Equal(t, 10, next)
+
+	chunk, next = Page(text, 100, 30)
+	assert.Empty(t, chunk)
+	assert.Equal(t, -1, next)
+
+	chunk, next = Page("ünïcödé text", 0, 5)
+	assert.Equal(t, "ünïcö", chunk, "offsets count characters, not bytes")
+	assert.Equal(t, 5, next)
+}
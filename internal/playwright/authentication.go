@@ -0,0 +1,404 @@
+package playwright
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+)
+
+// DefaultAuthenticationTimeout bounds each step of a login flow when no timeout is given
+const DefaultAuthenticationTimeout = 30 * time.Second
+
+// AuthenticationOptions describes a login to perform in a browser session.
+// Which fields are used depends on Type:
+//
+//   - "basic" sets HTTP credentials on a fresh context. LoginURL, when given,
+//     only scopes the credentials to that origin; nothing is navigated.
+//   - "form" opens LoginURL (or uses the current page), fills the username
+//     and password selectors and submits.
+//   - "oauth" runs an authorization-code redirect flow, see OAuthOptions.
+//
+// SuccessSelector and SuccessURLPattern are optional checks that the login
+// actually worked. SuccessURLPattern is a glob, or a regular expression when
+// wrapped in slashes (e.g. "/dashboard|home/").
+type AuthenticationOptions struct {
+	Type              string
+	Username          string
+	Password          string
+	LoginURL          string
+	UsernameSelector  string
+	PasswordSelector  string
+	SubmitSelector    string
+	SuccessSelector   string
+	SuccessURLPattern string
+	Timeout           time.Duration
+	OAuth             OAuthOptions
+}
+
+// OAuthOptions configures an OAuth 2.0 authorization-code flow. When
+// AuthorizeURL is set the request to the provider is built from it,
+// ClientID, RedirectURI and Scope, with a random state that is checked on
+// the way back. Without it the flow is started by the application at
+// LoginURL. Either way the flow completes when the browser is redirected to
+// RedirectURI with an authorization code.
+type OAuthOptions struct {
+	AuthorizeURL    string
+	ClientID        string
+	RedirectURI     string
+	Scope           string
+	ConsentSelector string
+}
+
+// AuthenticationResult reports the outcome of HandleAuthentication.
+// Verified is true only when a success check (or the OAuth redirect) confirmed
+// the login; a submitted form without a success check is not verified.
+type AuthenticationResult struct {
+	Type     string `json:"type"`
+	Verified bool   `json:"verified"`
+	FinalURL string `json:"final_url,omitempty"`
+	Message  string `json:"message"`
+}
+
+// HandleAuthentication performs a basic, form or OAuth login in the session
+func (p *playwrightImpl) HandleAuthentication(ctx context.Context, sessionID string, options AuthenticationOptions) (*AuthenticationResult, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.Timeout <= 0 {
+		options.Timeout = DefaultAuthenticationTimeout
+	}
+
+	p.logger.Info("handling authentication",
+		zap.String("sessionID", sessionID),
+		zap.String("type", options.Type),
+		zap.String("loginURL", options.LoginURL))
+
+	switch options.Type {
+	case "basic":
+		return p.basicAuthentication(session, options)
+	case "form":
+		return p.formAuthentication(session, options)
+	case "oauth":
+		return p.oauthAuthentication(ctx, session, options)
+	default:
+		return nil, fmt.Errorf("unsupported authentication type: %s", options.Type)
+	}
+}
+
+// basicAuthentication recreates the session's context with HTTP credentials.
+// Playwright only accepts credentials at context creation, so cookies and
+// storage of the previous context are lost.
+func (p *playwrightImpl) basicAuthentication(session *BrowserSession, options AuthenticationOptions) (*AuthenticationResult, error) {
+	if options.Username == "" {
+		return nil, fmt.Errorf("basic auth requires a username")
+	}
+
+	credentials := &playwright.HttpCredentials{
+		Username: options.Username,
+		Password: options.Password,
+	}
+
+	message := "HTTP credentials set for all origins"
+	if options.LoginURL != "" {
+		origin, err := originOf(options.LoginURL)
+		if err != nil {
+			return nil, err
+		}
+		credentials.Origin = &origin
+		message = fmt.Sprintf("HTTP credentials set for %s", origin)
+	}
+
+	err := p.recreateContext(session, func(opts *playwright.BrowserNewContextOptions) {
+		opts.HttpCredentials = credentials
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply HTTP credentials: %w", err)
+	}
+
+	return &AuthenticationResult{
+		Type:    "basic",
+		Message: message + "; they are sent on the next navigation",
+	}, nil
+}
+
+// formAuthentication fills and submits a login form, then runs the success checks
+func (p *playwrightImpl) formAuthentication(session *BrowserSession, options AuthenticationOptions) (*AuthenticationResult, error) {
+	page := session.ActivePage()
+	timeoutMs := float64(options.Timeout.Milliseconds())
+
+	if options.LoginURL != "" {
+		if _, err := page.Goto(options.LoginURL, playwright.PageGotoOptions{Timeout: &timeoutMs}); err != nil {
+			return nil, fmt.Errorf("failed to navigate to login URL: %w", err)
+		}
+	}
+
+	if err := fillLoginForm(page, options, timeoutMs); err != nil {
+		return nil, err
+	}
+
+	result := &AuthenticationResult{Type: "form"}
+	if err := p.verifyLogin(page, options, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// oauthAuthentication drives an authorization-code flow until the provider
+// redirects back to the redirect URI, then runs the success checks against
+// wherever the application lands.
+func (p *playwrightImpl) oauthAuthentication(ctx context.Context, session *BrowserSession, options AuthenticationOptions) (*AuthenticationResult, error) {
+	oauth := options.OAuth
+	if oauth.RedirectURI == "" {
+		return nil, fmt.Errorf("oauth requires a redirect URI to detect the end of the flow")
+	}
+
+	var startURL, state string
+	switch {
+	case oauth.AuthorizeURL != "":
+		if oauth.ClientID == "" {
+			return nil, fmt.Errorf("oauth requires a client ID when an authorize URL is given")
+		}
+		var err error
+		state, err = randomState()
+		if err != nil {
+			return nil, err
+		}
+		startURL, err = buildAuthorizeURL(oauth, state)
+		if err != nil {
+			return nil, err
+		}
+	case options.LoginURL != "":
+		startURL = options.LoginURL
+	default:
+		return nil, fmt.Errorf("oauth requires an authorize URL or a login URL that starts the flow")
+	}
+
+	// Watch requests rather than committed URLs: applications usually answer
+	// the callback with another redirect, so the page may never show it. The
+	// listener is on the context so a provider opened in a popup is seen too.
+	page := session.ActivePage()
+	redirected := make(chan string, 1)
+	onRequest := func(request playwright.Request) {
+		if strings.HasPrefix(request.URL(), oauth.RedirectURI) {
+			select {
+			case redirected <- request.URL():
+			default:
+			}
+		}
+	}
+	session.Context.OnRequest(onRequest)
+	defer session.Context.RemoveListener("request", onRequest)
+
+	timeoutMs := float64(options.Timeout.Milliseconds())
+	if _, err := page.Goto(startURL, playwright.PageGotoOptions{Timeout: &timeoutMs}); err != nil {
+		return nil, fmt.Errorf("failed to open OAuth authorization page: %w", err)
+	}
+
+	// A provider with an existing session may redirect straight back. If the
+	// app opened the provider in a popup, that popup is now the active tab.
+	if !hasRedirected(redirected) {
+		page = session.ActivePage()
+		if options.UsernameSelector != "" || options.PasswordSelector != "" {
+			if err := fillLoginForm(page, options, timeoutMs); err != nil {
+				return nil, fmt.Errorf("failed to sign in at OAuth provider: %w", err)
+			}
+		}
+		if oauth.ConsentSelector != "" && !hasRedirected(redirected) {
+			err := page.Locator(oauth.ConsentSelector).First().Click(playwright.LocatorClickOptions{Timeout: &timeoutMs})
+			if err != nil && !hasRedirected(redirected) {
+				return nil, fmt.Errorf("failed to grant OAuth consent: %w", err)
+			}
+		}
+	}
+
+	var callbackURL string
+	select {
+	case callbackURL = <-redirected:
+	case <-time.After(options.Timeout):
+		return nil, fmt.Errorf("timed out after %s waiting for OAuth redirect to %s (stuck at %s)",
+			options.Timeout, oauth.RedirectURI, redactAuthorizationCode(page.URL()))
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	callback, err := url.Parse(callbackURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OAuth redirect URL: %w", err)
+	}
+	query := callback.Query()
+	if providerErr := query.Get("error"); providerErr != "" {
+		return nil, fmt.Errorf("OAuth provider returned error %q: %s", providerErr, query.Get("error_description"))
+	}
+	if query.Get("code") == "" {
+		return nil, fmt.Errorf("OAuth redirect to %s carried no authorization code", oauth.RedirectURI)
+	}
+	if state != "" && query.Get("state") != state {
+		return nil, fmt.Errorf("OAuth state mismatch on redirect, possible CSRF")
+	}
+
+	result := &AuthenticationResult{Type: "oauth", Verified: true}
+	if err := p.verifyLogin(page, options, result); err != nil {
+		return nil, err
+	}
+	if options.SuccessSelector == "" && options.SuccessURLPattern == "" {
+		result.Message = "OAuth provider redirected back with an authorization code"
+	}
+	return result, nil
+}
+
+// fillLoginForm fills whichever credential selectors are set and submits,
+// either by clicking SubmitSelector or by pressing Enter in the last field.
+func fillLoginForm(page playwright.Page, options AuthenticationOptions, timeoutMs float64) error {
+	if options.UsernameSelector == "" && options.PasswordSelector == "" {
+		return fmt.Errorf("form login requires a username or password selector")
+	}
+
+	var lastField playwright.Locator
+	if options.UsernameSelector != "" {
+		lastField = page.Locator(options.UsernameSelector).First()
+		if err := lastField.Fill(options.Username, playwright.LocatorFillOptions{Timeout: &timeoutMs}); err != nil {
+			return fmt.Errorf("failed to fill username: %w", err)
+		}
+	}
+	if options.PasswordSelector != "" {
+		lastField = page.Locator(options.PasswordSelector).First()
+		if err := lastField.Fill(options.Password, playwright.LocatorFillOptions{Timeout: &timeoutMs}); err != nil {
+			return fmt.Errorf("failed to fill password: %w", err)
+		}
+	}
+
+	if options.SubmitSelector != "" {
+		if err := page.Locator(options.SubmitSelector).First().Click(playwright.LocatorClickOptions{Timeout: &timeoutMs}); err != nil {
+			return fmt.Errorf("failed to submit form: %w", err)
+		}
+		return nil
+	}
+
+	if err := lastField.Press("Enter", playwright.LocatorPressOptions{Timeout: &timeoutMs}); err != nil {
+		return fmt.Errorf("failed to submit form: %w", err)
+	}
+	return nil
+}
+
+// verifyLogin runs the configured success checks and fills in the result.
+// With no checks configured it only waits for the page to settle, and leaves
+// Verified as it was.
+func (p *playwrightImpl) verifyLogin(page playwright.Page, options AuthenticationOptions, result *AuthenticationResult) error {
+	timeoutMs := float64(options.Timeout.Milliseconds())
+
+	if options.SuccessURLPattern != "" {
+		matcher, err := urlPattern(options.SuccessURLPattern)
+		if err != nil {
+			return err
+		}
+		if err := page.WaitForURL(matcher, playwright.PageWaitForURLOptions{Timeout: &timeoutMs}); err != nil {
+			return fmt.Errorf("login could not be verified: URL %s does not match %s: %w",
+				redactAuthorizationCode(page.URL()), options.SuccessURLPattern, err)
+		}
+	}
+
+	if options.SuccessSelector != "" {
+		err := page.Locator(options.SuccessSelector).First().WaitFor(playwright.LocatorWaitForOptions{
+			State:   playwright.WaitForSelectorStateVisible,
+			Timeout: &timeoutMs,
+		})
+		if err != nil {
+			return fmt.Errorf("login could not be verified: success selector %s did not appear: %w", options.SuccessSelector, err)
+		}
+	}
+
+	if options.SuccessURLPattern != "" || options.SuccessSelector != "" {
+		result.Verified = true
+		result.Message = "login verified"
+	} else {
+		if err := page.WaitForLoadState(playwright.PageWaitForLoadStateOptions{
+			State:   playwright.LoadStateLoad,
+			Timeout: &timeoutMs,
+		}); err != nil {
+			p.logger.Debug("page did not reach load state after login", zap.Error(err))
+		}
+		result.Message = "login form submitted; set a success selector or URL pattern to verify it"
+	}
+
+	result.FinalURL = redactAuthorizationCode(page.URL())
+	return nil
+}
+
+// urlPattern turns a user supplied URL pattern into a Playwright URL matcher:
+// a pattern wrapped in slashes is a regular expression, anything else a glob.
+func urlPattern(pattern string) (any, error) {
+	if len(pattern) > 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid URL pattern %s: %w", pattern, err)
+		}
+		return re, nil
+	}
+	return pattern, nil
+}
+
+// buildAuthorizeURL adds the authorization-code request parameters to the
+// provider's authorize endpoint, keeping any parameters it already carries.
+func buildAuthorizeURL(oauth OAuthOptions, state string) (string, error) {
+	authorize, err := url.Parse(oauth.AuthorizeURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid OAuth authorize URL: %w", err)
+	}
+
+	query := authorize.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", oauth.ClientID)
+	query.Set("redirect_uri", oauth.RedirectURI)
+	query.Set("state", state)
+	if oauth.Scope != "" {
+		query.Set("scope", oauth.Scope)
+	}
+	authorize.RawQuery = query.Encode()
+	return authorize.String(), nil
+}
+
+// redactAuthorizationCode masks the one-time code so it is not echoed back
+// to the model or written to logs.
+func redactAuthorizationCode(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	query := parsed.Query()
+	if !query.Has("code") {
+		return rawURL
+	}
+	query.Set("code", "REDACTED")
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
+
+func originOf(rawURL string) (string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return "", fmt.Errorf("invalid login URL %q: expected an absolute URL", rawURL)
+	}
+	return parsed.Scheme + "://" + parsed.Host, nil
+}
+
+func randomState() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate OAuth state: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func hasRedirected(redirected chan string) bool {
+	return len(redirected) > 0
+}
@@ -0,0 +1,212 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestURLPattern(t *testing.T) {
+	glob, err := urlPattern("**/dashboard")
+	require.NoError(t, err)
+	assert.Equal(t, "**/dashboard", glob)
+
+	re, err := urlPattern("/dashboard|home/")
+	require.NoError(t, err)
+	require.IsType(t, &regexp.Regexp{}, re)
+	assert.True(t, re.(*regexp.Regexp).MatchString("https://app.example.com/home"))
+
+	_, err = urlPattern("/[unclosed/")
+	assert.ErrorContains(t, err, "invalid URL pattern")
+}
+
+func TestBuildAuthorizeURL(t *testing.T) {
+	raw, err := buildAuthorizeURL(OAuthOptions{
+		AuthorizeURL: "https://idp.example.com/authorize?prompt=login",
+		ClientID:     "browser-agent",
+		RedirectURI:  "https://app.example.com/callback",
+		Scope:        "openid email",
+	}, "xyz")
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(raw)
+	require.NoError(t, err)
+	query := parsed.Query()
+	assert.Equal(t, "code", query.Get("response_type"))
+	assert.Equal(t, "browser-agent", query.Get("client_id"))
+	assert.Equal(t, "https://app.example.com/callback", query.Get("redirect_uri"))
+	assert.Equal(t, "openid email", query.Get("scope"))
+	assert.Equal(t, "xyz", query.Get("state"))
+	assert.Equal(t, "login", query.Get("prompt"), "existing provider parameters are kept")
+}
+
+func TestRedactAuthorizationCode(t *testing.T) {
+	assert.Equal(t, "https://app.example.com/callback?code=REDACTED&state=s",
+		redactAuthorizationCode("https://app.example.com/callback?code=secret&state=s"))
+	assert.Equal(t, "https://app.example.com/home", redactAuthorizationCode("https://app.example.com/home"))
+}
+
+func TestOriginOf(t *testing.T) {
+	origin, err := originOf("https://intranet.example.com:8443/reports?q=1")
+	require.NoError(t, err)
+	assert.Equal(t, "https://intranet.example.com:8443", origin)
+
+	_, err = originOf("/relative/path")
+	assert.Error(t, err)
+}
+
+// newStubIdentityProvider serves a minimal login form, OAuth authorize
+// endpoint and basic-auth protected page for the authentication tests.
+func newStubIdentityProvider(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+
+	loginForm := `<form method="post" action="%s">
+		<input id="user" name="user"><input id="pass" name="pass" type="password">
+		<input type="hidden" name="next" value="%s">
+		<button id="submit" type="submit">Sign in</button></form>`
+
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, _ = fmt.Fprintf(w, loginForm, "/login", "/dashboard")
+			return
+		}
+		if r.FormValue("user") != "alice" || r.FormValue("pass") != "s3cret" {
+			http.Redirect(w, r, "/login?error=1", http.StatusFound)
+			return
+		}
+		http.Redirect(w, r, r.FormValue("next"), http.StatusFound)
+	})
+	mux.HandleFunc("/dashboard", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `<h1 id="welcome">Welcome alice</h1>`)
+	})
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		callback := r.URL.Query().Get("redirect_uri") + "?code=auth-code-123&state=" + url.QueryEscape(r.URL.Query().Get("state"))
+		_, _ = fmt.Fprintf(w, loginForm, "/login", callback)
+	})
+	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		// Like a real app: exchange the code, then redirect away from the callback
+		http.Redirect(w, r, "/dashboard", http.StatusFound)
+	})
+	mux.HandleFunc("/protected", func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "alice" || pass != "s3cret" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="stub"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = fmt.Fprint(w, `<h1 id="welcome">Protected content</h1>`)
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestHandleAuthenticationFlows(t *testing.T) {
+	idp := newStubIdentityProvider(t)
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium"},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	newSession := func(t *testing.T) *BrowserSession {
+		ctx := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name()})
+		session, err := service.GetOrCreateTaskSession(ctx)
+		require.NoError(t, err)
+		return session
+	}
+
+	t.Run("form login verified by selector and URL", func(t *testing.T) {
+		session := newSession(t)
+		result, err := service.HandleAuthentication(context.Background(), session.ID, AuthenticationOptions{
+			Type:              "form",
+			Username:          "alice",
+			Password:          "s3cret",
+			LoginURL:          idp.URL + "/login",
+			UsernameSelector:  "#user",
+			PasswordSelector:  "#pass",
+			SubmitSelector:    "#submit",
+			SuccessSelector:   "#welcome",
+			SuccessURLPattern: "**/dashboard",
+			Timeout:           10 * time.Second,
+		})
+		require.NoError(t, err)
+		assert.True(t, result.Verified)
+		assert.Equal(t, idp.URL+"/dashboard", result.FinalURL)
+	})
+
+	t.Run("form login with wrong password fails verification", func(t *testing.T) {
+		session := newSession(t)
+		_, err := service.HandleAuthentication(context.Background(), session.ID, AuthenticationOptions{
+			Type:             "form",
+			Username:         "alice",
+			Password:         "wrong",
+			LoginURL:         idp.URL + "/login",
+			UsernameSelector: "#user",
+			PasswordSelector: "#pass",
+			SuccessSelector:  "#welcome",
+			Timeout:          2 * time.Second,
+		})
+		assert.ErrorContains(t, err, "login could not be verified")
+	})
+
+	t.Run("oauth authorization code flow", func(t *testing.T) {
+		session := newSession(t)
+		result, err := service.HandleAuthentication(context.Background(), session.ID, AuthenticationOptions{
+			Type:              "oauth",
+			Username:          "alice",
+			Password:          "s3cret",
+			UsernameSelector:  "#user",
+			PasswordSelector:  "#pass",
+			SubmitSelector:    "#submit",
+			SuccessURLPattern: "**/dashboard",
+			Timeout:           10 * time.Second,
+			OAuth: OAuthOptions{
+				AuthorizeURL: idp.URL + "/authorize",
+				ClientID:     "browser-agent",
+				RedirectURI:  idp.URL + "/callback",
+				Scope:        "openid",
+			},
+		})
+		require.NoError(t, err)
+		assert.True(t, result.Verified)
+		assert.NotContains(t, result.FinalURL, "auth-code-123")
+	})
+
+	t.Run("basic auth credentials apply to later navigations", func(t *testing.T) {
+		session := newSession(t)
+		result, err := service.HandleAuthentication(context.Background(), session.ID, AuthenticationOptions{
+			Type:     "basic",
+			Username: "alice",
+			Password: "s3cret",
+			LoginURL: idp.URL,
+		})
+		require.NoError(t, err)
+		assert.False(t, result.Verified, "basic auth only sets credentials")
+
+		session, err = service.GetSession(session.ID)
+		require.NoError(t, err)
+		response, err := session.ActivePage().Goto(idp.URL + "/protected")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.Status())
+	})
+}
@@ -0,0 +1,277 @@
+package playwright
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+)
+
+// browserPool keeps a bounded set of long-lived browser processes and hands
+// one out for every new BrowserContext, so a task session costs a context
+// rather than a full browser launch.
+//
+// New contexts go to the browser with the fewest active contexts, which keeps
+// load spread evenly as sessions come and go. A browser that has served
+// maxContexts contexts is marked as retiring: it takes no new work and is
+// closed once its last context is released, and a replacement is launched on
+// demand. A browser that disconnects (crash, OOM kill, remote CDP endpoint
+// going away) is dropped from the pool immediately.
+//
+// Browsers are launched without holding the pool's lock, so a slow start
+// does not hold up other acquires and releases. A launch in flight holds a
+// pending slot that counts towards the pool's size until it is published or
+// rolled back.
+type browserPool struct {
+	logger      *zap.Logger
+	launch      func() (playwright.Browser, error)
+	size        int
+	maxContexts int
+
+	mu       sync.Mutex
+	browsers []*pooledBrowser
+	// pending counts the browsers being launched
+	pending int
+	// launched is signalled whenever a launch finishes or the pool closes
+	launched *sync.Cond
+	closed   bool
+}
+
+// pooledBrowser tracks the usage of a single browser owned by the pool
+type pooledBrowser struct {
+	browser  playwright.Browser
+	active   int
+	served   int
+	retiring bool
+	crashed  bool
+}
+
+// browserPoolStats is a point-in-time snapshot of the pool, used for health logging
+type browserPoolStats struct {
+	Browsers       int
+	Retiring       int
+	ActiveContexts int
+}
+
+// newBrowserPool creates an empty pool. Browsers are launched lazily on the
+// first acquire. A maxContexts of zero disables recycling.
+func newBrowserPool(logger *zap.Logger, size, maxContexts int, launch func() (playwright.Browser, error)) *browserPool {
+	if size < 1 {
+		size = 1
+	}
+	if maxContexts < 0 {
+		maxContexts = 0
+	}
+	bp := &browserPool{
+		logger:      logger,
+		launch:      launch,
+		size:        size,
+		maxContexts: maxContexts,
+	}
+	bp.launched = sync.NewCond(&bp.mu)
+	return bp
+}
+
+// acquire reserves a browser for one new context. It prefers an idle browser,
+// launches another one while the pool is below its size and every existing
+// browser is busy, and otherwise falls back to the least-loaded browser.
+// When every slot of the pool is a browser still being launched, it waits
+// for one of them.
+func (bp *browserPool) acquire() (*pooledBrowser, error) {
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+
+	for {
+		if bp.closed {
+			return nil, fmt.Errorf("browser pool is closed")
+		}
+
+		bp.evictDisconnectedLocked()
+
+		best, available := bp.leastLoadedLocked()
+		canGrow := available+bp.pending < bp.size
+		if best != nil && (best.active == 0 || !canGrow) {
+			return bp.reserveLocked(best), nil
+		}
+		if best == nil && !canGrow {
+			bp.launched.Wait()
+			continue
+		}
+
+		pb, err := bp.launchLocked()
+		if err != nil {
+			if bp.closed {
+				return nil, fmt.Errorf("browser pool is closed")
+			}
+			// The pool may have changed while the lock was released
+			if best, _ = bp.leastLoadedLocked(); best == nil {
+				return nil, err
+			}
+			bp.logger.Warn("failed to grow browser pool, reusing an existing browser", zap.Error(err))
+			return bp.reserveLocked(best), nil
+		}
+		return bp.reserveLocked(pb), nil
+	}
+}
+
+// leastLoadedLocked returns the browser with the fewest active contexts
+// that still takes new ones, and how many browsers do. Callers must hold
+// bp.mu.
+func (bp *browserPool) leastLoadedLocked() (*pooledBrowser, int) {
+	var best *pooledBrowser
+	available := 0
+	for _, pb := range bp.browsers {
+		if pb.retiring {
+			continue
+		}
+		available++
+		if best == nil || pb.active < best.active {
+			best = pb
+		}
+	}
+	return best, available
+}
+
+// reserveLocked counts a new context against best, retiring it once it has
+// served maxContexts. Callers must hold bp.mu.
+func (bp *browserPool) reserveLocked(best *pooledBrowser) *pooledBrowser {
+	best.active++
+	best.served++
+	if bp.maxContexts > 0 && best.served >= bp.maxContexts {
+		best.retiring = true
+		bp.logger.Info("pooled browser reached its context limit, retiring after current sessions close",
+			zap.Int("served", best.served),
+			zap.Int("max_contexts", bp.maxContexts))
+	}
+	return best
+}
+
+// release returns a context slot to the pool, closing the browser if it was
+// retiring and this was its last context.
+func (bp *browserPool) release(pb *pooledBrowser) {
+	bp.mu.Lock()
+	pb.active--
+	shouldClose := pb.retiring && pb.active <= 0 && !pb.crashed && !bp.closed
+	if shouldClose {
+		bp.removeLocked(pb)
+	}
+	bp.mu.Unlock()
+
+	if shouldClose {
+		bp.closeBrowser(pb, "recycling pooled browser")
+	}
+}
+
+// close shuts down every browser in the pool and rejects further acquires.
+func (bp *browserPool) close() {
+	bp.mu.Lock()
+	bp.closed = true
+	browsers := bp.browsers
+	bp.browsers = nil
+	bp.launched.Broadcast()
+	bp.mu.Unlock()
+
+	for _, pb := range browsers {
+		if !pb.crashed {
+			bp.closeBrowser(pb, "closing pooled browser")
+		}
+	}
+}
+
+// stats returns a snapshot of the pool's current usage
+func (bp *browserPool) stats() browserPoolStats {
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+
+	stats := browserPoolStats{Browsers: len(bp.browsers)}
+	for _, pb := range bp.browsers {
+		if pb.retiring {
+			stats.Retiring++
+		}
+		stats.ActiveContexts += pb.active
+	}
+	return stats
+}
+
+// launchLocked starts a new browser and adds it to the pool. Callers must
+// hold bp.mu, which is released while the browser starts and held again
+// when launchLocked returns. A browser that finishes starting after the
+// pool closed is closed again.
+func (bp *browserPool) launchLocked() (*pooledBrowser, error) {
+	bp.pending++
+	bp.mu.Unlock()
+	browser, err := bp.launch()
+	var pb *pooledBrowser
+	if err == nil {
+		pb = &pooledBrowser{browser: browser}
+		browser.OnDisconnected(func(playwright.Browser) {
+			bp.handleDisconnect(pb)
+		})
+	}
+	bp.mu.Lock()
+	bp.pending--
+	bp.launched.Broadcast()
+
+	if err != nil {
+		return nil, err
+	}
+	if bp.closed {
+		bp.mu.Unlock()
+		bp.closeBrowser(pb, "closing browser launched after the pool closed")
+		bp.mu.Lock()
+		return nil, fmt.Errorf("browser pool is closed")
+	}
+	bp.browsers = append(bp.browsers, pb)
+
+	bp.logger.Info("launched pooled browser",
+		zap.Int("pool_browsers", len(bp.browsers)),
+		zap.Int("pool_size", bp.size))
+	return pb, nil
+}
+
+// handleDisconnect drops a browser that went away without the pool closing it
+func (bp *browserPool) handleDisconnect(pb *pooledBrowser) {
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+
+	if pb.crashed || !slices.Contains(bp.browsers, pb) {
+		return
+	}
+
+	pb.crashed = true
+	pb.retiring = true
+	bp.removeLocked(pb)
+	bp.logger.Warn("pooled browser disconnected, removed from pool",
+		zap.Int("active_contexts", pb.active),
+		zap.Int("pool_browsers", len(bp.browsers)))
+}
+
+// evictDisconnectedLocked removes browsers whose disconnect event was missed.
+// Callers must hold bp.mu.
+func (bp *browserPool) evictDisconnectedLocked() {
+	bp.browsers = slices.DeleteFunc(bp.browsers, func(pb *pooledBrowser) bool {
+		if pb.browser.IsConnected() {
+			return false
+		}
+		pb.crashed = true
+		pb.retiring = true
+		bp.logger.Warn("evicting disconnected browser from pool", zap.Int("active_contexts", pb.active))
+		return true
+	})
+}
+
+// removeLocked drops pb from the pool. Callers must hold bp.mu.
+func (bp *browserPool) removeLocked(pb *pooledBrowser) {
+	bp.browsers = slices.DeleteFunc(bp.browsers, func(candidate *pooledBrowser) bool {
+		return candidate == pb
+	})
+}
+
+func (bp *browserPool) closeBrowser(pb *pooledBrowser, reason string) {
+	bp.logger.Info(reason, zap.Int("served", pb.served))
+	if err := pb.browser.Close(); err != nil {
+		bp.logger.Error("failed to close pooled browser", zap.Error(err))
+	}
+}
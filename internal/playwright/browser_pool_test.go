@@ -0,0 +1,240 @@
+package playwright
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+	zap "go.uber.org/zap"
+)
+
+// fakePoolBrowser implements just enough of playwright.Browser for the pool;
+// any other method panics through the nil embedded interface.
+type fakePoolBrowser struct {
+	playwright.Browser
+	connected      bool
+	closeCalls     int
+	onDisconnected func(playwright.Browser)
+}
+
+func (f *fakePoolBrowser) IsConnected() bool { return f.connected }
+
+func (f *fakePoolBrowser) Close(...playwright.BrowserCloseOptions) error {
+	f.closeCalls++
+	f.connected = false
+	return nil
+}
+
+func (f *fakePoolBrowser) OnDisconnected(fn func(playwright.Browser)) { f.onDisconnected = fn }
+
+func newTestPool(size, maxContexts int) (*browserPool, *[]*fakePoolBrowser) {
+	launched := &[]*fakePoolBrowser{}
+	pool := newBrowserPool(zap.NewNop(), size, maxContexts, func() (playwright.Browser, error) {
+		b := &fakePoolBrowser{connected: true}
+		*launched = append(*launched, b)
+		return b, nil
+	})
+	return pool, launched
+}
+
+func TestBrowserPoolSpreadsContextsAcrossBrowsers(t *testing.T) {
+	pool, launched := newTestPool(2, 0)
+
+	first, err := pool.acquire()
+	require.NoError(t, err)
+	second, err := pool.acquire()
+	require.NoError(t, err)
+	third, err := pool.acquire()
+	require.NoError(t, err)
+
+	assert.Len(t, *launched, 2, "pool must not launch more browsers than its size")
+	assert.NotSame(t, first, second, "a busy browser should not get the second context while the pool can grow")
+	assert.Same(t, first, third, "once full, the least-loaded browser takes the next context")
+
+	pool.release(second)
+	fourth, err := pool.acquire()
+	require.NoError(t, err)
+	assert.Same(t, second, fourth, "released capacity should be reused before a loaded browser")
+	assert.Equal(t, 3, pool.stats().ActiveContexts)
+}
+
+func TestBrowserPoolRecyclesAfterMaxContexts(t *testing.T) {
+	pool, launched := newTestPool(1, 2)
+
+	a, err := pool.acquire()
+	require.NoError(t, err)
+	b, err := pool.acquire()
+	require.NoError(t, err)
+	require.Same(t, a, b)
+	assert.Equal(t, 1, pool.stats().Retiring)
+
+	c, err := pool.acquire()
+	require.NoError(t, err)
+	assert.NotSame(t, a, c, "a retiring browser must not take new contexts")
+	require.Len(t, *launched, 2)
+
+	pool.release(a)
+	assert.Zero(t, (*launched)[0].closeCalls, "retiring browser stays open while it still has contexts")
+
+	pool.release(b)
+	assert.Equal(t, 1, (*launched)[0].closeCalls, "retiring browser is closed with its last context")
+	assert.Equal(t, 1, pool.stats().Browsers)
+}
+
+func TestBrowserPoolDropsDisconnectedBrowser(t *testing.T) {
+	pool, launched := newTestPool(1, 0)
+
+	crashed, err := pool.acquire()
+	require.NoError(t, err)
+
+	(*launched)[0].connected = false
+	(*launched)[0].onDisconnected((*launched)[0])
+	assert.Zero(t, pool.stats().Browsers)
+
+	replacement, err := pool.acquire()
+	require.NoError(t, err)
+	assert.NotSame(t, crashed, replacement)
+
+	pool.release(crashed)
+	assert.Zero(t, (*launched)[0].closeCalls, "a crashed browser is not closed again")
+}
+
+func TestBrowserPoolEvictsBrowserWithMissedDisconnect(t *testing.T) {
+	pool, launched := newTestPool(1, 0)
+
+	first, err := pool.acquire()
+	require.NoError(t, err)
+	pool.release(first)
+
+	(*launched)[0].connected = false
+
+	second, err := pool.acquire()
+	require.NoError(t, err)
+	assert.NotSame(t, first, second)
+	assert.Len(t, *launched, 2)
+}
+
+func TestBrowserPoolClose(t *testing.T) {
+	pool, launched := newTestPool(2, 0)
+
+	held, err := pool.acquire()
+	require.NoError(t, err)
+	_, err = pool.acquire()
+	require.NoError(t, err)
+
+	pool.close()
+	for _, b := range *launched {
+		assert.Equal(t, 1, b.closeCalls)
+	}
+
+	pool.release(held)
+	assert.Equal(t, 1, (*launched)[0].closeCalls, "release after close must not close twice")
+
+	_, err = pool.acquire()
+	assert.ErrorContains(t, err, "browser pool is closed")
+}
+
+// newGatedPool returns a pool whose launches block until a value is sent on
+// the returned channel, and the number of launches started so far
+func newGatedPool(size int) (*browserPool, chan struct{}, *atomic.Int32) {
+	gate := make(chan struct{})
+	launches := &atomic.Int32{}
+	pool := newBrowserPool(zap.NewNop(), size, 0, func() (playwright.Browser, error) {
+		launches.Add(1)
+		<-gate
+		return &fakePoolBrowser{connected: true}, nil
+	})
+	return pool, gate, launches
+}
+
+// pendingLaunches reads the pool's launches in flight
+func pendingLaunches(pool *browserPool) int {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	return pool.pending
+}
+
+func TestBrowserPoolLaunchesOutsideTheLock(t *testing.T) {
+	pool, gate, _ := newGatedPool(2)
+	go func() { gate <- struct{}{} }()
+	first, err := pool.acquire()
+	require.NoError(t, err)
+
+	acquired := make(chan *pooledBrowser)
+	go func() {
+		pb, err := pool.acquire()
+		assert.NoError(t, err)
+		acquired <- pb
+	}()
+	require.Eventually(t, func() bool { return pendingLaunches(pool) == 1 }, time.Second, time.Millisecond)
+
+	// Neither blocks on the launch in flight
+	assert.Equal(t, 1, pool.stats().ActiveContexts)
+	pool.release(first)
+
+	gate <- struct{}{}
+	second := <-acquired
+	assert.NotSame(t, first, second, "the launched browser is published to the waiting acquire")
+	assert.Equal(t, 2, pool.stats().Browsers)
+	assert.Zero(t, pendingLaunches(pool))
+}
+
+func TestBrowserPoolWaitsForPendingLaunch(t *testing.T) {
+	pool, gate, launches := newGatedPool(1)
+
+	var wg sync.WaitGroup
+	acquired := make([]*pooledBrowser, 2)
+	for i := range acquired {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pb, err := pool.acquire()
+			assert.NoError(t, err)
+			acquired[i] = pb
+		}()
+	}
+	require.Eventually(t, func() bool { return pendingLaunches(pool) == 1 }, time.Second, time.Millisecond)
+
+	gate <- struct{}{}
+	wg.Wait()
+	assert.Equal(t, int32(1), launches.Load(), "a pending launch counts towards the pool's size")
+	assert.Same(t, acquired[0], acquired[1])
+	assert.Equal(t, 2, pool.stats().ActiveContexts)
+}
+
+func TestBrowserPoolRollsBackLaunchAfterClose(t *testing.T) {
+	pool, gate, _ := newGatedPool(1)
+
+	result := make(chan error)
+	go func() {
+		_, err := pool.acquire()
+		result <- err
+	}()
+	require.Eventually(t, func() bool { return pendingLaunches(pool) == 1 }, time.Second, time.Millisecond)
+
+	pool.close()
+	gate <- struct{}{}
+	assert.ErrorContains(t, <-result, "browser pool is closed")
+	assert.Zero(t, pool.stats().Browsers, "a browser launched after close is not published")
+	assert.Zero(t, pendingLaunches(pool))
+}
+
+func TestSameLaunchOptions(t *testing.T) {
+	base := DefaultBrowserConfig()
+
+	viewport := DefaultBrowserConfig()
+	viewport.ViewportWidth = 375
+	assert.True(t, sameLaunchOptions(base, viewport), "viewport is a context option and can share a browser")
+
+	headed := DefaultBrowserConfig()
+	headed.Headless = false
+	assert.False(t, sameLaunchOptions(base, headed))
+
+	firefox := DefaultBrowserConfig()
+	firefox.Engine = Firefox
+	assert.False(t, sameLaunchOptions(base, firefox))
+}
@@ -0,0 +1,390 @@
+package playwright
+
+import (
+	"context"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+
+	proxy "github.com/inference-gateway/browser-agent/internal/proxy"
+)
+
+// cancelReason is reported to the Playwright calls interrupted when a
+// cancelled operation's tab is closed
+const cancelReason = "operation cancelled"
+
+// pageAborter is implemented by services that can stop the Playwright call
+// running on a session's active tab
+type pageAborter interface {
+	abortActivePage(sessionID string)
+}
+
+// cancellable makes the operations of a BrowserAutomation honour their
+// context. Playwright calls take no context and run until their own
+// timeout, so each operation runs in the background and is raced against
+// ctx.Done(). A page operation whose context is done first has its tab
+// closed, which makes the call return.
+type cancellable struct {
+	BrowserAutomation
+}
+
+// WithCancellation wraps automation so that every operation returns with
+// the context's error as soon as its context is done, for example when the
+// A2A task is cancelled. Closing sessions and shutting down are passed
+// through, so cleanup is never cut short.
+func WithCancellation(automation BrowserAutomation) BrowserAutomation {
+	if _, ok := automation.(*cancellable); ok {
+		return automation
+	}
+	return &cancellable{BrowserAutomation: automation}
+}
+
+// race runs call and returns its result, or ctx's error as soon as ctx is
+// done. abort, when set, is then called to stop call; call's result is
+// discarded once it returns.
+func race[T any](ctx context.Context, abort func(), call func() (T, error)) (T, error) {
+	var zero T
+	if err := ctx.Err(); err != nil {
+		return zero, err
+	}
+
+	type result struct {
+		value T
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		value, err := call()
+		done <- result{value, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-ctx.Done():
+		if abort != nil {
+			abort()
+		}
+		return zero, ctx.Err()
+	}
+}
+
+// raceErr is race for calls that only return an error
+func raceErr(ctx context.Context, abort func(), call func() error) error {
+	_, err := race(ctx, abort, func() (struct{}, error) {
+		return struct{}{}, call()
+	})
+	return err
+}
+
+// sleep waits for d, or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// abort returns the function that stops an operation on sessionID
+func (c *cancellable) abort(sessionID string) func() {
+	aborter, ok := c.BrowserAutomation.(pageAborter)
+	if !ok {
+		return nil
+	}
+	return func() {
+		aborter.abortActivePage(sessionID)
+	}
+}
+
+// abortActivePage closes the active tab of a session, so the Playwright
+// call running on it returns instead of running into its timeout. A session
+// left without tabs gets a blank one, so it stays usable.
+func (p *playwrightImpl) abortActivePage(sessionID string) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return
+	}
+	page := session.ActivePage()
+	if page == nil || page.IsClosed() {
+		return
+	}
+
+	p.logger.Info("operation cancelled, closing the active tab",
+		zap.String("sessionID", sessionID),
+		zap.String("url", page.URL()))
+	reason := cancelReason
+	if err := page.Close(playwright.PageCloseOptions{Reason: &reason}); err != nil {
+		p.logger.Debug("failed to close the cancelled tab", zap.String("sessionID", sessionID), zap.Error(err))
+	}
+	p.removeTab(session, page)
+
+	session.tabsMux.RLock()
+	remaining, browserContext := len(session.tabs), session.Context
+	session.tabsMux.RUnlock()
+	if remaining > 0 || browserContext == nil {
+		return
+	}
+	fresh, err := browserContext.NewPage()
+	if err != nil {
+		p.logger.Warn("failed to open a tab after cancellation", zap.String("sessionID", sessionID), zap.Error(err))
+		return
+	}
+	p.addTab(session, fresh)
+	session.tabsMux.Lock()
+	session.Page = fresh
+	session.tabsMux.Unlock()
+}
+
+// The operations below run their call with race. Operations that only read
+// what the session recorded, or that create sessions, are not aborted.
+
+func (c *cancellable) LaunchBrowser(ctx context.Context, config *BrowserConfig) (*BrowserSession, error) {
+	return race(ctx, nil, func() (*BrowserSession, error) {
+		return c.BrowserAutomation.LaunchBrowser(ctx, config)
+	})
+}
+
+func (c *cancellable) GetOrCreateTaskSession(ctx context.Context) (*BrowserSession, error) {
+	return race(ctx, nil, func() (*BrowserSession, error) {
+		return c.BrowserAutomation.GetOrCreateTaskSession(ctx)
+	})
+}
+
+func (c *cancellable) SaveStorageState(ctx context.Context, sessionID, path string) error {
+	return raceErr(ctx, nil, func() error {
+		return c.BrowserAutomation.SaveStorageState(ctx, sessionID, path)
+	})
+}
+
+func (c *cancellable) SaveProfile(ctx context.Context, sessionID, name string) (*Profile, error) {
+	return race(ctx, nil, func() (*Profile, error) {
+		return c.BrowserAutomation.SaveProfile(ctx, sessionID, name)
+	})
+}
+
+func (c *cancellable) ListProfiles(ctx context.Context) ([]Profile, error) {
+	return race(ctx, nil, func() ([]Profile, error) {
+		return c.BrowserAutomation.ListProfiles(ctx)
+	})
+}
+
+func (c *cancellable) DeleteProfile(ctx context.Context, name string) error {
+	return raceErr(ctx, nil, func() error {
+		return c.BrowserAutomation.DeleteProfile(ctx, name)
+	})
+}
+
+func (c *cancellable) ConfigureBrowser(ctx context.Context, sessionID string, emulation BrowserEmulation) (*EmulationSettings, error) {
+	return race(ctx, c.abort(sessionID), func() (*EmulationSettings, error) {
+		return c.BrowserAutomation.ConfigureBrowser(ctx, sessionID, emulation)
+	})
+}
+
+func (c *cancellable) SetProxy(ctx context.Context, sessionID string, change ProxyChange) (*proxy.Settings, error) {
+	return race(ctx, c.abort(sessionID), func() (*proxy.Settings, error) {
+		return c.BrowserAutomation.SetProxy(ctx, sessionID, change)
+	})
+}
+
+func (c *cancellable) NavigateToURL(ctx context.Context, sessionID, url string, waitUntil string, timeout time.Duration) (*NavigationResult, error) {
+	return race(ctx, c.abort(sessionID), func() (*NavigationResult, error) {
+		return c.BrowserAutomation.NavigateToURL(ctx, sessionID, url, waitUntil, timeout)
+	})
+}
+
+func (c *cancellable) NavigateHistory(ctx context.Context, sessionID, action, waitUntil string, timeout time.Duration) (*NavigationResult, error) {
+	return race(ctx, c.abort(sessionID), func() (*NavigationResult, error) {
+		return c.BrowserAutomation.NavigateHistory(ctx, sessionID, action, waitUntil, timeout)
+	})
+}
+
+func (c *cancellable) ClickElement(ctx context.Context, sessionID, selector string, options map[string]any) error {
+	return raceErr(ctx, c.abort(sessionID), func() error {
+		return c.BrowserAutomation.ClickElement(ctx, sessionID, selector, options)
+	})
+}
+
+func (c *cancellable) FillForm(ctx context.Context, sessionID string, fields []map[string]any, submit bool, submitSelector string) ([]FieldResult, error) {
+	return race(ctx, c.abort(sessionID), func() ([]FieldResult, error) {
+		return c.BrowserAutomation.FillForm(ctx, sessionID, fields, submit, submitSelector)
+	})
+}
+
+func (c *cancellable) ExtractData(ctx context.Context, sessionID string, extractors []map[string]any, format string) (string, error) {
+	return race(ctx, c.abort(sessionID), func() (string, error) {
+		return c.BrowserAutomation.ExtractData(ctx, sessionID, extractors, format)
+	})
+}
+
+func (c *cancellable) TakeScreenshot(ctx context.Context, sessionID, path string, fullPage bool, selector string, format string, quality int) error {
+	return raceErr(ctx, c.abort(sessionID), func() error {
+		return c.BrowserAutomation.TakeScreenshot(ctx, sessionID, path, fullPage, selector, format, quality)
+	})
+}
+
+func (c *cancellable) ExecuteScript(ctx context.Context, sessionID, script string, args []any) (any, error) {
+	return race(ctx, c.abort(sessionID), func() (any, error) {
+		return c.BrowserAutomation.ExecuteScript(ctx, sessionID, script, args)
+	})
+}
+
+func (c *cancellable) WaitForCondition(ctx context.Context, sessionID, condition string, options WaitOptions) (*WaitResult, error) {
+	return race(ctx, c.abort(sessionID), func() (*WaitResult, error) {
+		return c.BrowserAutomation.WaitForCondition(ctx, sessionID, condition, options)
+	})
+}
+
+func (c *cancellable) HandleAuthentication(ctx context.Context, sessionID string, options AuthenticationOptions) (*AuthenticationResult, error) {
+	return race(ctx, c.abort(sessionID), func() (*AuthenticationResult, error) {
+		return c.BrowserAutomation.HandleAuthentication(ctx, sessionID, options)
+	})
+}
+
+func (c *cancellable) GetPageSnapshot(ctx context.Context, sessionID string, options SnapshotOptions) (*PageSnapshot, error) {
+	return race(ctx, c.abort(sessionID), func() (*PageSnapshot, error) {
+		return c.BrowserAutomation.GetPageSnapshot(ctx, sessionID, options)
+	})
+}
+
+func (c *cancellable) GetPageContent(ctx context.Context, sessionID, selector, frame string, timeout time.Duration) (*PageContent, error) {
+	return race(ctx, c.abort(sessionID), func() (*PageContent, error) {
+		return c.BrowserAutomation.GetPageContent(ctx, sessionID, selector, frame, timeout)
+	})
+}
+
+func (c *cancellable) GetNetworkLog(ctx context.Context, sessionID string, filter NetworkFilter) (*NetworkLog, error) {
+	return race(ctx, nil, func() (*NetworkLog, error) {
+		return c.BrowserAutomation.GetNetworkLog(ctx, sessionID, filter)
+	})
+}
+
+func (c *cancellable) GetConsoleLogs(ctx context.Context, sessionID string, filter ConsoleFilter) (*ConsoleLogs, error) {
+	return race(ctx, nil, func() (*ConsoleLogs, error) {
+		return c.BrowserAutomation.GetConsoleLogs(ctx, sessionID, filter)
+	})
+}
+
+func (c *cancellable) WaitForDownload(ctx context.Context, sessionID string, options DownloadOptions) (*Download, error) {
+	return race(ctx, c.abort(sessionID), func() (*Download, error) {
+		return c.BrowserAutomation.WaitForDownload(ctx, sessionID, options)
+	})
+}
+
+func (c *cancellable) PressKeys(ctx context.Context, sessionID string, keys []string, options KeyboardOptions) error {
+	return raceErr(ctx, c.abort(sessionID), func() error {
+		return c.BrowserAutomation.PressKeys(ctx, sessionID, keys, options)
+	})
+}
+
+func (c *cancellable) TypeText(ctx context.Context, sessionID, text string, options KeyboardOptions) error {
+	return raceErr(ctx, c.abort(sessionID), func() error {
+		return c.BrowserAutomation.TypeText(ctx, sessionID, text, options)
+	})
+}
+
+func (c *cancellable) Hover(ctx context.Context, sessionID, selector string, options MouseOptions) error {
+	return raceErr(ctx, c.abort(sessionID), func() error {
+		return c.BrowserAutomation.Hover(ctx, sessionID, selector, options)
+	})
+}
+
+func (c *cancellable) ScrollIntoView(ctx context.Context, sessionID, selector string, options MouseOptions) error {
+	return raceErr(ctx, c.abort(sessionID), func() error {
+		return c.BrowserAutomation.ScrollIntoView(ctx, sessionID, selector, options)
+	})
+}
+
+func (c *cancellable) Scroll(ctx context.Context, sessionID string, options ScrollOptions) (*ScrollPosition, error) {
+	return race(ctx, c.abort(sessionID), func() (*ScrollPosition, error) {
+		return c.BrowserAutomation.Scroll(ctx, sessionID, options)
+	})
+}
+
+func (c *cancellable) DragAndDrop(ctx context.Context, sessionID, source, target string, options MouseOptions) error {
+	return raceErr(ctx, c.abort(sessionID), func() error {
+		return c.BrowserAutomation.DragAndDrop(ctx, sessionID, source, target, options)
+	})
+}
+
+func (c *cancellable) ClickAt(ctx context.Context, sessionID string, x, y float64, options ClickAtOptions) error {
+	return raceErr(ctx, c.abort(sessionID), func() error {
+		return c.BrowserAutomation.ClickAt(ctx, sessionID, x, y, options)
+	})
+}
+
+func (c *cancellable) GetCookies(ctx context.Context, sessionID string, filter CookieFilter) ([]Cookie, error) {
+	return race(ctx, nil, func() ([]Cookie, error) {
+		return c.BrowserAutomation.GetCookies(ctx, sessionID, filter)
+	})
+}
+
+func (c *cancellable) SetCookies(ctx context.Context, sessionID string, cookies []Cookie) error {
+	return raceErr(ctx, nil, func() error {
+		return c.BrowserAutomation.SetCookies(ctx, sessionID, cookies)
+	})
+}
+
+func (c *cancellable) ClearCookies(ctx context.Context, sessionID string, filter CookieFilter) (int, error) {
+	return race(ctx, nil, func() (int, error) {
+		return c.BrowserAutomation.ClearCookies(ctx, sessionID, filter)
+	})
+}
+
+func (c *cancellable) GetStorage(ctx context.Context, sessionID string, query StorageQuery) (*WebStorage, error) {
+	return race(ctx, c.abort(sessionID), func() (*WebStorage, error) {
+		return c.BrowserAutomation.GetStorage(ctx, sessionID, query)
+	})
+}
+
+func (c *cancellable) SetStorage(ctx context.Context, sessionID string, update StorageUpdate) (*WebStorage, error) {
+	return race(ctx, c.abort(sessionID), func() (*WebStorage, error) {
+		return c.BrowserAutomation.SetStorage(ctx, sessionID, update)
+	})
+}
+
+func (c *cancellable) AddRoute(ctx context.Context, sessionID string, rule RouteRule) (*RouteRule, error) {
+	return race(ctx, nil, func() (*RouteRule, error) {
+		return c.BrowserAutomation.AddRoute(ctx, sessionID, rule)
+	})
+}
+
+func (c *cancellable) RemoveRoutes(ctx context.Context, sessionID string, ids []string) ([]RouteRule, error) {
+	return race(ctx, nil, func() ([]RouteRule, error) {
+		return c.BrowserAutomation.RemoveRoutes(ctx, sessionID, ids)
+	})
+}
+
+func (c *cancellable) ListRoutes(ctx context.Context, sessionID string) ([]RouteRule, error) {
+	return race(ctx, nil, func() ([]RouteRule, error) {
+		return c.BrowserAutomation.ListRoutes(ctx, sessionID)
+	})
+}
+
+func (c *cancellable) ListTabs(ctx context.Context, sessionID string) ([]Tab, error) {
+	return race(ctx, nil, func() ([]Tab, error) {
+		return c.BrowserAutomation.ListTabs(ctx, sessionID)
+	})
+}
+
+func (c *cancellable) SwitchTab(ctx context.Context, sessionID, tabID string) (*Tab, error) {
+	return race(ctx, nil, func() (*Tab, error) {
+		return c.BrowserAutomation.SwitchTab(ctx, sessionID, tabID)
+	})
+}
+
+func (c *cancellable) OpenTab(ctx context.Context, sessionID, url, waitUntil string, timeout time.Duration) (*Tab, error) {
+	return race(ctx, c.abort(sessionID), func() (*Tab, error) {
+		return c.BrowserAutomation.OpenTab(ctx, sessionID, url, waitUntil, timeout)
+	})
+}
+
+func (c *cancellable) CloseTab(ctx context.Context, sessionID, tabID string) (*Tab, error) {
+	return race(ctx, c.abort(sessionID), func() (*Tab, error) {
+		return c.BrowserAutomation.CloseTab(ctx, sessionID, tabID)
+	})
+}
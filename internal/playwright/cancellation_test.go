@@ -0,0 +1,76 @@
+package playwright_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+func TestWithCancellation_ReturnsWhenCancelled(t *testing.T) {
+	fake := &mocks.FakeBrowserAutomation{}
+	release := make(chan struct{})
+	defer close(release)
+	fake.NavigateToURLStub = func(context.Context, string, string, string, time.Duration) (*playwright.NavigationResult, error) {
+		<-release
+		return &playwright.NavigationResult{}, nil
+	}
+	fake.WaitForConditionStub = func(context.Context, string, string, playwright.WaitOptions) (*playwright.WaitResult, error) {
+		<-release
+		return &playwright.WaitResult{}, nil
+	}
+	service := playwright.WithCancellation(fake)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	started := time.Now()
+	result, err := service.NavigateToURL(ctx, "session-1", "https://example.com", "load", time.Minute)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, result)
+	assert.Less(t, time.Since(started), 5*time.Second, "the call returns on cancellation, not when the operation finishes")
+
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = service.WaitForCondition(ctx, "session-1", playwright.WaitTimeout, playwright.WaitOptions{Timeout: time.Minute})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWithCancellation_PassesResultsThrough(t *testing.T) {
+	fake := &mocks.FakeBrowserAutomation{}
+	fake.NavigateToURLReturns(&playwright.NavigationResult{URL: "https://example.com/", Status: 200}, nil)
+	fake.ClickElementReturns(errors.New("element not found"))
+	service := playwright.WithCancellation(fake)
+
+	result, err := service.NavigateToURL(context.Background(), "session-1", "https://example.com", "load", time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, 200, result.Status)
+
+	err = service.ClickElement(context.Background(), "session-1", "#missing", nil)
+	assert.EqualError(t, err, "element not found")
+
+	_, sessionID, url, _, _ := fake.NavigateToURLArgsForCall(0)
+	assert.Equal(t, "session-1", sessionID)
+	assert.Equal(t, "https://example.com", url)
+	assert.Same(t, service, playwright.WithCancellation(service), "wrapping twice is a no-op")
+}
+
+func TestWithCancellation_SkipsCancelledOperations(t *testing.T) {
+	fake := &mocks.FakeBrowserAutomation{}
+	service := playwright.WithCancellation(fake)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := service.TypeText(ctx, "session-1", "hello", playwright.KeyboardOptions{})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, fake.TypeTextCallCount(), "an operation whose context is already done is not started")
+
+	require.NoError(t, service.CloseBrowser(ctx, "session-1"), "closing sessions is passed through")
+	assert.Equal(t, 1, fake.CloseBrowserCallCount())
+}
@@ -0,0 +1,313 @@
+package playwright
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	playwright "github.com/mxschmitt/playwright-go"
+)
+
+// DefaultConsoleLogSize is how many messages a session's console log keeps
+const DefaultConsoleLogSize = 1000
+
+// maxConsoleTextBytes bounds the text and stack kept for one message
+const maxConsoleTextBytes = 4000
+
+// Console log sources
+const (
+	// ConsoleSourceConsole is a console.* call of a page
+	ConsoleSourceConsole = "console"
+	// ConsoleSourcePageError is an uncaught exception or unhandled rejection
+	ConsoleSourcePageError = "pageerror"
+	// ConsoleSourceNetwork is a request that failed without a response
+	ConsoleSourceNetwork = "network"
+)
+
+// Console log levels, from least to most severe
+const (
+	ConsoleLevelDebug   = "debug"
+	ConsoleLevelInfo    = "info"
+	ConsoleLevelWarning = "warning"
+	ConsoleLevelError   = "error"
+)
+
+// consoleLevels orders the levels by severity
+var consoleLevels = []string{ConsoleLevelDebug, ConsoleLevelInfo, ConsoleLevelWarning, ConsoleLevelError}
+
+// ConsoleEntry is one console message, page error or failed request of a
+// session's pages
+type ConsoleEntry struct {
+	ID     int    `json:"id"`
+	Source string `json:"source"`
+	Level  string `json:"level"`
+	// Type is the console method, e.g. "log" or "assert", for console
+	// messages, and the error name for page errors
+	Type      string    `json:"type,omitempty"`
+	Text      string    `json:"text"`
+	URL       string    `json:"url,omitempty"`
+	Line      int       `json:"line,omitempty"`
+	Column    int       `json:"column,omitempty"`
+	Stack     string    `json:"stack,omitempty"`
+	TabID     string    `json:"tab_id,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ConsoleFilter selects entries from a console log. Zero values match
+// everything.
+type ConsoleFilter struct {
+	// MinLevel keeps entries at this level or above
+	MinLevel string
+	Sources  []string
+	// SinceID keeps entries newer than this cursor
+	SinceID int
+	// SinceLastCall keeps entries recorded after the previous
+	// GetConsoleLogs call of the session, overriding SinceID
+	SinceLastCall bool
+	// Limit keeps only the most recent matches
+	Limit int
+}
+
+// ConsoleLogs is the result of GetConsoleLogs, oldest entry first
+type ConsoleLogs struct {
+	Entries []ConsoleEntry `json:"entries"`
+	// Matched counts the entries that passed the filter before Limit
+	Matched int `json:"matched"`
+	// Errors and PageErrors count the matched error-level entries and,
+	// among them, uncaught exceptions
+	Errors     int `json:"errors"`
+	PageErrors int `json:"page_errors"`
+	// Cursor is the ID of the newest recorded entry; pass it as SinceID to
+	// read only what comes after
+	Cursor int `json:"cursor"`
+	// Dropped is how many older entries were evicted from the full log
+	Dropped int `json:"dropped"`
+}
+
+// consoleLog is a bounded ring buffer of a session's console output, fed by
+// the context's events
+type consoleLog struct {
+	mu       sync.Mutex
+	entries  []ConsoleEntry
+	start    int
+	count    int
+	nextID   int
+	dropped  int
+	lastRead int
+	// blocked holds requests aborted by the session's routes, whose
+	// failures are intended and not reported
+	blocked map[playwright.Request]struct{}
+}
+
+// newConsoleLog returns a log holding up to size entries, or nil when size
+// is not positive and capture is disabled
+func newConsoleLog(size int) *consoleLog {
+	if size <= 0 {
+		return nil
+	}
+	return &consoleLog{
+		entries: make([]ConsoleEntry, size),
+		blocked: map[playwright.Request]struct{}{},
+	}
+}
+
+// record appends an entry, evicting the oldest one when the log is full
+func (l *consoleLog) record(entry ConsoleEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.nextID++
+	entry.ID = l.nextID
+	entry.Text = truncateUTF8(entry.Text, maxConsoleTextBytes)
+	entry.Stack = truncateUTF8(entry.Stack, maxConsoleTextBytes)
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now()
+	}
+	if l.count == len(l.entries) {
+		l.entries[l.start] = entry
+		l.start = (l.start + 1) % len(l.entries)
+		l.dropped++
+		return
+	}
+	l.entries[(l.start+l.count)%len(l.entries)] = entry
+	l.count++
+}
+
+// markBlocked notes that a route aborted request, so its failure is skipped
+func (l *consoleLog) markBlocked(request playwright.Request) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.blocked[request] = struct{}{}
+}
+
+// read returns the entries matching filter and moves the since-last-call
+// cursor to the newest entry
+func (l *consoleLog) read(filter ConsoleFilter) *ConsoleLogs {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	since := filter.SinceID
+	if filter.SinceLastCall {
+		since = l.lastRead
+	}
+	l.lastRead = l.nextID
+
+	minLevel := slices.Index(consoleLevels, filter.MinLevel)
+	logs := &ConsoleLogs{Entries: []ConsoleEntry{}, Cursor: l.nextID, Dropped: l.dropped}
+	for i := range l.count {
+		entry := l.entries[(l.start+i)%len(l.entries)]
+		if entry.ID <= since ||
+			slices.Index(consoleLevels, entry.Level) < minLevel ||
+			(len(filter.Sources) > 0 && !slices.Contains(filter.Sources, entry.Source)) {
+			continue
+		}
+		logs.Entries = append(logs.Entries, entry)
+		if entry.Level == ConsoleLevelError {
+			logs.Errors++
+		}
+		if entry.Source == ConsoleSourcePageError {
+			logs.PageErrors++
+		}
+	}
+
+	logs.Matched = len(logs.Entries)
+	if filter.Limit > 0 && len(logs.Entries) > filter.Limit {
+		logs.Entries = logs.Entries[len(logs.Entries)-filter.Limit:]
+	}
+	return logs
+}
+
+// consoleLevel maps a console method to a log level
+func consoleLevel(messageType string) string {
+	switch messageType {
+	case "error", "assert":
+		return ConsoleLevelError
+	case "warning":
+		return ConsoleLevelWarning
+	case "debug", "trace":
+		return ConsoleLevelDebug
+	default:
+		return ConsoleLevelInfo
+	}
+}
+
+// truncateUTF8 cuts s to at most n bytes without splitting a character
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n] + "…"
+}
+
+// captureConsole records the console messages, uncaught errors and failed
+// requests of the session's current context
+func (p *playwrightImpl) captureConsole(session *BrowserSession) {
+	log := session.console
+	if log == nil || session.Context == nil {
+		return
+	}
+
+	session.Context.OnConsole(func(message playwright.ConsoleMessage) {
+		entry := ConsoleEntry{
+			Source: ConsoleSourceConsole,
+			Level:  consoleLevel(message.Type()),
+			Type:   message.Type(),
+			Text:   message.Text(),
+			TabID:  session.tabIDOf(message.Page()),
+		}
+		if location := message.Location(); location != nil {
+			entry.URL, entry.Line, entry.Column = location.URL, location.Line, location.Column
+		}
+		if timestamp, err := message.Timestamp(); err == nil && timestamp > 0 {
+			entry.Timestamp = time.UnixMilli(int64(timestamp))
+		}
+		log.record(entry)
+	})
+
+	session.Context.OnWebError(func(webError playwright.WebError) {
+		entry := ConsoleEntry{
+			Source: ConsoleSourcePageError,
+			Level:  ConsoleLevelError,
+			TabID:  session.tabIDOf(webError.Page()),
+		}
+		if err := webError.Error(); err != nil {
+			entry.Text = err.Error()
+			var pageErr *playwright.Error
+			if errors.As(err, &pageErr) {
+				entry.Text = pageErr.Message
+				entry.Type = pageErr.Name
+				entry.Stack = pageErr.Stack
+			}
+		}
+		if location := webError.Location(); location != nil {
+			entry.URL, entry.Line, entry.Column = location.URL, location.Line, location.Column
+		}
+		log.record(entry)
+	})
+
+	session.Context.OnRequestFailed(func(request playwright.Request) {
+		log.mu.Lock()
+		_, blocked := log.blocked[request]
+		delete(log.blocked, request)
+		log.mu.Unlock()
+		if blocked {
+			return
+		}
+
+		text := "request failed"
+		if failure := request.Failure(); failure != nil {
+			text = failure.Error()
+		}
+		entry := ConsoleEntry{
+			Source: ConsoleSourceNetwork,
+			Level:  ConsoleLevelError,
+			Type:   request.ResourceType(),
+			Text:   fmt.Sprintf("%s %s: %s", request.Method(), request.URL(), text),
+			URL:    request.URL(),
+		}
+		if frame := request.Frame(); frame != nil {
+			entry.TabID = session.tabIDOf(frame.Page())
+		}
+		log.record(entry)
+	})
+}
+
+// tabIDOf returns the ID of the tab wrapping page, or "" when it is not
+// tracked
+func (s *BrowserSession) tabIDOf(page playwright.Page) string {
+	if page == nil {
+		return ""
+	}
+	s.tabsMux.RLock()
+	defer s.tabsMux.RUnlock()
+	if tab := s.tabByPage(page); tab != nil {
+		return tab.id
+	}
+	return ""
+}
+
+// GetConsoleLogs returns the console messages, uncaught errors and failed
+// requests recorded for a session that match filter
+func (p *playwrightImpl) GetConsoleLogs(ctx context.Context, sessionID string, filter ConsoleFilter) (*ConsoleLogs, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.console == nil {
+		return nil, fmt.Errorf("console capture is disabled; set BROWSER_CONSOLE_LOG_SIZE to enable it")
+	}
+	if filter.MinLevel != "" && !slices.Contains(consoleLevels, filter.MinLevel) {
+		return nil, fmt.Errorf("unknown console level %q", filter.MinLevel)
+	}
+	return session.console.read(filter), nil
+}
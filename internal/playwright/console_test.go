@@ -0,0 +1,115 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestConsoleLogRead(t *testing.T) {
+	assert.Nil(t, newConsoleLog(0), "a zero size disables capture")
+
+	log := newConsoleLog(3)
+	log.record(ConsoleEntry{Source: ConsoleSourceConsole, Level: ConsoleLevelDebug, Text: "booting"})
+	log.record(ConsoleEntry{Source: ConsoleSourceConsole, Level: ConsoleLevelInfo, Text: "ready"})
+
+	logs := log.read(ConsoleFilter{SinceLastCall: true})
+	assert.Equal(t, 2, logs.Matched)
+	assert.Equal(t, 2, logs.Cursor)
+	assert.Zero(t, logs.Errors)
+
+	log.record(ConsoleEntry{Source: ConsoleSourceConsole, Level: ConsoleLevelWarning, Text: "deprecated API"})
+	log.record(ConsoleEntry{Source: ConsoleSourcePageError, Level: ConsoleLevelError, Text: "boom"})
+
+	logs = log.read(ConsoleFilter{SinceLastCall: true, MinLevel: ConsoleLevelWarning})
+	require.Len(t, logs.Entries, 2, "only what was logged since the last call")
+	assert.Equal(t, "deprecated API", logs.Entries[0].Text)
+	assert.Equal(t, 1, logs.Errors)
+	assert.Equal(t, 1, logs.PageErrors)
+	assert.Equal(t, 1, logs.Dropped)
+
+	logs = log.read(ConsoleFilter{SinceLastCall: true})
+	assert.Empty(t, logs.Entries)
+
+	logs = log.read(ConsoleFilter{SinceID: 2, Sources: []string{ConsoleSourcePageError}})
+	require.Len(t, logs.Entries, 1)
+	assert.Equal(t, 4, logs.Entries[0].ID)
+
+	logs = log.read(ConsoleFilter{Limit: 1})
+	assert.Equal(t, 3, logs.Matched)
+	require.Len(t, logs.Entries, 1)
+	assert.Equal(t, "boom", logs.Entries[0].Text, "the most recent matches are kept")
+}
+
+func TestConsoleLevel(t *testing.T) {
+	assert.Equal(t, ConsoleLevelError, consoleLevel("assert"))
+	assert.Equal(t, ConsoleLevelWarning, consoleLevel("warning"))
+	assert.Equal(t, ConsoleLevelDebug, consoleLevel("trace"))
+	assert.Equal(t, ConsoleLevelInfo, consoleLevel("table"))
+}
+
+func TestTruncateUTF8(t *testing.T) {
+	assert.Equal(t, "short", truncateUTF8("short", 10))
+	assert.Equal(t, "h…", truncateUTF8("hé", 2), "a character is not split")
+	assert.Len(t, truncateUTF8(strings.Repeat("x", maxConsoleTextBytes*2), maxConsoleTextBytes), maxConsoleTextBytes+len("…"))
+}
+
+func TestGetConsoleLogsCapturesPageErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing.js" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = fmt.Fprint(w, `<script>
+			console.warn('slow network')
+			fetch('http://127.0.0.1:1/unreachable').catch(() => {})
+			setTimeout(() => { undefined.total }, 0)
+		</script>`)
+	}))
+	defer srv.Close()
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium"},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	ctx := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name()})
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL, "networkidle", 10*time.Second)
+	require.NoError(t, err)
+
+	logs, err := service.GetConsoleLogs(ctx, session.ID, ConsoleFilter{SinceLastCall: true, MinLevel: ConsoleLevelWarning})
+	require.NoError(t, err)
+	assert.Equal(t, 1, logs.PageErrors)
+
+	sources := map[string]ConsoleEntry{}
+	for _, entry := range logs.Entries {
+		sources[entry.Source] = entry
+	}
+	assert.Equal(t, "slow network", sources[ConsoleSourceConsole].Text)
+	assert.Equal(t, "TypeError", sources[ConsoleSourcePageError].Type)
+	assert.NotEmpty(t, sources[ConsoleSourcePageError].Stack)
+	assert.Contains(t, sources[ConsoleSourceNetwork].URL, "/unreachable")
+	assert.Equal(t, "tab-1", sources[ConsoleSourcePageError].TabID)
+
+	logs, err = service.GetConsoleLogs(ctx, session.ID, ConsoleFilter{SinceLastCall: true})
+	require.NoError(t, err)
+	assert.Empty(t, logs.Entries, "entries are returned once")
+}
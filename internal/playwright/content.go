@@ -0,0 +1,66 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+)
+
+// PageContent is the rendered markup of the active tab, or of one element
+// of it, as the DOM stands after scripts ran
+type PageContent struct {
+	URL   string `json:"url"`
+	Title string `json:"title"`
+	HTML  string `json:"html"`
+}
+
+// GetPageContent returns the rendered HTML of the active tab. With a
+// selector only that element's outer HTML is returned, looked up in frame
+// the same way element operations do.
+func (p *playwrightImpl) GetPageContent(ctx context.Context, sessionID, selector, frame string, timeout time.Duration) (*PageContent, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	page := session.ActivePage()
+	p.logger.Info("reading page content",
+		zap.String("sessionID", sessionID),
+		zap.String("selector", selector),
+		zap.String("frame", frame))
+
+	var markup string
+	if selector != "" {
+		locator, err := p.locate(page, frame, selector)
+		if err != nil {
+			return nil, err
+		}
+		timeoutMs := float64(timeout.Milliseconds())
+		result, err := locator.First().Evaluate("element => element.outerHTML", nil, playwright.LocatorEvaluateOptions{
+			Timeout: &timeoutMs,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read content of %s: %w", selector, err)
+		}
+		markup, _ = result.(string)
+	} else {
+		markup, err = page.Content()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read page content: %w", err)
+		}
+	}
+
+	title, err := page.Title()
+	if err != nil {
+		title = ""
+	}
+
+	return &PageContent{
+		URL:   page.URL(),
+		Title: title,
+		HTML:  markup,
+	}, nil
+}
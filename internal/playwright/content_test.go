@@ -0,0 +1,50 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestGetPageContentReturnsRenderedDOM(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `<title>Shell</title><main id="app"></main>
+			<script>document.getElementById('app').innerHTML = '<h1>Rendered</h1><p id="lead">Hydrated text</p>'</script>`)
+	}))
+	defer srv.Close()
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium"},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	ctx := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name()})
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL, "load", 10*time.Second)
+	require.NoError(t, err)
+
+	content, err := service.GetPageContent(ctx, session.ID, "", "", 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "Shell", content.Title)
+	assert.Contains(t, content.HTML, "<h1>Rendered</h1>")
+
+	content, err = service.GetPageContent(ctx, session.ID, "#lead", "", 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, `<p id="lead">Hydrated text</p>`, content.HTML)
+}
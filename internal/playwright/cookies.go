@@ -0,0 +1,201 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+)
+
+// SameSiteValues are the SameSite attributes a cookie may have
+var SameSiteValues = []string{"Strict", "Lax", "None"}
+
+// Cookie is a cookie of a session's context. HTTPOnly cookies are included:
+// pages cannot read them from scripts, but they are sent with requests.
+type Cookie struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	// URL sets Domain, Path and Secure from a URL instead; only used when
+	// setting cookies
+	URL    string `json:"url,omitempty"`
+	Domain string `json:"domain,omitempty"`
+	Path   string `json:"path,omitempty"`
+	// Expires is a Unix time in seconds, or -1 for a session cookie
+	Expires  float64 `json:"expires,omitempty"`
+	HTTPOnly bool    `json:"http_only"`
+	Secure   bool    `json:"secure"`
+	SameSite string  `json:"same_site,omitempty"`
+}
+
+// CookieFilter selects cookies. Zero values match everything.
+type CookieFilter struct {
+	// Domain matches cookies of the domain and its subdomains
+	Domain string
+	Name   string
+	Path   string
+	// HTTPOnly, when set, keeps only cookies whose HttpOnly flag equals it
+	HTTPOnly *bool
+}
+
+// matches reports whether cookie passes the filter
+func (f CookieFilter) matches(cookie playwright.Cookie) bool {
+	if f.Domain != "" && !cookieDomainMatches(cookie.Domain, f.Domain) {
+		return false
+	}
+	if f.Name != "" && cookie.Name != f.Name {
+		return false
+	}
+	if f.Path != "" && cookie.Path != f.Path {
+		return false
+	}
+	return f.HTTPOnly == nil || cookie.HttpOnly == *f.HTTPOnly
+}
+
+// empty reports whether the filter matches every cookie
+func (f CookieFilter) empty() bool {
+	return f.Domain == "" && f.Name == "" && f.Path == "" && f.HTTPOnly == nil
+}
+
+// cookieDomainMatches reports whether a cookie set for cookieDomain belongs
+// to domain or one of its subdomains
+func cookieDomainMatches(cookieDomain, domain string) bool {
+	cookieDomain = strings.TrimPrefix(strings.ToLower(cookieDomain), ".")
+	domain = strings.TrimPrefix(strings.ToLower(domain), ".")
+	return cookieDomain == domain || strings.HasSuffix(cookieDomain, "."+domain)
+}
+
+// GetCookies returns the cookies of the session's context that match filter
+func (p *playwrightImpl) GetCookies(ctx context.Context, sessionID string, filter CookieFilter) ([]Cookie, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	cookies, err := session.Context.Cookies()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cookies: %w", err)
+	}
+
+	matched := []Cookie{}
+	for _, cookie := range cookies {
+		if filter.matches(cookie) {
+			matched = append(matched, fromPlaywrightCookie(cookie))
+		}
+	}
+	return matched, nil
+}
+
+// SetCookies adds cookies to the session's context, replacing cookies with
+// the same name, domain and path. Each cookie needs a URL, or a domain and
+// path.
+func (p *playwrightImpl) SetCookies(ctx context.Context, sessionID string, cookies []Cookie) error {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return err
+	}
+
+	optional := make([]playwright.OptionalCookie, 0, len(cookies))
+	for _, cookie := range cookies {
+		if cookie.URL == "" && cookie.Domain == "" {
+			return fmt.Errorf("cookie %q needs a url or a domain", cookie.Name)
+		}
+		if cookie.SameSite != "" && !slices.Contains(SameSiteValues, cookie.SameSite) {
+			return fmt.Errorf("cookie %q has invalid same_site %q", cookie.Name, cookie.SameSite)
+		}
+		optional = append(optional, toPlaywrightCookie(cookie))
+	}
+
+	if err := session.Context.AddCookies(optional); err != nil {
+		return fmt.Errorf("failed to set cookies: %w", err)
+	}
+	p.logger.Info("set cookies", zap.String("sessionID", sessionID), zap.Int("count", len(cookies)))
+	return nil
+}
+
+// ClearCookies removes the cookies of the session's context that match
+// filter and returns how many were removed
+func (p *playwrightImpl) ClearCookies(ctx context.Context, sessionID string, filter CookieFilter) (int, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return 0, err
+	}
+	cookies, err := session.Context.Cookies()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read cookies: %w", err)
+	}
+
+	if filter.empty() {
+		if err := session.Context.ClearCookies(); err != nil {
+			return 0, fmt.Errorf("failed to clear cookies: %w", err)
+		}
+		p.logger.Info("cleared cookies", zap.String("sessionID", sessionID), zap.Int("count", len(cookies)))
+		return len(cookies), nil
+	}
+
+	cleared := 0
+	for _, cookie := range cookies {
+		if !filter.matches(cookie) {
+			continue
+		}
+		if err := session.Context.ClearCookies(playwright.BrowserContextClearCookiesOptions{
+			Name:   cookie.Name,
+			Domain: cookie.Domain,
+			Path:   cookie.Path,
+		}); err != nil {
+			return cleared, fmt.Errorf("failed to clear cookie %q: %w", cookie.Name, err)
+		}
+		cleared++
+	}
+	p.logger.Info("cleared cookies", zap.String("sessionID", sessionID), zap.Int("count", cleared))
+	return cleared, nil
+}
+
+// fromPlaywrightCookie converts a cookie read from a context
+func fromPlaywrightCookie(cookie playwright.Cookie) Cookie {
+	result := Cookie{
+		Name:     cookie.Name,
+		Value:    cookie.Value,
+		Domain:   cookie.Domain,
+		Path:     cookie.Path,
+		Expires:  cookie.Expires,
+		HTTPOnly: cookie.HttpOnly,
+		Secure:   cookie.Secure,
+	}
+	if cookie.SameSite != nil {
+		result.SameSite = string(*cookie.SameSite)
+	}
+	return result
+}
+
+// toPlaywrightCookie converts a cookie to add to a context. Secure is only
+// sent when set, so a URL's https scheme can still imply it.
+func toPlaywrightCookie(cookie Cookie) playwright.OptionalCookie {
+	optional := playwright.OptionalCookie{
+		Name:     cookie.Name,
+		Value:    cookie.Value,
+		HttpOnly: playwright.Bool(cookie.HTTPOnly),
+	}
+	if cookie.Secure {
+		optional.Secure = playwright.Bool(true)
+	}
+	if cookie.URL != "" {
+		optional.URL = playwright.String(cookie.URL)
+	} else {
+		optional.Domain = playwright.String(cookie.Domain)
+		path := cookie.Path
+		if path == "" {
+			path = "/"
+		}
+		optional.Path = playwright.String(path)
+	}
+	if cookie.Expires != 0 {
+		optional.Expires = playwright.Float(cookie.Expires)
+	}
+	if cookie.SameSite != "" {
+		sameSite := playwright.SameSiteAttribute(cookie.SameSite)
+		optional.SameSite = &sameSite
+	}
+	return optional
+}
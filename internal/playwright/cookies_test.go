@@ -0,0 +1,119 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestCookieDomainMatches(t *testing.T) {
+	assert.True(t, cookieDomainMatches("example.com", "example.com"))
+	assert.True(t, cookieDomainMatches(".example.com", "example.com"))
+	assert.True(t, cookieDomainMatches("app.Example.com", ".example.com"))
+	assert.False(t, cookieDomainMatches("badexample.com", "example.com"))
+	assert.False(t, cookieDomainMatches("example.com", "app.example.com"))
+}
+
+func TestCookieFilter_Matches(t *testing.T) {
+	cookie := playwright.Cookie{Name: "session", Domain: "app.example.com", Path: "/", HttpOnly: true}
+	httpOnly, scriptVisible := true, false
+
+	assert.True(t, CookieFilter{}.matches(cookie))
+	assert.True(t, CookieFilter{Domain: "example.com", Name: "session", Path: "/", HTTPOnly: &httpOnly}.matches(cookie))
+	assert.False(t, CookieFilter{HTTPOnly: &scriptVisible}.matches(cookie))
+	assert.False(t, CookieFilter{Name: "theme"}.matches(cookie))
+	assert.False(t, CookieFilter{Path: "/app"}.matches(cookie))
+	assert.False(t, CookieFilter{Domain: "other.com"}.matches(cookie))
+}
+
+func TestToPlaywrightCookie(t *testing.T) {
+	byDomain := toPlaywrightCookie(Cookie{Name: "ff", Value: "on", Domain: ".example.com", SameSite: "Lax"})
+	assert.Equal(t, ".example.com", *byDomain.Domain)
+	assert.Equal(t, "/", *byDomain.Path, "a domain cookie defaults to the whole site")
+	assert.Nil(t, byDomain.Secure)
+	assert.Nil(t, byDomain.Expires)
+	assert.Equal(t, playwright.SameSiteAttribute("Lax"), *byDomain.SameSite)
+
+	byURL := toPlaywrightCookie(Cookie{Name: "ff", Value: "on", URL: "https://example.com", Secure: true, Expires: 1900000000})
+	assert.Equal(t, "https://example.com", *byURL.URL)
+	assert.Nil(t, byURL.Domain)
+	assert.Nil(t, byURL.Path)
+	assert.True(t, *byURL.Secure)
+	assert.Equal(t, float64(1900000000), *byURL.Expires)
+}
+
+func TestCookiesAndStorage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "signed-in", Path: "/", HttpOnly: true})
+		}
+		flag := "off"
+		if cookie, err := r.Cookie("ff_checkout"); err == nil {
+			flag = cookie.Value
+		}
+		_, _ = fmt.Fprintf(w, `<title>checkout %s</title><script>localStorage.setItem('theme', 'dark')</script>`, flag)
+	}))
+	defer srv.Close()
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium", DataDir: t.TempDir()},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	ctx := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name()})
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL+"/login", "load", 10*time.Second)
+	require.NoError(t, err)
+
+	httpOnly := true
+	cookies, err := service.GetCookies(ctx, session.ID, CookieFilter{HTTPOnly: &httpOnly})
+	require.NoError(t, err)
+	require.Len(t, cookies, 1)
+	assert.Equal(t, "session", cookies[0].Name, "httpOnly cookies are visible")
+
+	require.NoError(t, service.SetCookies(ctx, session.ID, []Cookie{{Name: "ff_checkout", Value: "on", URL: srv.URL}}))
+	result, err := service.NavigateToURL(ctx, session.ID, srv.URL+"/cart", "load", 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "checkout on", result.Title, "the injected cookie is sent")
+
+	cleared, err := service.ClearCookies(ctx, session.ID, CookieFilter{Name: "session"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, cleared)
+	cookies, err = service.GetCookies(ctx, session.ID, CookieFilter{})
+	require.NoError(t, err)
+	require.Len(t, cookies, 1)
+	assert.Equal(t, "ff_checkout", cookies[0].Name)
+
+	storage, err := service.GetStorage(ctx, session.ID, StorageQuery{Type: LocalStorage})
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL, storage.Origin)
+	assert.Equal(t, map[string]string{"theme": "dark"}, storage.Items)
+
+	storage, err = service.SetStorage(ctx, session.ID, StorageUpdate{
+		Type:   LocalStorage,
+		Set:    map[string]string{"flags": `{"beta":true}`},
+		Remove: []string{"theme"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"flags": `{"beta":true}`}, storage.Items)
+
+	_, err = service.SetStorage(ctx, session.ID, StorageUpdate{Type: LocalStorage, Origin: "https://other.example", Clear: true})
+	assert.ErrorContains(t, err, "only be changed while the active tab is on it")
+}
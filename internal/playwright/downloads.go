@@ -0,0 +1,287 @@
+package playwright
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+)
+
+// downloadsDirName is the directory under BROWSER_DATA_DIR that holds one
+// download directory per task
+const downloadsDirName = "downloads"
+
+// unsafeFileChars are replaced in names used as files or directories
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// DownloadOptions configures WaitForDownload
+type DownloadOptions struct {
+	// Selector is clicked to start the download; when empty WaitForDownload
+	// only waits for one to start
+	Selector string
+	Frame    string
+	// Timeout bounds the wait for the download to start and to finish
+	Timeout time.Duration
+}
+
+// Download is a file a session's page downloaded, saved to the task's
+// download directory
+type Download struct {
+	Path     string `json:"path"`
+	Filename string `json:"filename"`
+	// SuggestedFilename is the name the page gave the file, before it was
+	// made safe and unique
+	SuggestedFilename string `json:"suggested_filename"`
+	URL               string `json:"url"`
+	Size              int64  `json:"size"`
+	MimeType          string `json:"mime_type"`
+	SHA256            string `json:"sha256"`
+	TabID             string `json:"tab_id,omitempty"`
+}
+
+// WaitForDownload clicks Selector, when given, and waits for a download to
+// start in any tab of the session. The file is saved under the task's
+// download directory in BROWSER_DATA_DIR.
+func (p *playwrightImpl) WaitForDownload(ctx context.Context, sessionID string, options DownloadOptions) (*Download, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = 30 * time.Second
+	}
+	deadline := time.NewTimer(options.Timeout)
+	defer deadline.Stop()
+
+	downloads := make(chan playwright.Download, 1)
+	onDownload := func(download playwright.Download) {
+		select {
+		case downloads <- download:
+		default:
+		}
+	}
+
+	// Listen on every tab, and on tabs opened by the click, since a
+	// download link may target a new window
+	tabs, _ := snapshotTabs(session)
+	for _, tab := range tabs {
+		tab.page.OnDownload(onDownload)
+		defer tab.page.RemoveListener("download", onDownload)
+	}
+	// Listeners added to new tabs stay after the wait; once the channel is
+	// full they drop what they receive
+	onPage := func(page playwright.Page) {
+		page.OnDownload(onDownload)
+	}
+	if session.Context != nil {
+		session.Context.OnPage(onPage)
+		defer session.Context.RemoveListener("page", onPage)
+	}
+
+	if options.Selector != "" {
+		locator, err := p.locate(session.ActivePage(), options.Frame, options.Selector)
+		if err != nil {
+			return nil, err
+		}
+		timeoutMs := float64(options.Timeout.Milliseconds())
+		p.logger.Info("clicking to start download", zap.String("sessionID", sessionID), zap.String("selector", options.Selector))
+		if err := locator.Click(playwright.LocatorClickOptions{Timeout: &timeoutMs}); err != nil {
+			return nil, fmt.Errorf("failed to click %s: %w", options.Selector, err)
+		}
+	}
+
+	var download playwright.Download
+	select {
+	case download = <-downloads:
+	case <-deadline.C:
+		return nil, fmt.Errorf("no download started within %s", options.Timeout)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	dir, err := p.downloadDir(session)
+	if err != nil {
+		return nil, err
+	}
+	path, err := uniquePath(dir, download.SuggestedFilename())
+	if err != nil {
+		return nil, err
+	}
+
+	// SaveAs waits for the download to finish
+	saved := make(chan error, 1)
+	go func() {
+		saved <- download.SaveAs(path)
+	}()
+	select {
+	case err = <-saved:
+	case <-deadline.C:
+		err = fmt.Errorf("download did not finish within %s", options.Timeout)
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	if err != nil {
+		if cancelErr := download.Cancel(); cancelErr != nil {
+			p.logger.Debug("failed to cancel download", zap.Error(cancelErr))
+		}
+		return nil, fmt.Errorf("failed to save download %s: %w", download.URL(), err)
+	}
+
+	result, err := describeDownload(path)
+	if err != nil {
+		return nil, err
+	}
+	result.SuggestedFilename = download.SuggestedFilename()
+	result.URL = download.URL()
+	result.TabID = session.tabIDOf(download.Page())
+
+	p.logger.Info("download saved",
+		zap.String("sessionID", sessionID),
+		zap.String("url", result.URL),
+		zap.String("path", path),
+		zap.Int64("size", result.Size))
+	return result, nil
+}
+
+// downloadDir returns the session's task download directory, creating it
+func (p *playwrightImpl) downloadDir(session *BrowserSession) (string, error) {
+	dir := p.downloadPath(session)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create download directory: %w", err)
+	}
+	return dir, nil
+}
+
+// downloadPath is the session's task download directory
+func (p *playwrightImpl) downloadPath(session *BrowserSession) string {
+	owner := session.TaskID
+	if owner == "" {
+		owner = session.ID
+	}
+	return filepath.Join(p.config.Browser.DataDir, downloadsDirName, unsafeFileChars.ReplaceAllString(owner, "_"))
+}
+
+// DownloadStored records that a file WaitForDownload saved for the session
+// is kept elsewhere, such as in a task artifact, so the local copy is
+// deleted when the session closes. Downloads never reported here stay on
+// disk, since their path may be all the task was given.
+func (p *playwrightImpl) DownloadStored(ctx context.Context, sessionID, path string) error {
+	p.sessionsMux.Lock()
+	defer p.sessionsMux.Unlock()
+
+	session, exists := p.sessions[sessionID]
+	if !exists {
+		return fmt.Errorf("session not found: %s", sessionID)
+	}
+	dir := p.downloadPath(session)
+	if !strings.HasPrefix(filepath.Clean(path), dir+string(filepath.Separator)) {
+		return fmt.Errorf("%s is not a download of session %s", path, sessionID)
+	}
+	session.storedDownloads = append(session.storedDownloads, path)
+	return nil
+}
+
+// removeDownloads deletes the session's downloads that were stored
+// elsewhere, and the task download directory once nothing else is left in
+// it. p.sessionsMux must be held.
+func (p *playwrightImpl) removeDownloads(session *BrowserSession) {
+	if p.config == nil {
+		return
+	}
+	for _, path := range session.storedDownloads {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			p.logger.Warn("failed to remove stored download",
+				zap.String("sessionID", session.ID),
+				zap.String("path", path),
+				zap.Error(err))
+		}
+	}
+	session.storedDownloads = nil
+
+	dir := p.downloadPath(session)
+	if entries, err := os.ReadDir(dir); err != nil || len(entries) > 0 {
+		return
+	}
+	if err := os.Remove(dir); err != nil {
+		p.logger.Warn("failed to remove task download directory",
+			zap.String("sessionID", session.ID),
+			zap.String("dir", dir),
+			zap.Error(err))
+	}
+}
+
+// uniquePath returns a path in dir for a file named like suggested that does
+// not exist yet, adding -1, -2, ... before the extension when needed
+func uniquePath(dir, suggested string) (string, error) {
+	name := unsafeFileChars.ReplaceAllString(filepath.Base(suggested), "_")
+	name = strings.Trim(name, "._")
+	if name == "" {
+		name = "download"
+	}
+	ext := filepath.Ext(name)
+	stem := strings.TrimSuffix(name, ext)
+
+	for i := 0; i < 1000; i++ {
+		candidate := name
+		if i > 0 {
+			candidate = fmt.Sprintf("%s-%d%s", stem, i, ext)
+		}
+		path := filepath.Join(dir, candidate)
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("too many downloads named %s", name)
+}
+
+// describeDownload reads a saved file for its size, MIME type and sha256.
+// The type comes from the extension, or is sniffed from the content.
+func describeDownload(path string) (*Download, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open download: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, fmt.Errorf("failed to read download: %w", err)
+	}
+	head = head[:n]
+
+	hash := sha256.New()
+	hash.Write(head)
+	rest, err := io.Copy(hash, file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read download: %w", err)
+	}
+
+	mimeType := mime.TypeByExtension(filepath.Ext(path))
+	if mimeType == "" {
+		mimeType = http.DetectContentType(head)
+	}
+	if mediaType, _, err := mime.ParseMediaType(mimeType); err == nil {
+		mimeType = mediaType
+	}
+
+	return &Download{
+		Path:     path,
+		Filename: filepath.Base(path),
+		Size:     int64(n) + rest,
+		MimeType: mimeType,
+		SHA256:   hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
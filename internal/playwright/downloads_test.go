@@ -0,0 +1,153 @@
+package playwright
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestUniquePath(t *testing.T) {
+	dir := t.TempDir()
+
+	path, err := uniquePath(dir, "../../etc/quarterly report.csv")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "quarterly_report.csv"), path, "the name is made safe and kept in dir")
+
+	require.NoError(t, os.WriteFile(path, nil, 0644))
+	path, err = uniquePath(dir, "quarterly report.csv")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "quarterly_report-1.csv"), path)
+
+	path, err = uniquePath(dir, "..")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "download"), path)
+}
+
+func TestCloseSessionRemovesStoredDownloads(t *testing.T) {
+	dataDir := t.TempDir()
+	session := &BrowserSession{ID: "task-1", TaskID: "task-1"}
+	other := &BrowserSession{ID: "task-2", TaskID: "task-2"}
+	p := &playwrightImpl{
+		logger:   zap.NewNop(),
+		config:   &config.Config{Browser: config.BrowserConfig{DataDir: dataDir}},
+		sessions: map[string]*BrowserSession{"task-1": session, "task-2": other},
+	}
+
+	paths := map[*BrowserSession]string{}
+	for _, s := range []*BrowserSession{session, other} {
+		dir, err := p.downloadDir(s)
+		require.NoError(t, err)
+		paths[s] = filepath.Join(dir, "report.csv")
+		require.NoError(t, os.WriteFile(paths[s], []byte("id\n"), 0644))
+	}
+	require.NoError(t, p.DownloadStored(context.Background(), "task-1", paths[session]))
+	require.NoError(t, p.DownloadStored(context.Background(), "task-2", paths[other]))
+	assert.ErrorContains(t, p.DownloadStored(context.Background(), "task-1", filepath.Join(dataDir, "browser-state")), "is not a download of session task-1")
+
+	p.closeSession(session)
+	assert.NoDirExists(t, filepath.Join(dataDir, downloadsDirName, "task-1"))
+	assert.FileExists(t, paths[other], "other tasks keep their downloads")
+}
+
+func TestCloseSessionKeepsDownloadsReturnedByPath(t *testing.T) {
+	dataDir := t.TempDir()
+	session := &BrowserSession{ID: "task-1", TaskID: "task-1"}
+	p := &playwrightImpl{
+		logger:   zap.NewNop(),
+		config:   &config.Config{Browser: config.BrowserConfig{DataDir: dataDir}},
+		sessions: map[string]*BrowserSession{"task-1": session},
+	}
+
+	dir, err := p.downloadDir(session)
+	require.NoError(t, err)
+	stored := filepath.Join(dir, "report.csv")
+	require.NoError(t, os.WriteFile(stored, []byte("id\n"), 0644))
+	returned := filepath.Join(dir, "invoice.pdf")
+	require.NoError(t, os.WriteFile(returned, []byte("%PDF-1.7"), 0644))
+	require.NoError(t, p.DownloadStored(context.Background(), "task-1", stored))
+
+	p.closeSession(session)
+	assert.NoFileExists(t, stored)
+	assert.FileExists(t, returned, "a download only handed to the task by path outlives the session")
+}
+
+func TestDescribeDownload(t *testing.T) {
+	dir := t.TempDir()
+	content := []byte("[" + strings.Repeat(`{"id":1},`, 100) + "{}]")
+	sum := sha256.Sum256(content)
+
+	jsonPath := filepath.Join(dir, "orders.json")
+	require.NoError(t, os.WriteFile(jsonPath, content, 0644))
+	download, err := describeDownload(jsonPath)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), download.Size)
+	assert.Equal(t, hex.EncodeToString(sum[:]), download.SHA256)
+	assert.Equal(t, "application/json", download.MimeType)
+	assert.Equal(t, "orders.json", download.Filename)
+
+	noExt := filepath.Join(dir, "export")
+	require.NoError(t, os.WriteFile(noExt, []byte("%PDF-1.7\n"), 0644))
+	download, err = describeDownload(noExt)
+	require.NoError(t, err)
+	assert.Equal(t, "application/pdf", download.MimeType, "the type is sniffed without an extension")
+}
+
+func TestWaitForDownloadSavesFile(t *testing.T) {
+	const report = "id,total\n1,9.50\n"
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `<a id="export" href="/export">Export</a>`)
+	})
+	mux.HandleFunc("/export", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="report.csv"`)
+		_, _ = fmt.Fprint(w, report)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	dataDir := t.TempDir()
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium", DataDir: dataDir},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	ctx := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: "task-downloads"})
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL, "load", 10*time.Second)
+	require.NoError(t, err)
+
+	download, err := service.WaitForDownload(ctx, session.ID, DownloadOptions{Selector: "#export", Timeout: 10 * time.Second})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dataDir, "downloads", "task-downloads", "report.csv"), download.Path)
+	assert.Equal(t, int64(len(report)), download.Size)
+	assert.Equal(t, srv.URL+"/export", download.URL)
+
+	saved, err := os.ReadFile(download.Path)
+	require.NoError(t, err)
+	assert.Equal(t, report, string(saved))
+
+	_, err = service.WaitForDownload(ctx, session.ID, DownloadOptions{Timeout: 200 * time.Millisecond})
+	assert.ErrorContains(t, err, "no download started")
+}
@@ -40,7 +40,7 @@ func TestEngineEndToEnd(t *testing.T) {
 			ViewportWidth:  "1920",
 			ViewportHeight: "1080",
 		},
-	})
+	}, nil)
 	require.NoError(t, err)
 	defer func() { require.NoError(t, service.Shutdown(context.Background())) }()
 
@@ -48,7 +48,8 @@ func TestEngineEndToEnd(t *testing.T) {
 	require.NoError(t, err)
 	defer func() { _ = service.CloseBrowser(context.Background(), session.ID) }()
 
-	require.NoError(t, service.NavigateToURL(context.Background(), session.ID, "https://example.com", "load", 30*time.Second))
+	_, err = service.NavigateToURL(context.Background(), session.ID, "https://example.com", "load", 30*time.Second)
+	require.NoError(t, err)
 
 	h1, err := session.Page.Locator("h1").TextContent()
 	require.NoError(t, err)
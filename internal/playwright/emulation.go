@@ -0,0 +1,288 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+)
+
+// ColorSchemes are the color schemes a context can emulate
+var ColorSchemes = []string{"light", "dark", "no-preference"}
+
+// ReducedMotions are the prefers-reduced-motion values a context can emulate
+var ReducedMotions = []string{"reduce", "no-preference"}
+
+// reopenTimeout bounds loading the active tab's page again in a context
+// rebuilt while a task runs
+const reopenTimeout = 30 * time.Second
+
+// maxDeviceSuggestions is how many device names an unknown device error
+// suggests
+const maxDeviceSuggestions = 10
+
+// Geolocation is a position a context reports to pages allowed to read it
+type Geolocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// Accuracy is in meters
+	Accuracy float64 `json:"accuracy,omitempty"`
+}
+
+// BrowserEmulation changes what device, locale and preferences a session's
+// context emulates. Zero fields keep the current setting. Device applies a
+// Playwright device descriptor first, so the other fields can adjust it.
+type BrowserEmulation struct {
+	Device            string
+	ViewportWidth     int
+	ViewportHeight    int
+	DeviceScaleFactor float64
+	IsMobile          *bool
+	HasTouch          *bool
+	UserAgent         string
+	Locale            string
+	TimezoneID        string
+	Geolocation       *Geolocation
+	// Permissions replaces the permissions granted to every origin when
+	// not nil. Geolocation adds the geolocation permission.
+	Permissions   []string
+	ColorScheme   string
+	ReducedMotion string
+}
+
+// EmulationSettings is what a session's context emulates
+type EmulationSettings struct {
+	Device            string       `json:"device,omitempty"`
+	ViewportWidth     int          `json:"viewport_width"`
+	ViewportHeight    int          `json:"viewport_height"`
+	DeviceScaleFactor float64      `json:"device_scale_factor,omitempty"`
+	IsMobile          bool         `json:"is_mobile"`
+	HasTouch          bool         `json:"has_touch"`
+	UserAgent         string       `json:"user_agent,omitempty"`
+	Locale            string       `json:"locale,omitempty"`
+	TimezoneID        string       `json:"timezone,omitempty"`
+	Geolocation       *Geolocation `json:"geolocation,omitempty"`
+	Permissions       []string     `json:"permissions,omitempty"`
+	ColorScheme       string       `json:"color_scheme,omitempty"`
+	ReducedMotion     string       `json:"reduced_motion,omitempty"`
+	// Reopened is the URL loaded again in the new context, if the active
+	// tab was on a web page
+	Reopened string `json:"reopened,omitempty"`
+}
+
+// validate checks the values Playwright would only reject when the new
+// context is created
+func (e BrowserEmulation) validate() error {
+	if e.ViewportWidth < 0 || e.ViewportHeight < 0 {
+		return fmt.Errorf("viewport size must be positive")
+	}
+	if e.DeviceScaleFactor < 0 {
+		return fmt.Errorf("device scale factor must be positive")
+	}
+	if e.ColorScheme != "" && !slices.Contains(ColorSchemes, e.ColorScheme) {
+		return fmt.Errorf("unknown color scheme %q", e.ColorScheme)
+	}
+	if e.ReducedMotion != "" && !slices.Contains(ReducedMotions, e.ReducedMotion) {
+		return fmt.Errorf("unknown reduced motion %q", e.ReducedMotion)
+	}
+	if g := e.Geolocation; g != nil {
+		if g.Latitude < -90 || g.Latitude > 90 || g.Longitude < -180 || g.Longitude > 180 || g.Accuracy < 0 {
+			return fmt.Errorf("geolocation needs a latitude between -90 and 90, a longitude between -180 and 180 and a non-negative accuracy")
+		}
+	}
+	return nil
+}
+
+// apply sets the emulation on context options, the device descriptor first
+func (e BrowserEmulation) apply(opts *playwright.BrowserNewContextOptions, device *playwright.DeviceDescriptor) {
+	if device != nil {
+		if device.Viewport != nil {
+			opts.Viewport = &playwright.Size{Width: device.Viewport.Width, Height: device.Viewport.Height}
+		}
+		if device.Screen != nil {
+			opts.Screen = &playwright.Size{Width: device.Screen.Width, Height: device.Screen.Height}
+		}
+		opts.UserAgent = playwright.String(device.UserAgent)
+		opts.DeviceScaleFactor = playwright.Float(device.DeviceScaleFactor)
+		opts.IsMobile = playwright.Bool(device.IsMobile)
+		opts.HasTouch = playwright.Bool(device.HasTouch)
+	}
+
+	if e.ViewportWidth > 0 || e.ViewportHeight > 0 {
+		viewport := playwright.Size{}
+		if opts.Viewport != nil {
+			viewport = *opts.Viewport
+		}
+		if e.ViewportWidth > 0 {
+			viewport.Width = e.ViewportWidth
+		}
+		if e.ViewportHeight > 0 {
+			viewport.Height = e.ViewportHeight
+		}
+		opts.Viewport = &viewport
+		// A screen smaller than the viewport left over from a device would
+		// confuse media queries
+		opts.Screen = nil
+	}
+	if e.DeviceScaleFactor > 0 {
+		opts.DeviceScaleFactor = playwright.Float(e.DeviceScaleFactor)
+	}
+	if e.IsMobile != nil {
+		opts.IsMobile = playwright.Bool(*e.IsMobile)
+	}
+	if e.HasTouch != nil {
+		opts.HasTouch = playwright.Bool(*e.HasTouch)
+	}
+	if e.UserAgent != "" {
+		opts.UserAgent = playwright.String(e.UserAgent)
+	}
+	if e.Locale != "" {
+		opts.Locale = playwright.String(e.Locale)
+	}
+	if e.TimezoneID != "" {
+		opts.TimezoneId = playwright.String(e.TimezoneID)
+	}
+	if e.Permissions != nil {
+		opts.Permissions = slices.Clone(e.Permissions)
+	}
+	if g := e.Geolocation; g != nil {
+		opts.Geolocation = &playwright.Geolocation{
+			Latitude:  g.Latitude,
+			Longitude: g.Longitude,
+			Accuracy:  playwright.Float(g.Accuracy),
+		}
+		if !slices.Contains(opts.Permissions, "geolocation") {
+			opts.Permissions = append(slices.Clone(opts.Permissions), "geolocation")
+		}
+	}
+	if e.ColorScheme != "" {
+		opts.ColorScheme = (*playwright.ColorScheme)(playwright.String(e.ColorScheme))
+	}
+	if e.ReducedMotion != "" {
+		opts.ReducedMotion = (*playwright.ReducedMotion)(playwright.String(e.ReducedMotion))
+	}
+}
+
+// emulationSettings describes what context options emulate
+func emulationSettings(opts playwright.BrowserNewContextOptions, device string) *EmulationSettings {
+	settings := &EmulationSettings{Device: device, Permissions: opts.Permissions}
+	if opts.Viewport != nil {
+		settings.ViewportWidth = opts.Viewport.Width
+		settings.ViewportHeight = opts.Viewport.Height
+	}
+	if opts.DeviceScaleFactor != nil {
+		settings.DeviceScaleFactor = *opts.DeviceScaleFactor
+	}
+	if opts.IsMobile != nil {
+		settings.IsMobile = *opts.IsMobile
+	}
+	if opts.HasTouch != nil {
+		settings.HasTouch = *opts.HasTouch
+	}
+	if opts.UserAgent != nil {
+		settings.UserAgent = *opts.UserAgent
+	}
+	if opts.Locale != nil {
+		settings.Locale = *opts.Locale
+	}
+	if opts.TimezoneId != nil {
+		settings.TimezoneID = *opts.TimezoneId
+	}
+	if g := opts.Geolocation; g != nil {
+		settings.Geolocation = &Geolocation{Latitude: g.Latitude, Longitude: g.Longitude}
+		if g.Accuracy != nil {
+			settings.Geolocation.Accuracy = *g.Accuracy
+		}
+	}
+	if opts.ColorScheme != nil {
+		settings.ColorScheme = string(*opts.ColorScheme)
+	}
+	if opts.ReducedMotion != nil {
+		settings.ReducedMotion = string(*opts.ReducedMotion)
+	}
+	return settings
+}
+
+// lookupDevice finds a Playwright device descriptor by name, ignoring case.
+// An unknown name lists the devices that share its first word.
+func lookupDevice(devices map[string]*playwright.DeviceDescriptor, name string) (string, *playwright.DeviceDescriptor, error) {
+	if len(devices) == 0 {
+		return "", nil, fmt.Errorf("device descriptors are not available with this browser connection")
+	}
+	for known, descriptor := range devices {
+		if strings.EqualFold(known, name) {
+			return known, descriptor, nil
+		}
+	}
+
+	var similar []string
+	if words := strings.Fields(name); len(words) > 0 {
+		prefix := strings.ToLower(words[0])
+		for known := range devices {
+			if strings.HasPrefix(strings.ToLower(known), prefix) {
+				similar = append(similar, known)
+			}
+		}
+	}
+	sort.Strings(similar)
+	if len(similar) == 0 {
+		return "", nil, fmt.Errorf("unknown device %q; use a Playwright device name such as \"iPhone 13\", \"Pixel 7\" or \"Desktop Chrome\"", name)
+	}
+	if len(similar) > maxDeviceSuggestions {
+		similar = similar[:maxDeviceSuggestions]
+	}
+	return "", nil, fmt.Errorf("unknown device %q; similar devices: %s", name, strings.Join(similar, ", "))
+}
+
+// ConfigureBrowser recreates the session's context with the given device
+// emulation, on top of what the context already emulates. Options such as
+// the viewport of a mobile device or the timezone can only be set when a
+// context is created. Cookies and local storage are carried over, and the
+// active tab's page is loaded again, so it can be called before the first
+// navigation or in the middle of a task.
+func (p *playwrightImpl) ConfigureBrowser(ctx context.Context, sessionID string, emulation BrowserEmulation) (*EmulationSettings, error) {
+	if err := emulation.validate(); err != nil {
+		return nil, err
+	}
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	device := session.device
+	var descriptor *playwright.DeviceDescriptor
+	if emulation.Device != "" {
+		var devices map[string]*playwright.DeviceDescriptor
+		if p.pw != nil {
+			devices = p.pw.Devices
+		}
+		if device, descriptor, err = lookupDevice(devices, emulation.Device); err != nil {
+			return nil, err
+		}
+	}
+
+	reopened, err := p.rebuildContext(session, func(opts *playwright.BrowserNewContextOptions) {
+		emulation.apply(opts, descriptor)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply browser settings: %w", err)
+	}
+	session.tabsMux.Lock()
+	session.device = device
+	settings := emulationSettings(session.contextOptions, device)
+	session.tabsMux.Unlock()
+	settings.Reopened = reopened
+
+	p.logger.Info("browser configured",
+		zap.String("sessionID", sessionID),
+		zap.String("device", device),
+		zap.Int("viewportWidth", settings.ViewportWidth),
+		zap.Int("viewportHeight", settings.ViewportHeight),
+		zap.Bool("isMobile", settings.IsMobile))
+	return settings, nil
+}
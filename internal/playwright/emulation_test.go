@@ -0,0 +1,145 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+var testDevices = map[string]*playwright.DeviceDescriptor{
+	"iPhone 13": {
+		UserAgent:         "Mozilla/5.0 (iPhone; CPU iPhone OS 15_0 like Mac OS X)",
+		Viewport:          &playwright.Size{Width: 390, Height: 664},
+		Screen:            &playwright.Size{Width: 390, Height: 844},
+		DeviceScaleFactor: 3,
+		IsMobile:          true,
+		HasTouch:          true,
+	},
+	"iPhone 13 landscape": {Viewport: &playwright.Size{Width: 750, Height: 342}},
+	"Pixel 7":             {Viewport: &playwright.Size{Width: 412, Height: 839}},
+}
+
+func TestLookupDevice(t *testing.T) {
+	name, descriptor, err := lookupDevice(testDevices, "iphone 13")
+	require.NoError(t, err)
+	assert.Equal(t, "iPhone 13", name)
+	assert.Equal(t, 390, descriptor.Viewport.Width)
+
+	_, _, err = lookupDevice(testDevices, "iPhone 99")
+	assert.EqualError(t, err, `unknown device "iPhone 99"; similar devices: iPhone 13, iPhone 13 landscape`)
+	_, _, err = lookupDevice(testDevices, "Nokia 3310")
+	assert.ErrorContains(t, err, "use a Playwright device name")
+	_, _, err = lookupDevice(nil, "iPhone 13")
+	assert.ErrorContains(t, err, "not available")
+}
+
+func TestBrowserEmulation_Validate(t *testing.T) {
+	assert.NoError(t, BrowserEmulation{ColorScheme: "dark", ReducedMotion: "reduce"}.validate())
+	assert.ErrorContains(t, BrowserEmulation{ViewportWidth: -1}.validate(), "viewport")
+	assert.ErrorContains(t, BrowserEmulation{ColorScheme: "sepia"}.validate(), "color scheme")
+	assert.ErrorContains(t, BrowserEmulation{ReducedMotion: "none"}.validate(), "reduced motion")
+	assert.ErrorContains(t, BrowserEmulation{Geolocation: &Geolocation{Latitude: 100}}.validate(), "latitude")
+}
+
+func TestBrowserEmulation_Apply(t *testing.T) {
+	opts := playwright.BrowserNewContextOptions{
+		Viewport:  &playwright.Size{Width: 1280, Height: 720},
+		UserAgent: playwright.String("desktop"),
+		Locale:    playwright.String("en-US"),
+	}
+	isMobile := false
+	BrowserEmulation{
+		ViewportHeight: 700,
+		IsMobile:       &isMobile,
+		TimezoneID:     "Asia/Tokyo",
+		Geolocation:    &Geolocation{Latitude: 35.68, Longitude: 139.69},
+		Permissions:    []string{"notifications"},
+		ColorScheme:    "dark",
+	}.apply(&opts, testDevices["iPhone 13"])
+
+	assert.Equal(t, &playwright.Size{Width: 390, Height: 700}, opts.Viewport, "explicit sizes adjust the device's")
+	assert.Nil(t, opts.Screen)
+	assert.Equal(t, "Mozilla/5.0 (iPhone; CPU iPhone OS 15_0 like Mac OS X)", *opts.UserAgent)
+	assert.Equal(t, 3.0, *opts.DeviceScaleFactor)
+	assert.False(t, *opts.IsMobile, "explicit flags override the device's")
+	assert.True(t, *opts.HasTouch)
+	assert.Equal(t, "en-US", *opts.Locale, "unset fields keep the current setting")
+	assert.Equal(t, "Asia/Tokyo", *opts.TimezoneId)
+	assert.Equal(t, []string{"notifications", "geolocation"}, opts.Permissions)
+	assert.Equal(t, playwright.ColorSchemeDark, opts.ColorScheme)
+
+	settings := emulationSettings(opts, "iPhone 13")
+	assert.Equal(t, "iPhone 13", settings.Device)
+	assert.Equal(t, 390, settings.ViewportWidth)
+	assert.Equal(t, "dark", settings.ColorScheme)
+	assert.Equal(t, &Geolocation{Latitude: 35.68, Longitude: 139.69}, settings.Geolocation)
+}
+
+func TestConfigureBrowser(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "signed-in", Path: "/"})
+		}
+		_, _ = fmt.Fprint(w, `<title>shop</title>`)
+	}))
+	defer srv.Close()
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium", DataDir: t.TempDir()},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	ctx := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name()})
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL+"/login", "load", 10*time.Second)
+	require.NoError(t, err)
+
+	settings, err := service.ConfigureBrowser(ctx, session.ID, BrowserEmulation{
+		Device:      "iPhone 13",
+		Locale:      "de-DE",
+		TimezoneID:  "Europe/Berlin",
+		ColorScheme: "dark",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "iPhone 13", settings.Device)
+	assert.True(t, settings.IsMobile)
+	assert.Equal(t, srv.URL+"/login", settings.Reopened)
+
+	emulated, err := service.ExecuteScript(ctx, session.ID, `() => ({
+		width: window.innerWidth,
+		touch: navigator.maxTouchPoints > 0,
+		language: navigator.language,
+		timezone: Intl.DateTimeFormat().resolvedOptions().timeZone,
+		dark: matchMedia('(prefers-color-scheme: dark)').matches,
+		cookie: document.cookie,
+	})`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"width":    settings.ViewportWidth,
+		"touch":    true,
+		"language": "de-DE",
+		"timezone": "Europe/Berlin",
+		"dark":     true,
+		"cookie":   "session=signed-in",
+	}, emulated, "the new context emulates the device and keeps the cookies")
+
+	_, err = service.ConfigureBrowser(ctx, session.ID, BrowserEmulation{Device: "Nokia 3310"})
+	assert.ErrorContains(t, err, "unknown device")
+}
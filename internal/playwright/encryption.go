@@ -0,0 +1,217 @@
+package playwright
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	zap "go.uber.org/zap"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+// encryptedStateMagic starts every encrypted storage state file. Plain
+// files are Playwright's JSON and start with "{".
+var encryptedStateMagic = []byte("PWSTATE1")
+
+// stateKeySize is the size of the AES-256 keys storage states are
+// encrypted with
+const stateKeySize = 32
+
+// stateCipher encrypts saved storage states with AES-GCM. Files are
+// written with the current key; previous keys are only tried when
+// reading, so states saved before a key rotation stay readable until they
+// are re-encrypted.
+type stateCipher struct {
+	current  cipher.AEAD
+	previous []cipher.AEAD
+}
+
+// newStateCipher creates the cipher from BROWSER_STATE_ENCRYPTION_KEY or
+// the file at BROWSER_STATE_ENCRYPTION_KEY_FILE, and the keys of
+// BROWSER_STATE_ENCRYPTION_PREVIOUS_KEYS. It returns nil when no key is
+// configured, in which case states are saved in plain JSON.
+func newStateCipher(cfg *config.Config) (*stateCipher, error) {
+	encoded := strings.TrimSpace(cfg.Browser.StateEncryptionKey)
+	if path := cfg.Browser.StateEncryptionKeyFile; path != "" {
+		if encoded != "" {
+			return nil, fmt.Errorf("set only one of BROWSER_STATE_ENCRYPTION_KEY and BROWSER_STATE_ENCRYPTION_KEY_FILE")
+		}
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read state encryption key file: %w", err)
+		}
+		encoded = strings.TrimSpace(string(raw))
+	}
+	if encoded == "" {
+		if strings.TrimSpace(cfg.Browser.StateEncryptionPreviousKeys) != "" {
+			return nil, fmt.Errorf("BROWSER_STATE_ENCRYPTION_PREVIOUS_KEYS needs a current key to re-encrypt with")
+		}
+		return nil, nil
+	}
+
+	current, err := stateAEAD(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid state encryption key: %w", err)
+	}
+	c := &stateCipher{current: current}
+	for i, encoded := range strings.Split(cfg.Browser.StateEncryptionPreviousKeys, ",") {
+		encoded = strings.TrimSpace(encoded)
+		if encoded == "" {
+			continue
+		}
+		previous, err := stateAEAD(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid previous state encryption key %d: %w", i+1, err)
+		}
+		c.previous = append(c.previous, previous)
+	}
+	return c, nil
+}
+
+// stateAEAD creates an AES-GCM cipher from a base64-encoded 32-byte key
+func stateAEAD(encoded string) (cipher.AEAD, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("key is not base64: %w", err)
+	}
+	if len(key) != stateKeySize {
+		return nil, fmt.Errorf("key is %d bytes, want %d", len(key), stateKeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encode returns the bytes to save a storage state as: encrypted with the
+// current key, or as is when encryption is off
+func (c *stateCipher) encode(state []byte) ([]byte, error) {
+	if c == nil {
+		return state, nil
+	}
+	nonce := make([]byte, c.current.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := append(bytes.Clone(encryptedStateMagic), nonce...)
+	return c.current.Seal(sealed, nonce, state, encryptedStateMagic), nil
+}
+
+// decode returns the storage state saved in data. stale reports that the
+// file should be written again: it is plain while encryption is on, or
+// was encrypted with a previous key.
+func (c *stateCipher) decode(data []byte) (state []byte, stale bool, err error) {
+	if !bytes.HasPrefix(data, encryptedStateMagic) {
+		return data, c != nil, nil
+	}
+	if c == nil {
+		return nil, false, fmt.Errorf("storage state is encrypted but no state encryption key is configured")
+	}
+
+	payload := data[len(encryptedStateMagic):]
+	for i, aead := range append([]cipher.AEAD{c.current}, c.previous...) {
+		if len(payload) < aead.NonceSize() {
+			break
+		}
+		nonce, sealed := payload[:aead.NonceSize()], payload[aead.NonceSize():]
+		if state, err := aead.Open(nil, nonce, sealed, encryptedStateMagic); err == nil {
+			return state, i > 0, nil
+		}
+	}
+	return nil, false, fmt.Errorf("storage state cannot be decrypted with the configured keys")
+}
+
+// readStorageState reads the storage state saved at path for a new context,
+// decrypted and as JSON. A missing file yields nil. So does a file that
+// cannot be decrypted or parsed, with a warning, so that the context starts
+// clean instead of failing.
+func (p *playwrightImpl) readStorageState(path string) []byte {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			p.logger.Warn("failed to read storage state, starting with a clean context",
+				zap.String("path", path),
+				zap.Error(err))
+		}
+		return nil
+	}
+
+	decoded, _, err := p.stateCipher.decode(data)
+	if err == nil {
+		var state map[string]any
+		if err = json.Unmarshal(decoded, &state); err == nil {
+			return decoded
+		}
+		err = fmt.Errorf("storage state is not valid JSON: %w", err)
+	}
+	p.logger.Warn("unusable storage state, starting with a clean context",
+		zap.String("path", path),
+		zap.Error(err))
+	return nil
+}
+
+// writeStorageState replaces the file at path with state, encrypted when a
+// key is configured. The file is written next to path and renamed over it,
+// and only the agent can read it.
+func (p *playwrightImpl) writeStorageState(path string, state []byte) error {
+	encoded, err := p.stateCipher.encode(state)
+	if err != nil {
+		return err
+	}
+	partial := path + ".partial"
+	if err := os.WriteFile(partial, encoded, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(partial, path); err != nil {
+		_ = os.Remove(partial)
+		return err
+	}
+	return nil
+}
+
+// storageStateFiles lists the saved storage states: the shared one and
+// every profile
+func (p *playwrightImpl) storageStateFiles() []string {
+	files := []string{storageStatePath(p.config)}
+	matches, _ := filepath.Glob(filepath.Join(p.config.Browser.DataDir, profilesDirName, "*"+profileExtension))
+	return append(files, matches...)
+}
+
+// reencryptStorageStates brings saved storage states in line with the
+// configured keys: plain files are encrypted and files encrypted with a
+// previous key are encrypted again with the current one. Files that cannot
+// be decrypted are left alone and reported.
+func (p *playwrightImpl) reencryptStorageStates() {
+	if p.stateCipher == nil {
+		return
+	}
+	for _, path := range p.storageStateFiles() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		state, stale, err := p.stateCipher.decode(data)
+		if err != nil {
+			p.logger.Warn("cannot re-encrypt storage state", zap.String("path", path), zap.Error(err))
+			continue
+		}
+		if !stale {
+			continue
+		}
+		if err := p.writeStorageState(path, state); err != nil {
+			p.logger.Warn("failed to re-encrypt storage state", zap.String("path", path), zap.Error(err))
+			continue
+		}
+		p.logger.Info("re-encrypted storage state with the current key", zap.String("path", path))
+	}
+}
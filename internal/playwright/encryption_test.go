@@ -0,0 +1,190 @@
+package playwright
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+	observer "go.uber.org/zap/zaptest/observer"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+const testStorageState = `{"cookies":[{"name":"session","value":"signed-in","domain":"example.com","path":"/","expires":-1,"httpOnly":true,"secure":false,"sameSite":"Lax"}],"origins":[]}`
+
+func newStateKey(t *testing.T) string {
+	t.Helper()
+	key := make([]byte, stateKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func newEncryptingService(t *testing.T, browser config.BrowserConfig) (*playwrightImpl, *observer.ObservedLogs) {
+	t.Helper()
+	if browser.DataDir == "" {
+		browser.DataDir = t.TempDir()
+	}
+	cfg := &config.Config{Browser: browser}
+	stateCipher, err := newStateCipher(cfg)
+	require.NoError(t, err)
+	core, logs := observer.New(zap.InfoLevel)
+	return &playwrightImpl{logger: zap.New(core), config: cfg, stateCipher: stateCipher}, logs
+}
+
+func TestNewStateCipher(t *testing.T) {
+	c, err := newStateCipher(&config.Config{})
+	require.NoError(t, err)
+	assert.Nil(t, c, "no key leaves encryption off")
+
+	keyFile := filepath.Join(t.TempDir(), "state-key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(newStateKey(t)+"\n"), 0600))
+	c, err = newStateCipher(&config.Config{Browser: config.BrowserConfig{StateEncryptionKeyFile: keyFile}})
+	require.NoError(t, err)
+	assert.NotNil(t, c)
+
+	for name, browser := range map[string]config.BrowserConfig{
+		"not base64":          {StateEncryptionKey: "not a key!"},
+		"too short":           {StateEncryptionKey: base64.StdEncoding.EncodeToString([]byte("short"))},
+		"key and key file":    {StateEncryptionKey: newStateKey(t), StateEncryptionKeyFile: keyFile},
+		"missing key file":    {StateEncryptionKeyFile: filepath.Join(t.TempDir(), "missing")},
+		"previous keys alone": {StateEncryptionPreviousKeys: newStateKey(t)},
+		"bad previous key":    {StateEncryptionKey: newStateKey(t), StateEncryptionPreviousKeys: "nope"},
+	} {
+		_, err := newStateCipher(&config.Config{Browser: browser})
+		assert.Error(t, err, name)
+	}
+}
+
+func TestStorageStateEncryptionRoundTrip(t *testing.T) {
+	p, _ := newEncryptingService(t, config.BrowserConfig{StateEncryptionKey: newStateKey(t)})
+	path := filepath.Join(p.config.Browser.DataDir, "state.json")
+
+	require.NoError(t, p.writeStorageState(path, []byte(testStorageState)))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(data, encryptedStateMagic))
+	assert.NotContains(t, string(data), "signed-in", "cookies are not stored in the clear")
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	assert.JSONEq(t, testStorageState, string(p.readStorageState(path)))
+}
+
+func TestReadStorageStateKeepsIndexedDB(t *testing.T) {
+	p, _ := newEncryptingService(t, config.BrowserConfig{StateEncryptionKey: newStateKey(t)})
+	path := filepath.Join(p.config.Browser.DataDir, "state.json")
+	state := `{"cookies":[],"origins":[{"origin":"https://app.example.com","localStorage":[],"indexedDB":[{"name":"auth","version":1,"stores":[]}]}]}`
+
+	require.NoError(t, p.writeStorageState(path, []byte(state)))
+	assert.JSONEq(t, state, string(p.readStorageState(path)), "the state reaches the context as saved, IndexedDB included")
+}
+
+func TestReadStorageStateDegradesToCleanContext(t *testing.T) {
+	key := newStateKey(t)
+	writer, _ := newEncryptingService(t, config.BrowserConfig{StateEncryptionKey: key})
+	encrypted := filepath.Join(writer.config.Browser.DataDir, "encrypted.json")
+	require.NoError(t, writer.writeStorageState(encrypted, []byte(testStorageState)))
+
+	tests := []struct {
+		name    string
+		browser config.BrowserConfig
+		path    func(dir string) string
+		warning string
+	}{
+		{
+			name:    "wrong key",
+			browser: config.BrowserConfig{StateEncryptionKey: newStateKey(t)},
+			path:    func(string) string { return encrypted },
+			warning: "cannot be decrypted",
+		},
+		{
+			name:    "no key",
+			path:    func(string) string { return encrypted },
+			warning: "no state encryption key is configured",
+		},
+		{
+			name:    "truncated",
+			browser: config.BrowserConfig{StateEncryptionKey: key},
+			path: func(dir string) string {
+				path := filepath.Join(dir, "truncated.json")
+				require.NoError(t, os.WriteFile(path, encryptedStateMagic, 0600))
+				return path
+			},
+			warning: "cannot be decrypted",
+		},
+		{
+			name: "corrupt JSON",
+			path: func(dir string) string {
+				path := filepath.Join(dir, "corrupt.json")
+				require.NoError(t, os.WriteFile(path, []byte(`{"cookies": [`), 0600))
+				return path
+			},
+			warning: "not valid JSON",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, logs := newEncryptingService(t, tt.browser)
+
+			assert.Nil(t, p.readStorageState(tt.path(p.config.Browser.DataDir)))
+			warnings := logs.FilterMessage("unusable storage state, starting with a clean context").All()
+			require.Len(t, warnings, 1)
+			assert.Contains(t, warnings[0].ContextMap()["error"], tt.warning)
+		})
+	}
+
+	p, logs := newEncryptingService(t, config.BrowserConfig{})
+	assert.Nil(t, p.readStorageState(filepath.Join(p.config.Browser.DataDir, "missing.json")))
+	assert.Zero(t, logs.Len(), "a missing state is not worth a warning")
+}
+
+func TestReencryptStorageStates(t *testing.T) {
+	dataDir := t.TempDir()
+	oldKey, newKey := newStateKey(t), newStateKey(t)
+	old, _ := newEncryptingService(t, config.BrowserConfig{DataDir: dataDir, StateEncryptionKey: oldKey})
+
+	profiles := filepath.Join(dataDir, profilesDirName)
+	require.NoError(t, os.MkdirAll(profiles, 0700))
+	rotated := filepath.Join(profiles, "rotated.json")
+	require.NoError(t, old.writeStorageState(rotated, []byte(testStorageState)))
+	plain := filepath.Join(profiles, "plain.json")
+	require.NoError(t, os.WriteFile(plain, []byte(testStorageState), 0600))
+	foreign := filepath.Join(profiles, "foreign.json")
+	other, _ := newEncryptingService(t, config.BrowserConfig{DataDir: dataDir, StateEncryptionKey: newStateKey(t)})
+	require.NoError(t, other.writeStorageState(foreign, []byte(testStorageState)))
+	foreignBefore, err := os.ReadFile(foreign)
+	require.NoError(t, err)
+
+	p, logs := newEncryptingService(t, config.BrowserConfig{
+		DataDir:                     dataDir,
+		StateEncryptionKey:          newKey,
+		StateEncryptionPreviousKeys: oldKey,
+	})
+	p.reencryptStorageStates()
+
+	current, _ := newEncryptingService(t, config.BrowserConfig{DataDir: dataDir, StateEncryptionKey: newKey})
+	for _, path := range []string{rotated, plain} {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		state, stale, err := current.stateCipher.decode(data)
+		require.NoError(t, err, "%s is readable with the new key alone", path)
+		assert.False(t, stale)
+		assert.JSONEq(t, testStorageState, string(state))
+	}
+
+	foreignAfter, err := os.ReadFile(foreign)
+	require.NoError(t, err)
+	assert.Equal(t, foreignBefore, foreignAfter, "undecryptable files are left alone")
+	assert.Equal(t, 1, logs.FilterMessage("cannot re-encrypt storage state").Len())
+	assert.Equal(t, 2, logs.FilterMessage("re-encrypted storage state with the current key").Len())
+}
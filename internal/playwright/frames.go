@@ -0,0 +1,182 @@
+package playwright
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+)
+
+// frameChainSeparator separates the iframe selectors of a nested frame path,
+// e.g. "iframe#pay >> iframe.card"
+const frameChainSeparator = ">>"
+
+// locate resolves selector inside the frame described by frame. The frame
+// may be given as:
+//   - "name=<name>" or a bare frame name
+//   - "url=<pattern>" or a bare URL pattern: a glob ("**/checkout/*"), a
+//     /regex/, or with the url= prefix a plain substring of the frame URL
+//   - a chain of iframe element selectors, e.g. "iframe#pay >> iframe.card"
+//
+// A ref=eN selector from GetPageSnapshot ignores frame, since the ref names
+// its frame already. With no frame the main frame is tried first, then
+// every nested frame in document order. When nothing matches anywhere yet,
+// the main-frame locator is returned so Playwright's own waiting and error
+// reporting still apply.
+func (p *playwrightImpl) locate(page playwright.Page, frame, selector string) (playwright.Locator, error) {
+	// Snapshot refs already identify their frame
+	if IsRefSelector(selector) {
+		return refLocator(page, selector)
+	}
+
+	frame = strings.TrimSpace(frame)
+	if frame != "" {
+		return locateInFrame(page, frame, selector)
+	}
+
+	main := page.Locator(selector)
+	if count, err := main.Count(); err != nil || count > 0 {
+		return main, nil
+	}
+
+	mainFrame := page.MainFrame()
+	for _, candidate := range page.Frames() {
+		if candidate == mainFrame || candidate.IsDetached() {
+			continue
+		}
+		locator := candidate.Locator(selector)
+		if count, err := locator.Count(); err == nil && count > 0 {
+			p.logger.Info("element found in nested frame",
+				zap.String("selector", selector),
+				zap.String("frameName", candidate.Name()),
+				zap.String("frameURL", candidate.URL()))
+			return locator, nil
+		}
+	}
+
+	return main, nil
+}
+
+// locateInFrame resolves selector inside an explicitly named frame
+func locateInFrame(page playwright.Page, frame, selector string) (playwright.Locator, error) {
+	switch {
+	case strings.HasPrefix(frame, "name="):
+		name := strings.TrimPrefix(frame, "name=")
+		for _, candidate := range page.Frames() {
+			if candidate.Name() == name {
+				return candidate.Locator(selector), nil
+			}
+		}
+		return nil, fmt.Errorf("no frame named %q", name)
+
+	case strings.HasPrefix(frame, "url="):
+		pattern := strings.TrimPrefix(frame, "url=")
+		match, err := urlMatcher(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if found := findFrame(page, match); found != nil {
+			return found.Locator(selector), nil
+		}
+		return nil, fmt.Errorf("no frame with URL matching %q", pattern)
+
+	case strings.Contains(frame, frameChainSeparator):
+		return frameChainLocator(page, frame, selector)
+	}
+
+	// Bare values: a frame name wins, then a URL pattern, and anything else
+	// is taken as the selector of an iframe element.
+	for _, candidate := range page.Frames() {
+		if candidate.Name() == frame {
+			return candidate.Locator(selector), nil
+		}
+	}
+	if looksLikeURLPattern(frame) {
+		match, err := urlMatcher(frame)
+		if err != nil {
+			return nil, err
+		}
+		if found := findFrame(page, match); found != nil {
+			return found.Locator(selector), nil
+		}
+		return nil, fmt.Errorf("no frame with URL matching %q", frame)
+	}
+	return frameChainLocator(page, frame, selector)
+}
+
+// frameChainLocator descends through a ">>"-separated chain of iframe
+// selectors. Frame locators are lazy, so frames that are still loading are
+// waited for by the action that uses the locator.
+func frameChainLocator(page playwright.Page, chain, selector string) (playwright.Locator, error) {
+	var frameLocator playwright.FrameLocator
+	for _, part := range strings.Split(chain, frameChainSeparator) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return nil, fmt.Errorf("invalid frame selector chain %q", chain)
+		}
+		if frameLocator == nil {
+			frameLocator = page.FrameLocator(part)
+		} else {
+			frameLocator = frameLocator.FrameLocator(part)
+		}
+	}
+	return frameLocator.Locator(selector), nil
+}
+
+// findFrame returns the first attached frame whose URL satisfies match
+func findFrame(page playwright.Page, match func(string) bool) playwright.Frame {
+	for _, candidate := range page.Frames() {
+		if !candidate.IsDetached() && match(candidate.URL()) {
+			return candidate
+		}
+	}
+	return nil
+}
+
+// looksLikeURLPattern reports whether a bare frame value is meant as a URL
+// pattern rather than a frame name or iframe selector
+func looksLikeURLPattern(frame string) bool {
+	return strings.Contains(frame, "://") ||
+		strings.Contains(frame, "*") ||
+		(len(frame) > 2 && strings.HasPrefix(frame, "/") && strings.HasSuffix(frame, "/"))
+}
+
+// urlMatcher builds a matcher for a URL pattern, as used for frames and
+// network log filters. Globs use Playwright's rules (** crosses path
+// segments, * does not), /regex/ is a regular expression, and anything else
+// matches as a substring.
+func urlMatcher(pattern string) (func(string) bool, error) {
+	compiled, err := urlPattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if re, ok := compiled.(*regexp.Regexp); ok {
+		return re.MatchString, nil
+	}
+	if !strings.Contains(pattern, "*") {
+		return func(url string) bool { return strings.Contains(url, pattern) }, nil
+	}
+	re := globRegexp(pattern)
+	return re.MatchString, nil
+}
+
+// globRegexp translates a URL glob into an anchored regular expression
+func globRegexp(glob string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch {
+		case strings.HasPrefix(glob[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case glob[i] == '*':
+			b.WriteString("[^/]*")
+		default:
+			b.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
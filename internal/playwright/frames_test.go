@@ -0,0 +1,104 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestURLMatcher(t *testing.T) {
+	tests := []struct {
+		pattern string
+		url     string
+		match   bool
+	}{
+		{"**/checkout/*", "https://pay.example.com/checkout/card", true},
+		{"**/checkout/*", "https://pay.example.com/checkout/card/extra", false},
+		{"https://*.example.com/**", "https://pay.example.com/checkout/card", true},
+		{"/pay\\.example\\.com/", "https://pay.example.com/checkout", true},
+		{"pay.example.com", "https://pay.example.com/checkout", true},
+		{"pay.example.com", "https://example.com/pay", false},
+	}
+	for _, tt := range tests {
+		match, err := urlMatcher(tt.pattern)
+		require.NoError(t, err)
+		assert.Equal(t, tt.match, match(tt.url), "%s against %s", tt.pattern, tt.url)
+	}
+
+	_, err := urlMatcher("/[unclosed/")
+	assert.Error(t, err)
+}
+
+func TestLooksLikeURLPattern(t *testing.T) {
+	assert.True(t, looksLikeURLPattern("https://pay.example.com"))
+	assert.True(t, looksLikeURLPattern("**/checkout"))
+	assert.True(t, looksLikeURLPattern("/stripe/"))
+	assert.False(t, looksLikeURLPattern("payment"))
+	assert.False(t, looksLikeURLPattern("iframe#pay"))
+}
+
+func TestFrameAwareLocators(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `<h1>Checkout</h1><iframe id="pay" name="payment" src="/pay"></iframe>`)
+	})
+	mux.HandleFunc("/pay", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `<iframe class="card" src="/card"></iframe>`)
+	})
+	mux.HandleFunc("/card", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `<input id="number"><button id="confirm" onclick="this.textContent='done'">Pay</button>`)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium"},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	ctx := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name()})
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL, "load", 10*time.Second)
+	require.NoError(t, err)
+
+	chain := "iframe#pay >> iframe.card"
+	_, err = service.WaitForCondition(ctx, session.ID, "selector", WaitOptions{Selector: "#number", Frame: chain, State: "visible", Timeout: 10 * time.Second})
+	require.NoError(t, err)
+	_, err = service.FillForm(ctx, session.ID, []map[string]any{
+		{"selector": "#number", "value": "4242424242424242", "type": "text", "frame": "url=**/card"},
+	}, true, "#confirm")
+	require.NoError(t, err)
+
+	// No frame: the nested frame is found by searching
+	data, err := service.ExtractData(ctx, session.ID, []map[string]any{
+		{"name": "button", "selector": "#confirm", "attribute": "text"},
+	}, "json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"button":"done"}`, data)
+
+	require.NoError(t, service.ClickElement(ctx, session.ID, "iframe.card", map[string]any{
+		"frame":   "payment",
+		"timeout": 5 * time.Second,
+	}))
+
+	_, err = service.ExtractData(ctx, session.ID, []map[string]any{
+		{"name": "missing", "selector": "#number", "frame": "name=nope"},
+	}, "json")
+	assert.ErrorContains(t, err, `no frame named "nope"`)
+}
@@ -0,0 +1,204 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+)
+
+// keyAliases maps common names of keys to the ones Playwright knows
+var keyAliases = map[string]string{
+	"ctrl":    "Control",
+	"control": "Control",
+	"cmd":     "Meta",
+	"command": "Meta",
+	"meta":    "Meta",
+	"win":     "Meta",
+	"option":  "Alt",
+	"alt":     "Alt",
+	"shift":   "Shift",
+	"esc":     "Escape",
+	"return":  "Enter",
+	"del":     "Delete",
+	"space":   " ",
+	"up":      "ArrowUp",
+	"down":    "ArrowDown",
+	"left":    "ArrowLeft",
+	"right":   "ArrowRight",
+	"pgup":    "PageUp",
+	"pgdn":    "PageDown",
+}
+
+// namedKeys are the keys, besides single characters, that chords use most;
+// they are matched regardless of case
+var namedKeys = []string{
+	"Backspace", "Tab", "Enter", "Escape", "Delete", "Insert", "Home", "End", "PageUp", "PageDown",
+	"ArrowUp", "ArrowDown", "ArrowLeft", "ArrowRight", "CapsLock",
+	"F1", "F2", "F3", "F4", "F5", "F6", "F7", "F8", "F9", "F10", "F11", "F12",
+}
+
+// KeyboardOptions configures PressKeys and TypeText
+type KeyboardOptions struct {
+	// Selector is focused first; when empty the keys go to whatever has
+	// focus
+	Selector string
+	Frame    string
+	// Delay is held between keydown and keyup by PressKeys, and between
+	// characters by TypeText
+	Delay   time.Duration
+	Timeout time.Duration
+}
+
+// NormalizeKeyChord turns a chord such as "ctrl+shift+k" into Playwright's
+// "Control+Shift+K". A single character is kept as is, and "+" may itself
+// be the last key, as in "Shift++".
+func NormalizeKeyChord(chord string) (string, error) {
+	chord = strings.TrimSpace(chord)
+	if chord == "" {
+		return "", fmt.Errorf("empty key")
+	}
+	if chord == "+" {
+		return chord, nil
+	}
+
+	key := chord
+	var modifiers []string
+	if i := strings.LastIndex(chord[:len(chord)-1], "+"); i >= 0 {
+		key = chord[i+1:]
+		if trimmed := strings.TrimSpace(key); trimmed != "" {
+			key = trimmed
+		}
+		modifiers = strings.Split(chord[:i], "+")
+	}
+
+	parts := make([]string, 0, len(modifiers)+1)
+	for _, modifier := range modifiers {
+		modifier = strings.TrimSpace(modifier)
+		switch alias := keyAliases[strings.ToLower(modifier)]; alias {
+		case "Control", "Meta", "Alt", "Shift":
+			parts = append(parts, alias)
+		default:
+			if strings.EqualFold(modifier, "ControlOrMeta") {
+				parts = append(parts, "ControlOrMeta")
+				continue
+			}
+			return "", fmt.Errorf("unknown modifier %q in %q: use Control, Shift, Alt, Meta or ControlOrMeta", modifier, chord)
+		}
+	}
+
+	if len([]rune(key)) > 1 {
+		key = keyName(key)
+	} else if len(modifiers) > 0 {
+		// Shortcuts name letters in upper case
+		key = strings.ToUpper(key)
+	}
+	return strings.Join(append(parts, key), "+"), nil
+}
+
+// keyName returns the Playwright name of a named key
+func keyName(key string) string {
+	if alias, ok := keyAliases[strings.ToLower(key)]; ok {
+		return alias
+	}
+	for _, name := range namedKeys {
+		if strings.EqualFold(key, name) {
+			return name
+		}
+	}
+	return key
+}
+
+// PressKeys presses each key chord in turn, e.g. "Control+A" then
+// "Backspace", on the element Selector names or on the focused element
+func (p *playwrightImpl) PressKeys(ctx context.Context, sessionID string, keys []string, options KeyboardOptions) error {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("no keys to press")
+	}
+
+	chords := make([]string, 0, len(keys))
+	for _, key := range keys {
+		chord, err := NormalizeKeyChord(key)
+		if err != nil {
+			return err
+		}
+		chords = append(chords, chord)
+	}
+
+	page := session.ActivePage()
+	if err := p.focus(page, options); err != nil {
+		return err
+	}
+
+	p.logger.Info("pressing keys", zap.String("sessionID", sessionID), zap.Strings("keys", chords))
+	delay := float64(options.Delay.Milliseconds())
+	for _, chord := range chords {
+		if err := page.Keyboard().Press(chord, playwright.KeyboardPressOptions{Delay: &delay}); err != nil {
+			return fmt.Errorf("failed to press %s: %w", chord, err)
+		}
+	}
+	return nil
+}
+
+// TypeText types text one character at a time, firing the key events that
+// autocomplete widgets and editors listen for. With a Selector the element
+// is focused and typed into; otherwise the text goes to the focused element.
+func (p *playwrightImpl) TypeText(ctx context.Context, sessionID, text string, options KeyboardOptions) error {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return err
+	}
+
+	page := session.ActivePage()
+	delay := float64(options.Delay.Milliseconds())
+	p.logger.Info("typing text",
+		zap.String("sessionID", sessionID),
+		zap.String("selector", options.Selector),
+		zap.Int("length", len(text)))
+
+	if options.Selector == "" {
+		return page.Keyboard().Type(text, playwright.KeyboardTypeOptions{Delay: &delay})
+	}
+
+	locator, err := p.locate(page, options.Frame, options.Selector)
+	if err != nil {
+		return err
+	}
+	typeOptions := playwright.LocatorPressSequentiallyOptions{Delay: &delay}
+	if options.Timeout > 0 {
+		// The timeout covers typing every character
+		timeoutMs := float64((options.Timeout + options.Delay*time.Duration(len(text))).Milliseconds())
+		typeOptions.Timeout = &timeoutMs
+	}
+	if err := locator.PressSequentially(text, typeOptions); err != nil {
+		return fmt.Errorf("failed to type into %s: %w", options.Selector, err)
+	}
+	return nil
+}
+
+// focus focuses the element options.Selector names, if any
+func (p *playwrightImpl) focus(page playwright.Page, options KeyboardOptions) error {
+	if options.Selector == "" {
+		return nil
+	}
+	locator, err := p.locate(page, options.Frame, options.Selector)
+	if err != nil {
+		return err
+	}
+	focusOptions := playwright.LocatorFocusOptions{}
+	if options.Timeout > 0 {
+		timeoutMs := float64(options.Timeout.Milliseconds())
+		focusOptions.Timeout = &timeoutMs
+	}
+	if err := locator.Focus(focusOptions); err != nil {
+		return fmt.Errorf("failed to focus %s: %w", options.Selector, err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestNormalizeKeyChord(t *testing.T) {
+	tests := []struct {
+		chord string
+		want  string
+	}{
+		{"Enter", "Enter"},
+		{"enter", "Enter"},
+		{"esc", "Escape"},
+		{"a", "a"},
+		{"ctrl+a", "Control+A"},
+		{"Shift + Tab", "Shift+Tab"},
+		{"Cmd+Shift+p", "Meta+Shift+P"},
+		{"ControlOrMeta+v", "ControlOrMeta+V"},
+		{"+", "+"},
+		{"Shift++", "Shift++"},
+		{"Alt+ArrowDown", "Alt+ArrowDown"},
+		{"f5", "F5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.chord, func(t *testing.T) {
+			got, err := NormalizeKeyChord(tt.chord)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := NormalizeKeyChord(" ")
+	assert.Error(t, err)
+	_, err = NormalizeKeyChord("Hyper+K")
+	assert.ErrorContains(t, err, "unknown modifier")
+}
+
+func TestTypeTextAndPressKeys(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `
+<input id="city" autocomplete="off">
+<ul id="suggestions"></ul>
+<p id="chosen"></p>
+<script>
+  const cities = ['Zagreb', 'Zaragoza', 'Zürich'];
+  const input = document.getElementById('city'), list = document.getElementById('suggestions');
+  let keys = 0, active = -1;
+  input.addEventListener('keydown', e => {
+    keys++;
+    if (e.key === 'ArrowDown') active++;
+    if (e.key === 'Enter') document.getElementById('chosen').textContent = list.children[active].textContent;
+  });
+  input.addEventListener('input', () => {
+    list.innerHTML = cities.filter(c => c.startsWith(input.value)).map(c => '<li>' + c + '</li>').join('');
+    active = -1;
+  });
+  window.keyCount = () => keys;
+</script>`)
+	}))
+	defer srv.Close()
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium", DataDir: t.TempDir()},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	ctx := context.Background()
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL, "load", 10*time.Second)
+	require.NoError(t, err)
+
+	require.NoError(t, service.TypeText(ctx, session.ID, "Zar", KeyboardOptions{Selector: "#city", Delay: 10 * time.Millisecond}))
+	require.NoError(t, service.PressKeys(ctx, session.ID, []string{"ArrowDown", "Enter"}, KeyboardOptions{}))
+
+	result, err := service.ExecuteScript(ctx, session.ID,
+		`() => [document.getElementById('chosen').textContent, window.keyCount()]`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"Zaragoza", 5}, result, "each character fires its own key events")
+
+	require.NoError(t, service.PressKeys(ctx, session.ID, []string{"ControlOrMeta+A", "Backspace"}, KeyboardOptions{Selector: "#city"}))
+	value, err := service.ExecuteScript(ctx, session.ID, `() => document.getElementById('city').value`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", value)
+}
@@ -0,0 +1,223 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+	config "github.com/inference-gateway/browser-agent/config"
+	zap "go.uber.org/zap"
+)
+
+// storageStateFileName is the file under the data directory that new
+// contexts load their cookies and local storage from. The agent never
+// writes it; it is left to operators to provide.
+const storageStateFileName = "browser-state"
+
+// storageStatePath is the storage state new contexts start from
+func storageStatePath(cfg *config.Config) string {
+	return filepath.Join(cfg.Browser.DataDir, storageStateFileName)
+}
+
+// taskStateNames are the task states BROWSER_TASK_SESSION_CLOSE_ON accepts
+var taskStateNames = map[string]types.TaskState{
+	"completed":      types.TaskStateCompleted,
+	"failed":         types.TaskStateFailed,
+	"canceled":       types.TaskStateCancelled,
+	"cancelled":      types.TaskStateCancelled,
+	"rejected":       types.TaskStateRejected,
+	"input-required": types.TaskStateInputRequired,
+	"auth-required":  types.TaskStateAuthRequired,
+}
+
+// SessionLifecycle ends a task's browser session as soon as the task
+// reaches one of the configured states, instead of leaving it to expire.
+// Sessions of tasks in any other state, such as input-required by default,
+// are kept so the task can carry on where it paused.
+type SessionLifecycle struct {
+	logger     *zap.Logger
+	automation BrowserAutomation
+	closeOn    map[types.TaskState]bool
+	// profile is the named profile closing sessions are saved as, empty
+	// when they are not persisted
+	profile string
+}
+
+// NewSessionLifecycle creates a SessionLifecycle from the
+// BROWSER_TASK_SESSION_CLOSE_ON, BROWSER_TASK_SESSION_PERSIST and
+// BROWSER_TASK_SESSION_PROFILE settings
+func NewSessionLifecycle(logger *zap.Logger, automation BrowserAutomation, cfg *config.Config) (*SessionLifecycle, error) {
+	closeOn := make(map[types.TaskState]bool)
+	for name := range strings.SplitSeq(cfg.Browser.TaskSessionCloseOn, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		state, ok := taskStateNames[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown task state %q in BROWSER_TASK_SESSION_CLOSE_ON", name)
+		}
+		closeOn[state] = true
+	}
+
+	var profile string
+	if cfg.Browser.TaskSessionPersist {
+		profile = cfg.Browser.TaskSessionProfile
+		if profile == "" {
+			return nil, fmt.Errorf("BROWSER_TASK_SESSION_PERSIST requires BROWSER_TASK_SESSION_PROFILE to name the profile sessions are saved as")
+		}
+		if err := ValidateProfileName(profile); err != nil {
+			return nil, fmt.Errorf("invalid BROWSER_TASK_SESSION_PROFILE: %w", err)
+		}
+	}
+
+	return &SessionLifecycle{
+		logger:     logger,
+		automation: automation,
+		closeOn:    closeOn,
+		profile:    profile,
+	}, nil
+}
+
+// TaskFinished ends the session of the task taskID if state is one the
+// session is closed on. With persistence enabled, the session's cookies and
+// storage are saved first as the configured profile, which later tasks
+// start from only when they ask for it.
+func (l *SessionLifecycle) TaskFinished(ctx context.Context, taskID string, state types.TaskState) {
+	if !l.closeOn[state] {
+		l.logger.Debug("keeping task session",
+			zap.String("sessionID", taskID),
+			zap.String("state", string(state)))
+		return
+	}
+	// The task's own context is usually done by now, cancelled tasks
+	// included, and cleanup must still run
+	ctx = context.WithoutCancel(ctx)
+
+	if l.profile != "" {
+		if _, err := l.automation.SaveProfile(ctx, taskID, l.profile); err != nil {
+			l.logger.Warn("failed to persist task session",
+				zap.String("sessionID", taskID),
+				zap.Error(err))
+		}
+	}
+
+	if err := l.automation.CloseBrowser(ctx, taskID); err != nil {
+		// Tasks that never used the browser have no session
+		l.logger.Debug("no task session to close",
+			zap.String("sessionID", taskID),
+			zap.Error(err))
+		return
+	}
+	l.logger.Info("closed task session",
+		zap.String("sessionID", taskID),
+		zap.String("state", string(state)))
+}
+
+// BackgroundTaskHandler wraps handler so that each task's session is ended
+// when the task does
+func (l *SessionLifecycle) BackgroundTaskHandler(handler server.TaskHandler) server.TaskHandler {
+	return &lifecycleTaskHandler{TaskHandler: handler, lifecycle: l}
+}
+
+// StreamingTaskHandler wraps handler so that each task's session is ended
+// when its stream does
+func (l *SessionLifecycle) StreamingTaskHandler(handler server.StreamableTaskHandler) server.StreamableTaskHandler {
+	return &lifecycleStreamingTaskHandler{StreamableTaskHandler: handler, lifecycle: l}
+}
+
+// lifecycleTaskHandler reports the state background tasks end in
+type lifecycleTaskHandler struct {
+	server.TaskHandler
+	lifecycle *SessionLifecycle
+}
+
+func (h *lifecycleTaskHandler) HandleTask(ctx context.Context, task *types.Task, message *types.Message) (*types.Task, error) {
+	result, err := h.TaskHandler.HandleTask(ctx, task, message)
+
+	state := types.TaskStateCompleted
+	switch {
+	case ctx.Err() != nil:
+		state = types.TaskStateCancelled
+	case err != nil:
+		state = types.TaskStateFailed
+	case result != nil:
+		state = result.Status.State
+	}
+	h.lifecycle.TaskFinished(ctx, task.ID, state)
+	return result, err
+}
+
+// lifecycleStreamingTaskHandler follows the events of streaming tasks to
+// report the state they end in
+type lifecycleStreamingTaskHandler struct {
+	server.StreamableTaskHandler
+	lifecycle *SessionLifecycle
+}
+
+func (h *lifecycleStreamingTaskHandler) HandleStreamingTask(ctx context.Context, task *types.Task, message *types.Message) (<-chan cloudevents.Event, error) {
+	events, err := h.StreamableTaskHandler.HandleStreamingTask(ctx, task, message)
+	if err != nil {
+		h.lifecycle.TaskFinished(ctx, task.ID, types.TaskStateFailed)
+		return nil, err
+	}
+
+	forwarded := make(chan cloudevents.Event)
+	go func() {
+		defer close(forwarded)
+
+		var state types.TaskState
+		defer func() {
+			h.lifecycle.TaskFinished(ctx, task.ID, streamEndState(ctx, state))
+		}()
+
+		for event := range events {
+			state = streamedTaskState(event, state)
+			select {
+			case forwarded <- event:
+			case <-ctx.Done():
+				// The stream's reader is gone
+				return
+			}
+		}
+	}()
+	return forwarded, nil
+}
+
+// streamedTaskState returns the task state event moves the task to, or
+// current when it leaves the state as is
+func streamedTaskState(event cloudevents.Event, current types.TaskState) types.TaskState {
+	switch event.Type() {
+	case types.EventTaskStatusChanged:
+		var status types.TaskStatus
+		if err := event.DataAs(&status); err == nil {
+			return status.State
+		}
+	case types.EventInputRequired:
+		return types.TaskStateInputRequired
+	case types.EventTaskInterrupted:
+		return types.TaskStateCancelled
+	case types.EventStreamFailed:
+		return types.TaskStateFailed
+	}
+	return current
+}
+
+// streamEndState is the state a task whose stream stopped in state ends
+// in. A stream that closes while the task is still working completes it,
+// unless the task's context was cancelled.
+func streamEndState(ctx context.Context, state types.TaskState) types.TaskState {
+	switch state {
+	case types.TaskStateCompleted, types.TaskStateFailed, types.TaskStateCancelled,
+		types.TaskStateRejected, types.TaskStateInputRequired, types.TaskStateAuthRequired:
+		return state
+	}
+	if ctx.Err() != nil {
+		return types.TaskStateCancelled
+	}
+	return types.TaskStateCompleted
+}
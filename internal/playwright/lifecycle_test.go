@@ -0,0 +1,251 @@
+package playwright_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	servermocks "github.com/inference-gateway/adk/server/mocks"
+	types "github.com/inference-gateway/adk/types"
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+	zap "go.uber.org/zap"
+
+	config "github.com/inference-gateway/browser-agent/config"
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+func newSessionLifecycle(t *testing.T, closeOn string, persist bool) (*playwright.SessionLifecycle, *mocks.FakeBrowserAutomation, *config.Config) {
+	t.Helper()
+	cfg := &config.Config{}
+	cfg.Browser.DataDir = t.TempDir()
+	cfg.Browser.TaskSessionCloseOn = closeOn
+	cfg.Browser.TaskSessionPersist = persist
+	if persist {
+		cfg.Browser.TaskSessionProfile = "tasks"
+	}
+	fake := &mocks.FakeBrowserAutomation{}
+	lifecycle, err := playwright.NewSessionLifecycle(zap.NewNop(), fake, cfg)
+	require.NoError(t, err)
+	return lifecycle, fake, cfg
+}
+
+func TestNewSessionLifecycle_RejectsUnknownStates(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Browser.TaskSessionCloseOn = "completed,finished"
+	_, err := playwright.NewSessionLifecycle(zap.NewNop(), &mocks.FakeBrowserAutomation{}, cfg)
+	assert.ErrorContains(t, err, `"finished"`)
+}
+
+func TestSessionLifecycle_TaskFinished(t *testing.T) {
+	tests := []struct {
+		name   string
+		state  types.TaskState
+		closed bool
+	}{
+		{name: "completed", state: types.TaskStateCompleted, closed: true},
+		{name: "failed", state: types.TaskStateFailed, closed: true},
+		{name: "canceled", state: types.TaskStateCancelled, closed: true},
+		{name: "input required", state: types.TaskStateInputRequired, closed: false},
+		{name: "working", state: types.TaskStateWorking, closed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lifecycle, fake, _ := newSessionLifecycle(t, "completed, failed, canceled", false)
+
+			lifecycle.TaskFinished(context.Background(), "task-1", tt.state)
+
+			if !tt.closed {
+				assert.Zero(t, fake.CloseBrowserCallCount())
+				return
+			}
+			require.Equal(t, 1, fake.CloseBrowserCallCount())
+			_, sessionID := fake.CloseBrowserArgsForCall(0)
+			assert.Equal(t, "task-1", sessionID)
+			assert.Zero(t, fake.SaveProfileCallCount())
+		})
+	}
+}
+
+func TestSessionLifecycle_TaskFinishedOnInputRequiredWhenConfigured(t *testing.T) {
+	lifecycle, fake, _ := newSessionLifecycle(t, "input-required", false)
+
+	lifecycle.TaskFinished(context.Background(), "task-1", types.TaskStateInputRequired)
+	lifecycle.TaskFinished(context.Background(), "task-2", types.TaskStateCompleted)
+
+	require.Equal(t, 1, fake.CloseBrowserCallCount())
+	_, sessionID := fake.CloseBrowserArgsForCall(0)
+	assert.Equal(t, "task-1", sessionID)
+}
+
+func TestSessionLifecycle_PersistsBeforeClosing(t *testing.T) {
+	lifecycle, fake, _ := newSessionLifecycle(t, "completed", true)
+	fake.CloseBrowserStub = func(context.Context, string) error {
+		assert.Equal(t, 1, fake.SaveProfileCallCount(), "the state is saved before the session is closed")
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	lifecycle.TaskFinished(ctx, "task-1", types.TaskStateCompleted)
+
+	require.Equal(t, 1, fake.SaveProfileCallCount())
+	saveCtx, sessionID, profile := fake.SaveProfileArgsForCall(0)
+	assert.NoError(t, saveCtx.Err(), "cleanup does not inherit the task's cancellation")
+	assert.Equal(t, "task-1", sessionID)
+	assert.Equal(t, "tasks", profile, "sessions are saved as the configured profile, not a state every task loads")
+	assert.Zero(t, fake.SaveStorageStateCallCount())
+	assert.Equal(t, 1, fake.CloseBrowserCallCount())
+}
+
+func TestNewSessionLifecycle_PersistRequiresProfile(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Browser.TaskSessionPersist = true
+	_, err := playwright.NewSessionLifecycle(zap.NewNop(), &mocks.FakeBrowserAutomation{}, cfg)
+	assert.ErrorContains(t, err, "BROWSER_TASK_SESSION_PROFILE")
+
+	cfg.Browser.TaskSessionProfile = "../browser-state"
+	_, err = playwright.NewSessionLifecycle(zap.NewNop(), &mocks.FakeBrowserAutomation{}, cfg)
+	assert.ErrorContains(t, err, "invalid BROWSER_TASK_SESSION_PROFILE")
+}
+
+func TestSessionLifecycle_BackgroundTaskHandler(t *testing.T) {
+	tests := []struct {
+		name      string
+		result    types.TaskState
+		err       error
+		cancelled bool
+		closed    bool
+	}{
+		{name: "completed", result: types.TaskStateCompleted, closed: true},
+		{name: "input required", result: types.TaskStateInputRequired, closed: false},
+		{name: "failed", err: errors.New("agent failed"), closed: true},
+		{name: "canceled", result: types.TaskStateWorking, cancelled: true, closed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lifecycle, fake, _ := newSessionLifecycle(t, "completed,failed,canceled", false)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			inner := &servermocks.FakeTaskHandler{}
+			inner.HandleTaskStub = func(_ context.Context, task *types.Task, _ *types.Message) (*types.Task, error) {
+				if tt.cancelled {
+					cancel()
+				}
+				if tt.err != nil {
+					return nil, tt.err
+				}
+				task.Status.State = tt.result
+				return task, nil
+			}
+
+			handler := lifecycle.BackgroundTaskHandler(inner)
+			_, err := handler.HandleTask(ctx, &types.Task{ID: "task-1"}, &types.Message{})
+			assert.Equal(t, tt.err, err)
+
+			if tt.closed {
+				require.Equal(t, 1, fake.CloseBrowserCallCount())
+				_, sessionID := fake.CloseBrowserArgsForCall(0)
+				assert.Equal(t, "task-1", sessionID)
+			} else {
+				assert.Zero(t, fake.CloseBrowserCallCount())
+			}
+		})
+	}
+}
+
+func statusEvent(t *testing.T, state types.TaskState) cloudevents.Event {
+	t.Helper()
+	event := cloudevents.NewEvent()
+	event.SetType(types.EventTaskStatusChanged)
+	require.NoError(t, event.SetData(cloudevents.ApplicationJSON, types.TaskStatus{State: state}))
+	return event
+}
+
+func messageEvent(t *testing.T, eventType string) cloudevents.Event {
+	t.Helper()
+	return types.NewMessageEvent(eventType, "message-1", &types.Message{MessageID: "message-1"})
+}
+
+func TestSessionLifecycle_StreamingTaskHandler(t *testing.T) {
+	tests := []struct {
+		name   string
+		events func(t *testing.T) []cloudevents.Event
+		closed bool
+	}{
+		{
+			name: "completed",
+			events: func(t *testing.T) []cloudevents.Event {
+				return []cloudevents.Event{statusEvent(t, types.TaskStateWorking), statusEvent(t, types.TaskStateCompleted)}
+			},
+			closed: true,
+		},
+		{
+			name: "stream closed while working",
+			events: func(t *testing.T) []cloudevents.Event {
+				return []cloudevents.Event{statusEvent(t, types.TaskStateWorking)}
+			},
+			closed: true,
+		},
+		{
+			name: "input required",
+			events: func(t *testing.T) []cloudevents.Event {
+				return []cloudevents.Event{statusEvent(t, types.TaskStateWorking), messageEvent(t, types.EventInputRequired)}
+			},
+			closed: false,
+		},
+		{
+			name: "interrupted",
+			events: func(t *testing.T) []cloudevents.Event {
+				return []cloudevents.Event{messageEvent(t, types.EventTaskInterrupted)}
+			},
+			closed: true,
+		},
+		{
+			name: "failed",
+			events: func(t *testing.T) []cloudevents.Event {
+				return []cloudevents.Event{messageEvent(t, types.EventStreamFailed)}
+			},
+			closed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lifecycle, fake, _ := newSessionLifecycle(t, "completed,failed,canceled", false)
+			events := tt.events(t)
+			inner := &servermocks.FakeStreamableTaskHandler{}
+			inner.HandleStreamingTaskStub = func(context.Context, *types.Task, *types.Message) (<-chan cloudevents.Event, error) {
+				stream := make(chan cloudevents.Event, len(events))
+				for _, event := range events {
+					stream <- event
+				}
+				close(stream)
+				return stream, nil
+			}
+
+			handler := lifecycle.StreamingTaskHandler(inner)
+			stream, err := handler.HandleStreamingTask(context.Background(), &types.Task{ID: "task-1"}, &types.Message{})
+			require.NoError(t, err)
+
+			var forwarded []string
+			for event := range stream {
+				forwarded = append(forwarded, event.Type())
+			}
+			require.Len(t, forwarded, len(events), "every event reaches the stream's reader")
+
+			if tt.closed {
+				require.Equal(t, 1, fake.CloseBrowserCallCount())
+				_, sessionID := fake.CloseBrowserArgsForCall(0)
+				assert.Equal(t, "task-1", sessionID)
+			} else {
+				assert.Zero(t, fake.CloseBrowserCallCount())
+			}
+		})
+	}
+}
@@ -8,9 +8,55 @@ import (
 
 	"github.com/inference-gateway/browser-agent/config"
 	"github.com/inference-gateway/browser-agent/internal/playwright"
+	"github.com/inference-gateway/browser-agent/internal/proxy"
 )
 
 type FakeBrowserAutomation struct {
+	AddRouteStub        func(context.Context, string, playwright.RouteRule) (*playwright.RouteRule, error)
+	addRouteMutex       sync.RWMutex
+	addRouteArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.RouteRule
+	}
+	addRouteReturns struct {
+		result1 *playwright.RouteRule
+		result2 error
+	}
+	addRouteReturnsOnCall map[int]struct {
+		result1 *playwright.RouteRule
+		result2 error
+	}
+	ClearCookiesStub        func(context.Context, string, playwright.CookieFilter) (int, error)
+	clearCookiesMutex       sync.RWMutex
+	clearCookiesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.CookieFilter
+	}
+	clearCookiesReturns struct {
+		result1 int
+		result2 error
+	}
+	clearCookiesReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	ClickAtStub        func(context.Context, string, float64, float64, playwright.ClickAtOptions) error
+	clickAtMutex       sync.RWMutex
+	clickAtArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 float64
+		arg4 float64
+		arg5 playwright.ClickAtOptions
+	}
+	clickAtReturns struct {
+		result1 error
+	}
+	clickAtReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ClickElementStub        func(context.Context, string, string, map[string]any) error
 	clickElementMutex       sync.RWMutex
 	clickElementArgsForCall []struct {
@@ -48,6 +94,76 @@ type FakeBrowserAutomation struct {
 	closeExpiredSessionsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CloseTabStub        func(context.Context, string, string) (*playwright.Tab, error)
+	closeTabMutex       sync.RWMutex
+	closeTabArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	closeTabReturns struct {
+		result1 *playwright.Tab
+		result2 error
+	}
+	closeTabReturnsOnCall map[int]struct {
+		result1 *playwright.Tab
+		result2 error
+	}
+	ConfigureBrowserStub        func(context.Context, string, playwright.BrowserEmulation) (*playwright.EmulationSettings, error)
+	configureBrowserMutex       sync.RWMutex
+	configureBrowserArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.BrowserEmulation
+	}
+	configureBrowserReturns struct {
+		result1 *playwright.EmulationSettings
+		result2 error
+	}
+	configureBrowserReturnsOnCall map[int]struct {
+		result1 *playwright.EmulationSettings
+		result2 error
+	}
+	DeleteProfileStub        func(context.Context, string) error
+	deleteProfileMutex       sync.RWMutex
+	deleteProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteProfileReturns struct {
+		result1 error
+	}
+	deleteProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DownloadStoredStub        func(context.Context, string, string) error
+	downloadStoredMutex       sync.RWMutex
+	downloadStoredArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	downloadStoredReturns struct {
+		result1 error
+	}
+	downloadStoredReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DragAndDropStub        func(context.Context, string, string, string, playwright.MouseOptions) error
+	dragAndDropMutex       sync.RWMutex
+	dragAndDropArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 playwright.MouseOptions
+	}
+	dragAndDropReturns struct {
+		result1 error
+	}
+	dragAndDropReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ExecuteScriptStub        func(context.Context, string, string, []any) (any, error)
 	executeScriptMutex       sync.RWMutex
 	executeScriptArgsForCall []struct {
@@ -80,7 +196,7 @@ type FakeBrowserAutomation struct {
 		result1 string
 		result2 error
 	}
-	FillFormStub        func(context.Context, string, []map[string]any, bool, string) error
+	FillFormStub        func(context.Context, string, []map[string]any, bool, string) ([]playwright.FieldResult, error)
 	fillFormMutex       sync.RWMutex
 	fillFormArgsForCall []struct {
 		arg1 context.Context
@@ -90,10 +206,12 @@ type FakeBrowserAutomation struct {
 		arg5 string
 	}
 	fillFormReturns struct {
-		result1 error
+		result1 []playwright.FieldResult
+		result2 error
 	}
 	fillFormReturnsOnCall map[int]struct {
-		result1 error
+		result1 []playwright.FieldResult
+		result2 error
 	}
 	GetConfigStub        func() *config.Config
 	getConfigMutex       sync.RWMutex
@@ -105,6 +223,36 @@ type FakeBrowserAutomation struct {
 	getConfigReturnsOnCall map[int]struct {
 		result1 *config.Config
 	}
+	GetConsoleLogsStub        func(context.Context, string, playwright.ConsoleFilter) (*playwright.ConsoleLogs, error)
+	getConsoleLogsMutex       sync.RWMutex
+	getConsoleLogsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.ConsoleFilter
+	}
+	getConsoleLogsReturns struct {
+		result1 *playwright.ConsoleLogs
+		result2 error
+	}
+	getConsoleLogsReturnsOnCall map[int]struct {
+		result1 *playwright.ConsoleLogs
+		result2 error
+	}
+	GetCookiesStub        func(context.Context, string, playwright.CookieFilter) ([]playwright.Cookie, error)
+	getCookiesMutex       sync.RWMutex
+	getCookiesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.CookieFilter
+	}
+	getCookiesReturns struct {
+		result1 []playwright.Cookie
+		result2 error
+	}
+	getCookiesReturnsOnCall map[int]struct {
+		result1 []playwright.Cookie
+		result2 error
+	}
 	GetHealthStub        func(context.Context) error
 	getHealthMutex       sync.RWMutex
 	getHealthArgsForCall []struct {
@@ -116,17 +264,19 @@ type FakeBrowserAutomation struct {
 	getHealthReturnsOnCall map[int]struct {
 		result1 error
 	}
-	GetOrCreateDefaultSessionStub        func(context.Context) (*playwright.BrowserSession, error)
-	getOrCreateDefaultSessionMutex       sync.RWMutex
-	getOrCreateDefaultSessionArgsForCall []struct {
+	GetNetworkLogStub        func(context.Context, string, playwright.NetworkFilter) (*playwright.NetworkLog, error)
+	getNetworkLogMutex       sync.RWMutex
+	getNetworkLogArgsForCall []struct {
 		arg1 context.Context
+		arg2 string
+		arg3 playwright.NetworkFilter
 	}
-	getOrCreateDefaultSessionReturns struct {
-		result1 *playwright.BrowserSession
+	getNetworkLogReturns struct {
+		result1 *playwright.NetworkLog
 		result2 error
 	}
-	getOrCreateDefaultSessionReturnsOnCall map[int]struct {
-		result1 *playwright.BrowserSession
+	getNetworkLogReturnsOnCall map[int]struct {
+		result1 *playwright.NetworkLog
 		result2 error
 	}
 	GetOrCreateTaskSessionStub        func(context.Context) (*playwright.BrowserSession, error)
@@ -142,6 +292,38 @@ type FakeBrowserAutomation struct {
 		result1 *playwright.BrowserSession
 		result2 error
 	}
+	GetPageContentStub        func(context.Context, string, string, string, time.Duration) (*playwright.PageContent, error)
+	getPageContentMutex       sync.RWMutex
+	getPageContentArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 time.Duration
+	}
+	getPageContentReturns struct {
+		result1 *playwright.PageContent
+		result2 error
+	}
+	getPageContentReturnsOnCall map[int]struct {
+		result1 *playwright.PageContent
+		result2 error
+	}
+	GetPageSnapshotStub        func(context.Context, string, playwright.SnapshotOptions) (*playwright.PageSnapshot, error)
+	getPageSnapshotMutex       sync.RWMutex
+	getPageSnapshotArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.SnapshotOptions
+	}
+	getPageSnapshotReturns struct {
+		result1 *playwright.PageSnapshot
+		result2 error
+	}
+	getPageSnapshotReturnsOnCall map[int]struct {
+		result1 *playwright.PageSnapshot
+		result2 error
+	}
 	GetSessionStub        func(string) (*playwright.BrowserSession, error)
 	getSessionMutex       sync.RWMutex
 	getSessionArgsForCall []struct {
@@ -155,21 +337,48 @@ type FakeBrowserAutomation struct {
 		result1 *playwright.BrowserSession
 		result2 error
 	}
-	HandleAuthenticationStub        func(context.Context, string, string, string, string, string, map[string]string) error
+	GetStorageStub        func(context.Context, string, playwright.StorageQuery) (*playwright.WebStorage, error)
+	getStorageMutex       sync.RWMutex
+	getStorageArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.StorageQuery
+	}
+	getStorageReturns struct {
+		result1 *playwright.WebStorage
+		result2 error
+	}
+	getStorageReturnsOnCall map[int]struct {
+		result1 *playwright.WebStorage
+		result2 error
+	}
+	HandleAuthenticationStub        func(context.Context, string, playwright.AuthenticationOptions) (*playwright.AuthenticationResult, error)
 	handleAuthenticationMutex       sync.RWMutex
 	handleAuthenticationArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
-		arg3 string
-		arg4 string
-		arg5 string
-		arg6 string
-		arg7 map[string]string
+		arg3 playwright.AuthenticationOptions
 	}
 	handleAuthenticationReturns struct {
-		result1 error
+		result1 *playwright.AuthenticationResult
+		result2 error
 	}
 	handleAuthenticationReturnsOnCall map[int]struct {
+		result1 *playwright.AuthenticationResult
+		result2 error
+	}
+	HoverStub        func(context.Context, string, string, playwright.MouseOptions) error
+	hoverMutex       sync.RWMutex
+	hoverArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 playwright.MouseOptions
+	}
+	hoverReturns struct {
+		result1 error
+	}
+	hoverReturnsOnCall map[int]struct {
 		result1 error
 	}
 	LaunchBrowserStub        func(context.Context, *playwright.BrowserConfig) (*playwright.BrowserSession, error)
@@ -186,7 +395,65 @@ type FakeBrowserAutomation struct {
 		result1 *playwright.BrowserSession
 		result2 error
 	}
-	NavigateToURLStub        func(context.Context, string, string, string, time.Duration) error
+	ListProfilesStub        func(context.Context) ([]playwright.Profile, error)
+	listProfilesMutex       sync.RWMutex
+	listProfilesArgsForCall []struct {
+		arg1 context.Context
+	}
+	listProfilesReturns struct {
+		result1 []playwright.Profile
+		result2 error
+	}
+	listProfilesReturnsOnCall map[int]struct {
+		result1 []playwright.Profile
+		result2 error
+	}
+	ListRoutesStub        func(context.Context, string) ([]playwright.RouteRule, error)
+	listRoutesMutex       sync.RWMutex
+	listRoutesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	listRoutesReturns struct {
+		result1 []playwright.RouteRule
+		result2 error
+	}
+	listRoutesReturnsOnCall map[int]struct {
+		result1 []playwright.RouteRule
+		result2 error
+	}
+	ListTabsStub        func(context.Context, string) ([]playwright.Tab, error)
+	listTabsMutex       sync.RWMutex
+	listTabsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	listTabsReturns struct {
+		result1 []playwright.Tab
+		result2 error
+	}
+	listTabsReturnsOnCall map[int]struct {
+		result1 []playwright.Tab
+		result2 error
+	}
+	NavigateHistoryStub        func(context.Context, string, string, string, time.Duration) (*playwright.NavigationResult, error)
+	navigateHistoryMutex       sync.RWMutex
+	navigateHistoryArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 time.Duration
+	}
+	navigateHistoryReturns struct {
+		result1 *playwright.NavigationResult
+		result2 error
+	}
+	navigateHistoryReturnsOnCall map[int]struct {
+		result1 *playwright.NavigationResult
+		result2 error
+	}
+	NavigateToURLStub        func(context.Context, string, string, string, time.Duration) (*playwright.NavigationResult, error)
 	navigateToURLMutex       sync.RWMutex
 	navigateToURLArgsForCall []struct {
 		arg1 context.Context
@@ -196,11 +463,159 @@ type FakeBrowserAutomation struct {
 		arg5 time.Duration
 	}
 	navigateToURLReturns struct {
-		result1 error
+		result1 *playwright.NavigationResult
+		result2 error
 	}
 	navigateToURLReturnsOnCall map[int]struct {
+		result1 *playwright.NavigationResult
+		result2 error
+	}
+	OpenTabStub        func(context.Context, string, string, string, time.Duration) (*playwright.Tab, error)
+	openTabMutex       sync.RWMutex
+	openTabArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 time.Duration
+	}
+	openTabReturns struct {
+		result1 *playwright.Tab
+		result2 error
+	}
+	openTabReturnsOnCall map[int]struct {
+		result1 *playwright.Tab
+		result2 error
+	}
+	PressKeysStub        func(context.Context, string, []string, playwright.KeyboardOptions) error
+	pressKeysMutex       sync.RWMutex
+	pressKeysArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []string
+		arg4 playwright.KeyboardOptions
+	}
+	pressKeysReturns struct {
+		result1 error
+	}
+	pressKeysReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveRoutesStub        func(context.Context, string, []string) ([]playwright.RouteRule, error)
+	removeRoutesMutex       sync.RWMutex
+	removeRoutesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []string
+	}
+	removeRoutesReturns struct {
+		result1 []playwright.RouteRule
+		result2 error
+	}
+	removeRoutesReturnsOnCall map[int]struct {
+		result1 []playwright.RouteRule
+		result2 error
+	}
+	SaveProfileStub        func(context.Context, string, string) (*playwright.Profile, error)
+	saveProfileMutex       sync.RWMutex
+	saveProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	saveProfileReturns struct {
+		result1 *playwright.Profile
+		result2 error
+	}
+	saveProfileReturnsOnCall map[int]struct {
+		result1 *playwright.Profile
+		result2 error
+	}
+	SaveStorageStateStub        func(context.Context, string, string) error
+	saveStorageStateMutex       sync.RWMutex
+	saveStorageStateArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	saveStorageStateReturns struct {
+		result1 error
+	}
+	saveStorageStateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ScrollStub        func(context.Context, string, playwright.ScrollOptions) (*playwright.ScrollPosition, error)
+	scrollMutex       sync.RWMutex
+	scrollArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.ScrollOptions
+	}
+	scrollReturns struct {
+		result1 *playwright.ScrollPosition
+		result2 error
+	}
+	scrollReturnsOnCall map[int]struct {
+		result1 *playwright.ScrollPosition
+		result2 error
+	}
+	ScrollIntoViewStub        func(context.Context, string, string, playwright.MouseOptions) error
+	scrollIntoViewMutex       sync.RWMutex
+	scrollIntoViewArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 playwright.MouseOptions
+	}
+	scrollIntoViewReturns struct {
+		result1 error
+	}
+	scrollIntoViewReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetCookiesStub        func(context.Context, string, []playwright.Cookie) error
+	setCookiesMutex       sync.RWMutex
+	setCookiesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []playwright.Cookie
+	}
+	setCookiesReturns struct {
+		result1 error
+	}
+	setCookiesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetProxyStub        func(context.Context, string, playwright.ProxyChange) (*proxy.Settings, error)
+	setProxyMutex       sync.RWMutex
+	setProxyArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.ProxyChange
+	}
+	setProxyReturns struct {
+		result1 *proxy.Settings
+		result2 error
+	}
+	setProxyReturnsOnCall map[int]struct {
+		result1 *proxy.Settings
+		result2 error
+	}
+	SetStorageStub        func(context.Context, string, playwright.StorageUpdate) (*playwright.WebStorage, error)
+	setStorageMutex       sync.RWMutex
+	setStorageArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.StorageUpdate
+	}
+	setStorageReturns struct {
+		result1 *playwright.WebStorage
+		result2 error
+	}
+	setStorageReturnsOnCall map[int]struct {
+		result1 *playwright.WebStorage
+		result2 error
+	}
 	ShutdownStub        func(context.Context) error
 	shutdownMutex       sync.RWMutex
 	shutdownArgsForCall []struct {
@@ -212,6 +627,21 @@ type FakeBrowserAutomation struct {
 	shutdownReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SwitchTabStub        func(context.Context, string, string) (*playwright.Tab, error)
+	switchTabMutex       sync.RWMutex
+	switchTabArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	switchTabReturns struct {
+		result1 *playwright.Tab
+		result2 error
+	}
+	switchTabReturnsOnCall map[int]struct {
+		result1 *playwright.Tab
+		result2 error
+	}
 	TakeScreenshotStub        func(context.Context, string, string, bool, string, string, int) error
 	takeScreenshotMutex       sync.RWMutex
 	takeScreenshotArgsForCall []struct {
@@ -229,27 +659,252 @@ type FakeBrowserAutomation struct {
 	takeScreenshotReturnsOnCall map[int]struct {
 		result1 error
 	}
-	WaitForConditionStub        func(context.Context, string, string, string, string, time.Duration, string) error
+	TypeTextStub        func(context.Context, string, string, playwright.KeyboardOptions) error
+	typeTextMutex       sync.RWMutex
+	typeTextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 playwright.KeyboardOptions
+	}
+	typeTextReturns struct {
+		result1 error
+	}
+	typeTextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WaitForConditionStub        func(context.Context, string, string, playwright.WaitOptions) (*playwright.WaitResult, error)
 	waitForConditionMutex       sync.RWMutex
 	waitForConditionArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
 		arg3 string
-		arg4 string
-		arg5 string
-		arg6 time.Duration
-		arg7 string
+		arg4 playwright.WaitOptions
 	}
 	waitForConditionReturns struct {
-		result1 error
+		result1 *playwright.WaitResult
+		result2 error
 	}
 	waitForConditionReturnsOnCall map[int]struct {
-		result1 error
+		result1 *playwright.WaitResult
+		result2 error
+	}
+	WaitForDownloadStub        func(context.Context, string, playwright.DownloadOptions) (*playwright.Download, error)
+	waitForDownloadMutex       sync.RWMutex
+	waitForDownloadArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.DownloadOptions
+	}
+	waitForDownloadReturns struct {
+		result1 *playwright.Download
+		result2 error
+	}
+	waitForDownloadReturnsOnCall map[int]struct {
+		result1 *playwright.Download
+		result2 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeBrowserAutomation) AddRoute(arg1 context.Context, arg2 string, arg3 playwright.RouteRule) (*playwright.RouteRule, error) {
+	fake.addRouteMutex.Lock()
+	ret, specificReturn := fake.addRouteReturnsOnCall[len(fake.addRouteArgsForCall)]
+	fake.addRouteArgsForCall = append(fake.addRouteArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.RouteRule
+	}{arg1, arg2, arg3})
+	stub := fake.AddRouteStub
+	fakeReturns := fake.addRouteReturns
+	fake.recordInvocation("AddRoute", []interface{}{arg1, arg2, arg3})
+	fake.addRouteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) AddRouteCallCount() int {
+	fake.addRouteMutex.RLock()
+	defer fake.addRouteMutex.RUnlock()
+	return len(fake.addRouteArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) AddRouteCalls(stub func(context.Context, string, playwright.RouteRule) (*playwright.RouteRule, error)) {
+	fake.addRouteMutex.Lock()
+	defer fake.addRouteMutex.Unlock()
+	fake.AddRouteStub = stub
+}
+
+func (fake *FakeBrowserAutomation) AddRouteArgsForCall(i int) (context.Context, string, playwright.RouteRule) {
+	fake.addRouteMutex.RLock()
+	defer fake.addRouteMutex.RUnlock()
+	argsForCall := fake.addRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrowserAutomation) AddRouteReturns(result1 *playwright.RouteRule, result2 error) {
+	fake.addRouteMutex.Lock()
+	defer fake.addRouteMutex.Unlock()
+	fake.AddRouteStub = nil
+	fake.addRouteReturns = struct {
+		result1 *playwright.RouteRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) AddRouteReturnsOnCall(i int, result1 *playwright.RouteRule, result2 error) {
+	fake.addRouteMutex.Lock()
+	defer fake.addRouteMutex.Unlock()
+	fake.AddRouteStub = nil
+	if fake.addRouteReturnsOnCall == nil {
+		fake.addRouteReturnsOnCall = make(map[int]struct {
+			result1 *playwright.RouteRule
+			result2 error
+		})
+	}
+	fake.addRouteReturnsOnCall[i] = struct {
+		result1 *playwright.RouteRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) ClearCookies(arg1 context.Context, arg2 string, arg3 playwright.CookieFilter) (int, error) {
+	fake.clearCookiesMutex.Lock()
+	ret, specificReturn := fake.clearCookiesReturnsOnCall[len(fake.clearCookiesArgsForCall)]
+	fake.clearCookiesArgsForCall = append(fake.clearCookiesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.CookieFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ClearCookiesStub
+	fakeReturns := fake.clearCookiesReturns
+	fake.recordInvocation("ClearCookies", []interface{}{arg1, arg2, arg3})
+	fake.clearCookiesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) ClearCookiesCallCount() int {
+	fake.clearCookiesMutex.RLock()
+	defer fake.clearCookiesMutex.RUnlock()
+	return len(fake.clearCookiesArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) ClearCookiesCalls(stub func(context.Context, string, playwright.CookieFilter) (int, error)) {
+	fake.clearCookiesMutex.Lock()
+	defer fake.clearCookiesMutex.Unlock()
+	fake.ClearCookiesStub = stub
+}
+
+func (fake *FakeBrowserAutomation) ClearCookiesArgsForCall(i int) (context.Context, string, playwright.CookieFilter) {
+	fake.clearCookiesMutex.RLock()
+	defer fake.clearCookiesMutex.RUnlock()
+	argsForCall := fake.clearCookiesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrowserAutomation) ClearCookiesReturns(result1 int, result2 error) {
+	fake.clearCookiesMutex.Lock()
+	defer fake.clearCookiesMutex.Unlock()
+	fake.ClearCookiesStub = nil
+	fake.clearCookiesReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) ClearCookiesReturnsOnCall(i int, result1 int, result2 error) {
+	fake.clearCookiesMutex.Lock()
+	defer fake.clearCookiesMutex.Unlock()
+	fake.ClearCookiesStub = nil
+	if fake.clearCookiesReturnsOnCall == nil {
+		fake.clearCookiesReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.clearCookiesReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) ClickAt(arg1 context.Context, arg2 string, arg3 float64, arg4 float64, arg5 playwright.ClickAtOptions) error {
+	fake.clickAtMutex.Lock()
+	ret, specificReturn := fake.clickAtReturnsOnCall[len(fake.clickAtArgsForCall)]
+	fake.clickAtArgsForCall = append(fake.clickAtArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 float64
+		arg4 float64
+		arg5 playwright.ClickAtOptions
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ClickAtStub
+	fakeReturns := fake.clickAtReturns
+	fake.recordInvocation("ClickAt", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.clickAtMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBrowserAutomation) ClickAtCallCount() int {
+	fake.clickAtMutex.RLock()
+	defer fake.clickAtMutex.RUnlock()
+	return len(fake.clickAtArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) ClickAtCalls(stub func(context.Context, string, float64, float64, playwright.ClickAtOptions) error) {
+	fake.clickAtMutex.Lock()
+	defer fake.clickAtMutex.Unlock()
+	fake.ClickAtStub = stub
+}
+
+func (fake *FakeBrowserAutomation) ClickAtArgsForCall(i int) (context.Context, string, float64, float64, playwright.ClickAtOptions) {
+	fake.clickAtMutex.RLock()
+	defer fake.clickAtMutex.RUnlock()
+	argsForCall := fake.clickAtArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeBrowserAutomation) ClickAtReturns(result1 error) {
+	fake.clickAtMutex.Lock()
+	defer fake.clickAtMutex.Unlock()
+	fake.ClickAtStub = nil
+	fake.clickAtReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserAutomation) ClickAtReturnsOnCall(i int, result1 error) {
+	fake.clickAtMutex.Lock()
+	defer fake.clickAtMutex.Unlock()
+	fake.ClickAtStub = nil
+	if fake.clickAtReturnsOnCall == nil {
+		fake.clickAtReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.clickAtReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBrowserAutomation) ClickElement(arg1 context.Context, arg2 string, arg3 string, arg4 map[string]any) error {
 	fake.clickElementMutex.Lock()
 	ret, specificReturn := fake.clickElementReturnsOnCall[len(fake.clickElementArgsForCall)]
@@ -437,6 +1092,328 @@ func (fake *FakeBrowserAutomation) CloseExpiredSessionsReturnsOnCall(i int, resu
 	}{result1}
 }
 
+func (fake *FakeBrowserAutomation) CloseTab(arg1 context.Context, arg2 string, arg3 string) (*playwright.Tab, error) {
+	fake.closeTabMutex.Lock()
+	ret, specificReturn := fake.closeTabReturnsOnCall[len(fake.closeTabArgsForCall)]
+	fake.closeTabArgsForCall = append(fake.closeTabArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CloseTabStub
+	fakeReturns := fake.closeTabReturns
+	fake.recordInvocation("CloseTab", []interface{}{arg1, arg2, arg3})
+	fake.closeTabMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) CloseTabCallCount() int {
+	fake.closeTabMutex.RLock()
+	defer fake.closeTabMutex.RUnlock()
+	return len(fake.closeTabArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) CloseTabCalls(stub func(context.Context, string, string) (*playwright.Tab, error)) {
+	fake.closeTabMutex.Lock()
+	defer fake.closeTabMutex.Unlock()
+	fake.CloseTabStub = stub
+}
+
+func (fake *FakeBrowserAutomation) CloseTabArgsForCall(i int) (context.Context, string, string) {
+	fake.closeTabMutex.RLock()
+	defer fake.closeTabMutex.RUnlock()
+	argsForCall := fake.closeTabArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrowserAutomation) CloseTabReturns(result1 *playwright.Tab, result2 error) {
+	fake.closeTabMutex.Lock()
+	defer fake.closeTabMutex.Unlock()
+	fake.CloseTabStub = nil
+	fake.closeTabReturns = struct {
+		result1 *playwright.Tab
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) CloseTabReturnsOnCall(i int, result1 *playwright.Tab, result2 error) {
+	fake.closeTabMutex.Lock()
+	defer fake.closeTabMutex.Unlock()
+	fake.CloseTabStub = nil
+	if fake.closeTabReturnsOnCall == nil {
+		fake.closeTabReturnsOnCall = make(map[int]struct {
+			result1 *playwright.Tab
+			result2 error
+		})
+	}
+	fake.closeTabReturnsOnCall[i] = struct {
+		result1 *playwright.Tab
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) ConfigureBrowser(arg1 context.Context, arg2 string, arg3 playwright.BrowserEmulation) (*playwright.EmulationSettings, error) {
+	fake.configureBrowserMutex.Lock()
+	ret, specificReturn := fake.configureBrowserReturnsOnCall[len(fake.configureBrowserArgsForCall)]
+	fake.configureBrowserArgsForCall = append(fake.configureBrowserArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.BrowserEmulation
+	}{arg1, arg2, arg3})
+	stub := fake.ConfigureBrowserStub
+	fakeReturns := fake.configureBrowserReturns
+	fake.recordInvocation("ConfigureBrowser", []interface{}{arg1, arg2, arg3})
+	fake.configureBrowserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) ConfigureBrowserCallCount() int {
+	fake.configureBrowserMutex.RLock()
+	defer fake.configureBrowserMutex.RUnlock()
+	return len(fake.configureBrowserArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) ConfigureBrowserCalls(stub func(context.Context, string, playwright.BrowserEmulation) (*playwright.EmulationSettings, error)) {
+	fake.configureBrowserMutex.Lock()
+	defer fake.configureBrowserMutex.Unlock()
+	fake.ConfigureBrowserStub = stub
+}
+
+func (fake *FakeBrowserAutomation) ConfigureBrowserArgsForCall(i int) (context.Context, string, playwright.BrowserEmulation) {
+	fake.configureBrowserMutex.RLock()
+	defer fake.configureBrowserMutex.RUnlock()
+	argsForCall := fake.configureBrowserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrowserAutomation) ConfigureBrowserReturns(result1 *playwright.EmulationSettings, result2 error) {
+	fake.configureBrowserMutex.Lock()
+	defer fake.configureBrowserMutex.Unlock()
+	fake.ConfigureBrowserStub = nil
+	fake.configureBrowserReturns = struct {
+		result1 *playwright.EmulationSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) ConfigureBrowserReturnsOnCall(i int, result1 *playwright.EmulationSettings, result2 error) {
+	fake.configureBrowserMutex.Lock()
+	defer fake.configureBrowserMutex.Unlock()
+	fake.ConfigureBrowserStub = nil
+	if fake.configureBrowserReturnsOnCall == nil {
+		fake.configureBrowserReturnsOnCall = make(map[int]struct {
+			result1 *playwright.EmulationSettings
+			result2 error
+		})
+	}
+	fake.configureBrowserReturnsOnCall[i] = struct {
+		result1 *playwright.EmulationSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) DeleteProfile(arg1 context.Context, arg2 string) error {
+	fake.deleteProfileMutex.Lock()
+	ret, specificReturn := fake.deleteProfileReturnsOnCall[len(fake.deleteProfileArgsForCall)]
+	fake.deleteProfileArgsForCall = append(fake.deleteProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteProfileStub
+	fakeReturns := fake.deleteProfileReturns
+	fake.recordInvocation("DeleteProfile", []interface{}{arg1, arg2})
+	fake.deleteProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBrowserAutomation) DeleteProfileCallCount() int {
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	return len(fake.deleteProfileArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) DeleteProfileCalls(stub func(context.Context, string) error) {
+	fake.deleteProfileMutex.Lock()
+	defer fake.deleteProfileMutex.Unlock()
+	fake.DeleteProfileStub = stub
+}
+
+func (fake *FakeBrowserAutomation) DeleteProfileArgsForCall(i int) (context.Context, string) {
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	argsForCall := fake.deleteProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBrowserAutomation) DeleteProfileReturns(result1 error) {
+	fake.deleteProfileMutex.Lock()
+	defer fake.deleteProfileMutex.Unlock()
+	fake.DeleteProfileStub = nil
+	fake.deleteProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserAutomation) DeleteProfileReturnsOnCall(i int, result1 error) {
+	fake.deleteProfileMutex.Lock()
+	defer fake.deleteProfileMutex.Unlock()
+	fake.DeleteProfileStub = nil
+	if fake.deleteProfileReturnsOnCall == nil {
+		fake.deleteProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserAutomation) DownloadStored(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.downloadStoredMutex.Lock()
+	ret, specificReturn := fake.downloadStoredReturnsOnCall[len(fake.downloadStoredArgsForCall)]
+	fake.downloadStoredArgsForCall = append(fake.downloadStoredArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DownloadStoredStub
+	fakeReturns := fake.downloadStoredReturns
+	fake.recordInvocation("DownloadStored", []interface{}{arg1, arg2, arg3})
+	fake.downloadStoredMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBrowserAutomation) DownloadStoredCallCount() int {
+	fake.downloadStoredMutex.RLock()
+	defer fake.downloadStoredMutex.RUnlock()
+	return len(fake.downloadStoredArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) DownloadStoredCalls(stub func(context.Context, string, string) error) {
+	fake.downloadStoredMutex.Lock()
+	defer fake.downloadStoredMutex.Unlock()
+	fake.DownloadStoredStub = stub
+}
+
+func (fake *FakeBrowserAutomation) DownloadStoredArgsForCall(i int) (context.Context, string, string) {
+	fake.downloadStoredMutex.RLock()
+	defer fake.downloadStoredMutex.RUnlock()
+	argsForCall := fake.downloadStoredArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrowserAutomation) DownloadStoredReturns(result1 error) {
+	fake.downloadStoredMutex.Lock()
+	defer fake.downloadStoredMutex.Unlock()
+	fake.DownloadStoredStub = nil
+	fake.downloadStoredReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserAutomation) DownloadStoredReturnsOnCall(i int, result1 error) {
+	fake.downloadStoredMutex.Lock()
+	defer fake.downloadStoredMutex.Unlock()
+	fake.DownloadStoredStub = nil
+	if fake.downloadStoredReturnsOnCall == nil {
+		fake.downloadStoredReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.downloadStoredReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserAutomation) DragAndDrop(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 playwright.MouseOptions) error {
+	fake.dragAndDropMutex.Lock()
+	ret, specificReturn := fake.dragAndDropReturnsOnCall[len(fake.dragAndDropArgsForCall)]
+	fake.dragAndDropArgsForCall = append(fake.dragAndDropArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 playwright.MouseOptions
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.DragAndDropStub
+	fakeReturns := fake.dragAndDropReturns
+	fake.recordInvocation("DragAndDrop", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.dragAndDropMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBrowserAutomation) DragAndDropCallCount() int {
+	fake.dragAndDropMutex.RLock()
+	defer fake.dragAndDropMutex.RUnlock()
+	return len(fake.dragAndDropArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) DragAndDropCalls(stub func(context.Context, string, string, string, playwright.MouseOptions) error) {
+	fake.dragAndDropMutex.Lock()
+	defer fake.dragAndDropMutex.Unlock()
+	fake.DragAndDropStub = stub
+}
+
+func (fake *FakeBrowserAutomation) DragAndDropArgsForCall(i int) (context.Context, string, string, string, playwright.MouseOptions) {
+	fake.dragAndDropMutex.RLock()
+	defer fake.dragAndDropMutex.RUnlock()
+	argsForCall := fake.dragAndDropArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeBrowserAutomation) DragAndDropReturns(result1 error) {
+	fake.dragAndDropMutex.Lock()
+	defer fake.dragAndDropMutex.Unlock()
+	fake.DragAndDropStub = nil
+	fake.dragAndDropReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserAutomation) DragAndDropReturnsOnCall(i int, result1 error) {
+	fake.dragAndDropMutex.Lock()
+	defer fake.dragAndDropMutex.Unlock()
+	fake.DragAndDropStub = nil
+	if fake.dragAndDropReturnsOnCall == nil {
+		fake.dragAndDropReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.dragAndDropReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBrowserAutomation) ExecuteScript(arg1 context.Context, arg2 string, arg3 string, arg4 []any) (any, error) {
 	var arg4Copy []any
 	if arg4 != nil {
@@ -581,7 +1558,7 @@ func (fake *FakeBrowserAutomation) ExtractDataReturnsOnCall(i int, result1 strin
 	}{result1, result2}
 }
 
-func (fake *FakeBrowserAutomation) FillForm(arg1 context.Context, arg2 string, arg3 []map[string]any, arg4 bool, arg5 string) error {
+func (fake *FakeBrowserAutomation) FillForm(arg1 context.Context, arg2 string, arg3 []map[string]any, arg4 bool, arg5 string) ([]playwright.FieldResult, error) {
 	var arg3Copy []map[string]any
 	if arg3 != nil {
 		arg3Copy = make([]map[string]any, len(arg3))
@@ -604,9 +1581,9 @@ func (fake *FakeBrowserAutomation) FillForm(arg1 context.Context, arg2 string, a
 		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
 func (fake *FakeBrowserAutomation) FillFormCallCount() int {
@@ -615,7 +1592,7 @@ func (fake *FakeBrowserAutomation) FillFormCallCount() int {
 	return len(fake.fillFormArgsForCall)
 }
 
-func (fake *FakeBrowserAutomation) FillFormCalls(stub func(context.Context, string, []map[string]any, bool, string) error) {
+func (fake *FakeBrowserAutomation) FillFormCalls(stub func(context.Context, string, []map[string]any, bool, string) ([]playwright.FieldResult, error)) {
 	fake.fillFormMutex.Lock()
 	defer fake.fillFormMutex.Unlock()
 	fake.FillFormStub = stub
@@ -628,27 +1605,30 @@ func (fake *FakeBrowserAutomation) FillFormArgsForCall(i int) (context.Context,
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
-func (fake *FakeBrowserAutomation) FillFormReturns(result1 error) {
+func (fake *FakeBrowserAutomation) FillFormReturns(result1 []playwright.FieldResult, result2 error) {
 	fake.fillFormMutex.Lock()
 	defer fake.fillFormMutex.Unlock()
 	fake.FillFormStub = nil
 	fake.fillFormReturns = struct {
-		result1 error
-	}{result1}
+		result1 []playwright.FieldResult
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeBrowserAutomation) FillFormReturnsOnCall(i int, result1 error) {
+func (fake *FakeBrowserAutomation) FillFormReturnsOnCall(i int, result1 []playwright.FieldResult, result2 error) {
 	fake.fillFormMutex.Lock()
 	defer fake.fillFormMutex.Unlock()
 	fake.FillFormStub = nil
 	if fake.fillFormReturnsOnCall == nil {
 		fake.fillFormReturnsOnCall = make(map[int]struct {
-			result1 error
+			result1 []playwright.FieldResult
+			result2 error
 		})
 	}
 	fake.fillFormReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+		result1 []playwright.FieldResult
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeBrowserAutomation) GetConfig() *config.Config {
@@ -704,21 +1684,153 @@ func (fake *FakeBrowserAutomation) GetConfigReturnsOnCall(i int, result1 *config
 	}{result1}
 }
 
-func (fake *FakeBrowserAutomation) GetHealth(arg1 context.Context) error {
-	fake.getHealthMutex.Lock()
-	ret, specificReturn := fake.getHealthReturnsOnCall[len(fake.getHealthArgsForCall)]
-	fake.getHealthArgsForCall = append(fake.getHealthArgsForCall, struct {
+func (fake *FakeBrowserAutomation) GetConsoleLogs(arg1 context.Context, arg2 string, arg3 playwright.ConsoleFilter) (*playwright.ConsoleLogs, error) {
+	fake.getConsoleLogsMutex.Lock()
+	ret, specificReturn := fake.getConsoleLogsReturnsOnCall[len(fake.getConsoleLogsArgsForCall)]
+	fake.getConsoleLogsArgsForCall = append(fake.getConsoleLogsArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.GetHealthStub
-	fakeReturns := fake.getHealthReturns
-	fake.recordInvocation("GetHealth", []interface{}{arg1})
-	fake.getHealthMutex.Unlock()
+		arg2 string
+		arg3 playwright.ConsoleFilter
+	}{arg1, arg2, arg3})
+	stub := fake.GetConsoleLogsStub
+	fakeReturns := fake.getConsoleLogsReturns
+	fake.recordInvocation("GetConsoleLogs", []interface{}{arg1, arg2, arg3})
+	fake.getConsoleLogsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) GetConsoleLogsCallCount() int {
+	fake.getConsoleLogsMutex.RLock()
+	defer fake.getConsoleLogsMutex.RUnlock()
+	return len(fake.getConsoleLogsArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) GetConsoleLogsCalls(stub func(context.Context, string, playwright.ConsoleFilter) (*playwright.ConsoleLogs, error)) {
+	fake.getConsoleLogsMutex.Lock()
+	defer fake.getConsoleLogsMutex.Unlock()
+	fake.GetConsoleLogsStub = stub
+}
+
+func (fake *FakeBrowserAutomation) GetConsoleLogsArgsForCall(i int) (context.Context, string, playwright.ConsoleFilter) {
+	fake.getConsoleLogsMutex.RLock()
+	defer fake.getConsoleLogsMutex.RUnlock()
+	argsForCall := fake.getConsoleLogsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrowserAutomation) GetConsoleLogsReturns(result1 *playwright.ConsoleLogs, result2 error) {
+	fake.getConsoleLogsMutex.Lock()
+	defer fake.getConsoleLogsMutex.Unlock()
+	fake.GetConsoleLogsStub = nil
+	fake.getConsoleLogsReturns = struct {
+		result1 *playwright.ConsoleLogs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) GetConsoleLogsReturnsOnCall(i int, result1 *playwright.ConsoleLogs, result2 error) {
+	fake.getConsoleLogsMutex.Lock()
+	defer fake.getConsoleLogsMutex.Unlock()
+	fake.GetConsoleLogsStub = nil
+	if fake.getConsoleLogsReturnsOnCall == nil {
+		fake.getConsoleLogsReturnsOnCall = make(map[int]struct {
+			result1 *playwright.ConsoleLogs
+			result2 error
+		})
+	}
+	fake.getConsoleLogsReturnsOnCall[i] = struct {
+		result1 *playwright.ConsoleLogs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) GetCookies(arg1 context.Context, arg2 string, arg3 playwright.CookieFilter) ([]playwright.Cookie, error) {
+	fake.getCookiesMutex.Lock()
+	ret, specificReturn := fake.getCookiesReturnsOnCall[len(fake.getCookiesArgsForCall)]
+	fake.getCookiesArgsForCall = append(fake.getCookiesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.CookieFilter
+	}{arg1, arg2, arg3})
+	stub := fake.GetCookiesStub
+	fakeReturns := fake.getCookiesReturns
+	fake.recordInvocation("GetCookies", []interface{}{arg1, arg2, arg3})
+	fake.getCookiesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) GetCookiesCallCount() int {
+	fake.getCookiesMutex.RLock()
+	defer fake.getCookiesMutex.RUnlock()
+	return len(fake.getCookiesArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) GetCookiesCalls(stub func(context.Context, string, playwright.CookieFilter) ([]playwright.Cookie, error)) {
+	fake.getCookiesMutex.Lock()
+	defer fake.getCookiesMutex.Unlock()
+	fake.GetCookiesStub = stub
+}
+
+func (fake *FakeBrowserAutomation) GetCookiesArgsForCall(i int) (context.Context, string, playwright.CookieFilter) {
+	fake.getCookiesMutex.RLock()
+	defer fake.getCookiesMutex.RUnlock()
+	argsForCall := fake.getCookiesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrowserAutomation) GetCookiesReturns(result1 []playwright.Cookie, result2 error) {
+	fake.getCookiesMutex.Lock()
+	defer fake.getCookiesMutex.Unlock()
+	fake.GetCookiesStub = nil
+	fake.getCookiesReturns = struct {
+		result1 []playwright.Cookie
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) GetCookiesReturnsOnCall(i int, result1 []playwright.Cookie, result2 error) {
+	fake.getCookiesMutex.Lock()
+	defer fake.getCookiesMutex.Unlock()
+	fake.GetCookiesStub = nil
+	if fake.getCookiesReturnsOnCall == nil {
+		fake.getCookiesReturnsOnCall = make(map[int]struct {
+			result1 []playwright.Cookie
+			result2 error
+		})
+	}
+	fake.getCookiesReturnsOnCall[i] = struct {
+		result1 []playwright.Cookie
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) GetHealth(arg1 context.Context) error {
+	fake.getHealthMutex.Lock()
+	ret, specificReturn := fake.getHealthReturnsOnCall[len(fake.getHealthArgsForCall)]
+	fake.getHealthArgsForCall = append(fake.getHealthArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetHealthStub
+	fakeReturns := fake.getHealthReturns
+	fake.recordInvocation("GetHealth", []interface{}{arg1})
+	fake.getHealthMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
 	}
 	return fakeReturns.result1
 }
@@ -765,18 +1877,20 @@ func (fake *FakeBrowserAutomation) GetHealthReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
-func (fake *FakeBrowserAutomation) GetOrCreateDefaultSession(arg1 context.Context) (*playwright.BrowserSession, error) {
-	fake.getOrCreateDefaultSessionMutex.Lock()
-	ret, specificReturn := fake.getOrCreateDefaultSessionReturnsOnCall[len(fake.getOrCreateDefaultSessionArgsForCall)]
-	fake.getOrCreateDefaultSessionArgsForCall = append(fake.getOrCreateDefaultSessionArgsForCall, struct {
+func (fake *FakeBrowserAutomation) GetNetworkLog(arg1 context.Context, arg2 string, arg3 playwright.NetworkFilter) (*playwright.NetworkLog, error) {
+	fake.getNetworkLogMutex.Lock()
+	ret, specificReturn := fake.getNetworkLogReturnsOnCall[len(fake.getNetworkLogArgsForCall)]
+	fake.getNetworkLogArgsForCall = append(fake.getNetworkLogArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.GetOrCreateDefaultSessionStub
-	fakeReturns := fake.getOrCreateDefaultSessionReturns
-	fake.recordInvocation("GetOrCreateDefaultSession", []interface{}{arg1})
-	fake.getOrCreateDefaultSessionMutex.Unlock()
+		arg2 string
+		arg3 playwright.NetworkFilter
+	}{arg1, arg2, arg3})
+	stub := fake.GetNetworkLogStub
+	fakeReturns := fake.getNetworkLogReturns
+	fake.recordInvocation("GetNetworkLog", []interface{}{arg1, arg2, arg3})
+	fake.getNetworkLogMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -784,47 +1898,47 @@ func (fake *FakeBrowserAutomation) GetOrCreateDefaultSession(arg1 context.Contex
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeBrowserAutomation) GetOrCreateDefaultSessionCallCount() int {
-	fake.getOrCreateDefaultSessionMutex.RLock()
-	defer fake.getOrCreateDefaultSessionMutex.RUnlock()
-	return len(fake.getOrCreateDefaultSessionArgsForCall)
+func (fake *FakeBrowserAutomation) GetNetworkLogCallCount() int {
+	fake.getNetworkLogMutex.RLock()
+	defer fake.getNetworkLogMutex.RUnlock()
+	return len(fake.getNetworkLogArgsForCall)
 }
 
-func (fake *FakeBrowserAutomation) GetOrCreateDefaultSessionCalls(stub func(context.Context) (*playwright.BrowserSession, error)) {
-	fake.getOrCreateDefaultSessionMutex.Lock()
-	defer fake.getOrCreateDefaultSessionMutex.Unlock()
-	fake.GetOrCreateDefaultSessionStub = stub
+func (fake *FakeBrowserAutomation) GetNetworkLogCalls(stub func(context.Context, string, playwright.NetworkFilter) (*playwright.NetworkLog, error)) {
+	fake.getNetworkLogMutex.Lock()
+	defer fake.getNetworkLogMutex.Unlock()
+	fake.GetNetworkLogStub = stub
 }
 
-func (fake *FakeBrowserAutomation) GetOrCreateDefaultSessionArgsForCall(i int) context.Context {
-	fake.getOrCreateDefaultSessionMutex.RLock()
-	defer fake.getOrCreateDefaultSessionMutex.RUnlock()
-	argsForCall := fake.getOrCreateDefaultSessionArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeBrowserAutomation) GetNetworkLogArgsForCall(i int) (context.Context, string, playwright.NetworkFilter) {
+	fake.getNetworkLogMutex.RLock()
+	defer fake.getNetworkLogMutex.RUnlock()
+	argsForCall := fake.getNetworkLogArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeBrowserAutomation) GetOrCreateDefaultSessionReturns(result1 *playwright.BrowserSession, result2 error) {
-	fake.getOrCreateDefaultSessionMutex.Lock()
-	defer fake.getOrCreateDefaultSessionMutex.Unlock()
-	fake.GetOrCreateDefaultSessionStub = nil
-	fake.getOrCreateDefaultSessionReturns = struct {
-		result1 *playwright.BrowserSession
+func (fake *FakeBrowserAutomation) GetNetworkLogReturns(result1 *playwright.NetworkLog, result2 error) {
+	fake.getNetworkLogMutex.Lock()
+	defer fake.getNetworkLogMutex.Unlock()
+	fake.GetNetworkLogStub = nil
+	fake.getNetworkLogReturns = struct {
+		result1 *playwright.NetworkLog
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeBrowserAutomation) GetOrCreateDefaultSessionReturnsOnCall(i int, result1 *playwright.BrowserSession, result2 error) {
-	fake.getOrCreateDefaultSessionMutex.Lock()
-	defer fake.getOrCreateDefaultSessionMutex.Unlock()
-	fake.GetOrCreateDefaultSessionStub = nil
-	if fake.getOrCreateDefaultSessionReturnsOnCall == nil {
-		fake.getOrCreateDefaultSessionReturnsOnCall = make(map[int]struct {
-			result1 *playwright.BrowserSession
+func (fake *FakeBrowserAutomation) GetNetworkLogReturnsOnCall(i int, result1 *playwright.NetworkLog, result2 error) {
+	fake.getNetworkLogMutex.Lock()
+	defer fake.getNetworkLogMutex.Unlock()
+	fake.GetNetworkLogStub = nil
+	if fake.getNetworkLogReturnsOnCall == nil {
+		fake.getNetworkLogReturnsOnCall = make(map[int]struct {
+			result1 *playwright.NetworkLog
 			result2 error
 		})
 	}
-	fake.getOrCreateDefaultSessionReturnsOnCall[i] = struct {
-		result1 *playwright.BrowserSession
+	fake.getNetworkLogReturnsOnCall[i] = struct {
+		result1 *playwright.NetworkLog
 		result2 error
 	}{result1, result2}
 }
@@ -893,6 +2007,140 @@ func (fake *FakeBrowserAutomation) GetOrCreateTaskSessionReturnsOnCall(i int, re
 	}{result1, result2}
 }
 
+func (fake *FakeBrowserAutomation) GetPageContent(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 time.Duration) (*playwright.PageContent, error) {
+	fake.getPageContentMutex.Lock()
+	ret, specificReturn := fake.getPageContentReturnsOnCall[len(fake.getPageContentArgsForCall)]
+	fake.getPageContentArgsForCall = append(fake.getPageContentArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 time.Duration
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.GetPageContentStub
+	fakeReturns := fake.getPageContentReturns
+	fake.recordInvocation("GetPageContent", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.getPageContentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) GetPageContentCallCount() int {
+	fake.getPageContentMutex.RLock()
+	defer fake.getPageContentMutex.RUnlock()
+	return len(fake.getPageContentArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) GetPageContentCalls(stub func(context.Context, string, string, string, time.Duration) (*playwright.PageContent, error)) {
+	fake.getPageContentMutex.Lock()
+	defer fake.getPageContentMutex.Unlock()
+	fake.GetPageContentStub = stub
+}
+
+func (fake *FakeBrowserAutomation) GetPageContentArgsForCall(i int) (context.Context, string, string, string, time.Duration) {
+	fake.getPageContentMutex.RLock()
+	defer fake.getPageContentMutex.RUnlock()
+	argsForCall := fake.getPageContentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeBrowserAutomation) GetPageContentReturns(result1 *playwright.PageContent, result2 error) {
+	fake.getPageContentMutex.Lock()
+	defer fake.getPageContentMutex.Unlock()
+	fake.GetPageContentStub = nil
+	fake.getPageContentReturns = struct {
+		result1 *playwright.PageContent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) GetPageContentReturnsOnCall(i int, result1 *playwright.PageContent, result2 error) {
+	fake.getPageContentMutex.Lock()
+	defer fake.getPageContentMutex.Unlock()
+	fake.GetPageContentStub = nil
+	if fake.getPageContentReturnsOnCall == nil {
+		fake.getPageContentReturnsOnCall = make(map[int]struct {
+			result1 *playwright.PageContent
+			result2 error
+		})
+	}
+	fake.getPageContentReturnsOnCall[i] = struct {
+		result1 *playwright.PageContent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) GetPageSnapshot(arg1 context.Context, arg2 string, arg3 playwright.SnapshotOptions) (*playwright.PageSnapshot, error) {
+	fake.getPageSnapshotMutex.Lock()
+	ret, specificReturn := fake.getPageSnapshotReturnsOnCall[len(fake.getPageSnapshotArgsForCall)]
+	fake.getPageSnapshotArgsForCall = append(fake.getPageSnapshotArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.SnapshotOptions
+	}{arg1, arg2, arg3})
+	stub := fake.GetPageSnapshotStub
+	fakeReturns := fake.getPageSnapshotReturns
+	fake.recordInvocation("GetPageSnapshot", []interface{}{arg1, arg2, arg3})
+	fake.getPageSnapshotMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) GetPageSnapshotCallCount() int {
+	fake.getPageSnapshotMutex.RLock()
+	defer fake.getPageSnapshotMutex.RUnlock()
+	return len(fake.getPageSnapshotArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) GetPageSnapshotCalls(stub func(context.Context, string, playwright.SnapshotOptions) (*playwright.PageSnapshot, error)) {
+	fake.getPageSnapshotMutex.Lock()
+	defer fake.getPageSnapshotMutex.Unlock()
+	fake.GetPageSnapshotStub = stub
+}
+
+func (fake *FakeBrowserAutomation) GetPageSnapshotArgsForCall(i int) (context.Context, string, playwright.SnapshotOptions) {
+	fake.getPageSnapshotMutex.RLock()
+	defer fake.getPageSnapshotMutex.RUnlock()
+	argsForCall := fake.getPageSnapshotArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrowserAutomation) GetPageSnapshotReturns(result1 *playwright.PageSnapshot, result2 error) {
+	fake.getPageSnapshotMutex.Lock()
+	defer fake.getPageSnapshotMutex.Unlock()
+	fake.GetPageSnapshotStub = nil
+	fake.getPageSnapshotReturns = struct {
+		result1 *playwright.PageSnapshot
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) GetPageSnapshotReturnsOnCall(i int, result1 *playwright.PageSnapshot, result2 error) {
+	fake.getPageSnapshotMutex.Lock()
+	defer fake.getPageSnapshotMutex.Unlock()
+	fake.GetPageSnapshotStub = nil
+	if fake.getPageSnapshotReturnsOnCall == nil {
+		fake.getPageSnapshotReturnsOnCall = make(map[int]struct {
+			result1 *playwright.PageSnapshot
+			result2 error
+		})
+	}
+	fake.getPageSnapshotReturnsOnCall[i] = struct {
+		result1 *playwright.PageSnapshot
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBrowserAutomation) GetSession(arg1 string) (*playwright.BrowserSession, error) {
 	fake.getSessionMutex.Lock()
 	ret, specificReturn := fake.getSessionReturnsOnCall[len(fake.getSessionArgsForCall)]
@@ -941,40 +2189,1099 @@ func (fake *FakeBrowserAutomation) GetSessionReturns(result1 *playwright.Browser
 	}{result1, result2}
 }
 
-func (fake *FakeBrowserAutomation) GetSessionReturnsOnCall(i int, result1 *playwright.BrowserSession, result2 error) {
-	fake.getSessionMutex.Lock()
-	defer fake.getSessionMutex.Unlock()
-	fake.GetSessionStub = nil
-	if fake.getSessionReturnsOnCall == nil {
-		fake.getSessionReturnsOnCall = make(map[int]struct {
-			result1 *playwright.BrowserSession
-			result2 error
+func (fake *FakeBrowserAutomation) GetSessionReturnsOnCall(i int, result1 *playwright.BrowserSession, result2 error) {
+	fake.getSessionMutex.Lock()
+	defer fake.getSessionMutex.Unlock()
+	fake.GetSessionStub = nil
+	if fake.getSessionReturnsOnCall == nil {
+		fake.getSessionReturnsOnCall = make(map[int]struct {
+			result1 *playwright.BrowserSession
+			result2 error
+		})
+	}
+	fake.getSessionReturnsOnCall[i] = struct {
+		result1 *playwright.BrowserSession
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) GetStorage(arg1 context.Context, arg2 string, arg3 playwright.StorageQuery) (*playwright.WebStorage, error) {
+	fake.getStorageMutex.Lock()
+	ret, specificReturn := fake.getStorageReturnsOnCall[len(fake.getStorageArgsForCall)]
+	fake.getStorageArgsForCall = append(fake.getStorageArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.StorageQuery
+	}{arg1, arg2, arg3})
+	stub := fake.GetStorageStub
+	fakeReturns := fake.getStorageReturns
+	fake.recordInvocation("GetStorage", []interface{}{arg1, arg2, arg3})
+	fake.getStorageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) GetStorageCallCount() int {
+	fake.getStorageMutex.RLock()
+	defer fake.getStorageMutex.RUnlock()
+	return len(fake.getStorageArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) GetStorageCalls(stub func(context.Context, string, playwright.StorageQuery) (*playwright.WebStorage, error)) {
+	fake.getStorageMutex.Lock()
+	defer fake.getStorageMutex.Unlock()
+	fake.GetStorageStub = stub
+}
+
+func (fake *FakeBrowserAutomation) GetStorageArgsForCall(i int) (context.Context, string, playwright.StorageQuery) {
+	fake.getStorageMutex.RLock()
+	defer fake.getStorageMutex.RUnlock()
+	argsForCall := fake.getStorageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrowserAutomation) GetStorageReturns(result1 *playwright.WebStorage, result2 error) {
+	fake.getStorageMutex.Lock()
+	defer fake.getStorageMutex.Unlock()
+	fake.GetStorageStub = nil
+	fake.getStorageReturns = struct {
+		result1 *playwright.WebStorage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) GetStorageReturnsOnCall(i int, result1 *playwright.WebStorage, result2 error) {
+	fake.getStorageMutex.Lock()
+	defer fake.getStorageMutex.Unlock()
+	fake.GetStorageStub = nil
+	if fake.getStorageReturnsOnCall == nil {
+		fake.getStorageReturnsOnCall = make(map[int]struct {
+			result1 *playwright.WebStorage
+			result2 error
+		})
+	}
+	fake.getStorageReturnsOnCall[i] = struct {
+		result1 *playwright.WebStorage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) HandleAuthentication(arg1 context.Context, arg2 string, arg3 playwright.AuthenticationOptions) (*playwright.AuthenticationResult, error) {
+	fake.handleAuthenticationMutex.Lock()
+	ret, specificReturn := fake.handleAuthenticationReturnsOnCall[len(fake.handleAuthenticationArgsForCall)]
+	fake.handleAuthenticationArgsForCall = append(fake.handleAuthenticationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.AuthenticationOptions
+	}{arg1, arg2, arg3})
+	stub := fake.HandleAuthenticationStub
+	fakeReturns := fake.handleAuthenticationReturns
+	fake.recordInvocation("HandleAuthentication", []interface{}{arg1, arg2, arg3})
+	fake.handleAuthenticationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) HandleAuthenticationCallCount() int {
+	fake.handleAuthenticationMutex.RLock()
+	defer fake.handleAuthenticationMutex.RUnlock()
+	return len(fake.handleAuthenticationArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) HandleAuthenticationCalls(stub func(context.Context, string, playwright.AuthenticationOptions) (*playwright.AuthenticationResult, error)) {
+	fake.handleAuthenticationMutex.Lock()
+	defer fake.handleAuthenticationMutex.Unlock()
+	fake.HandleAuthenticationStub = stub
+}
+
+func (fake *FakeBrowserAutomation) HandleAuthenticationArgsForCall(i int) (context.Context, string, playwright.AuthenticationOptions) {
+	fake.handleAuthenticationMutex.RLock()
+	defer fake.handleAuthenticationMutex.RUnlock()
+	argsForCall := fake.handleAuthenticationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrowserAutomation) HandleAuthenticationReturns(result1 *playwright.AuthenticationResult, result2 error) {
+	fake.handleAuthenticationMutex.Lock()
+	defer fake.handleAuthenticationMutex.Unlock()
+	fake.HandleAuthenticationStub = nil
+	fake.handleAuthenticationReturns = struct {
+		result1 *playwright.AuthenticationResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) HandleAuthenticationReturnsOnCall(i int, result1 *playwright.AuthenticationResult, result2 error) {
+	fake.handleAuthenticationMutex.Lock()
+	defer fake.handleAuthenticationMutex.Unlock()
+	fake.HandleAuthenticationStub = nil
+	if fake.handleAuthenticationReturnsOnCall == nil {
+		fake.handleAuthenticationReturnsOnCall = make(map[int]struct {
+			result1 *playwright.AuthenticationResult
+			result2 error
+		})
+	}
+	fake.handleAuthenticationReturnsOnCall[i] = struct {
+		result1 *playwright.AuthenticationResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) Hover(arg1 context.Context, arg2 string, arg3 string, arg4 playwright.MouseOptions) error {
+	fake.hoverMutex.Lock()
+	ret, specificReturn := fake.hoverReturnsOnCall[len(fake.hoverArgsForCall)]
+	fake.hoverArgsForCall = append(fake.hoverArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 playwright.MouseOptions
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.HoverStub
+	fakeReturns := fake.hoverReturns
+	fake.recordInvocation("Hover", []interface{}{arg1, arg2, arg3, arg4})
+	fake.hoverMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBrowserAutomation) HoverCallCount() int {
+	fake.hoverMutex.RLock()
+	defer fake.hoverMutex.RUnlock()
+	return len(fake.hoverArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) HoverCalls(stub func(context.Context, string, string, playwright.MouseOptions) error) {
+	fake.hoverMutex.Lock()
+	defer fake.hoverMutex.Unlock()
+	fake.HoverStub = stub
+}
+
+func (fake *FakeBrowserAutomation) HoverArgsForCall(i int) (context.Context, string, string, playwright.MouseOptions) {
+	fake.hoverMutex.RLock()
+	defer fake.hoverMutex.RUnlock()
+	argsForCall := fake.hoverArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeBrowserAutomation) HoverReturns(result1 error) {
+	fake.hoverMutex.Lock()
+	defer fake.hoverMutex.Unlock()
+	fake.HoverStub = nil
+	fake.hoverReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserAutomation) HoverReturnsOnCall(i int, result1 error) {
+	fake.hoverMutex.Lock()
+	defer fake.hoverMutex.Unlock()
+	fake.HoverStub = nil
+	if fake.hoverReturnsOnCall == nil {
+		fake.hoverReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.hoverReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserAutomation) LaunchBrowser(arg1 context.Context, arg2 *playwright.BrowserConfig) (*playwright.BrowserSession, error) {
+	fake.launchBrowserMutex.Lock()
+	ret, specificReturn := fake.launchBrowserReturnsOnCall[len(fake.launchBrowserArgsForCall)]
+	fake.launchBrowserArgsForCall = append(fake.launchBrowserArgsForCall, struct {
+		arg1 context.Context
+		arg2 *playwright.BrowserConfig
+	}{arg1, arg2})
+	stub := fake.LaunchBrowserStub
+	fakeReturns := fake.launchBrowserReturns
+	fake.recordInvocation("LaunchBrowser", []interface{}{arg1, arg2})
+	fake.launchBrowserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) LaunchBrowserCallCount() int {
+	fake.launchBrowserMutex.RLock()
+	defer fake.launchBrowserMutex.RUnlock()
+	return len(fake.launchBrowserArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) LaunchBrowserCalls(stub func(context.Context, *playwright.BrowserConfig) (*playwright.BrowserSession, error)) {
+	fake.launchBrowserMutex.Lock()
+	defer fake.launchBrowserMutex.Unlock()
+	fake.LaunchBrowserStub = stub
+}
+
+func (fake *FakeBrowserAutomation) LaunchBrowserArgsForCall(i int) (context.Context, *playwright.BrowserConfig) {
+	fake.launchBrowserMutex.RLock()
+	defer fake.launchBrowserMutex.RUnlock()
+	argsForCall := fake.launchBrowserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBrowserAutomation) LaunchBrowserReturns(result1 *playwright.BrowserSession, result2 error) {
+	fake.launchBrowserMutex.Lock()
+	defer fake.launchBrowserMutex.Unlock()
+	fake.LaunchBrowserStub = nil
+	fake.launchBrowserReturns = struct {
+		result1 *playwright.BrowserSession
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) LaunchBrowserReturnsOnCall(i int, result1 *playwright.BrowserSession, result2 error) {
+	fake.launchBrowserMutex.Lock()
+	defer fake.launchBrowserMutex.Unlock()
+	fake.LaunchBrowserStub = nil
+	if fake.launchBrowserReturnsOnCall == nil {
+		fake.launchBrowserReturnsOnCall = make(map[int]struct {
+			result1 *playwright.BrowserSession
+			result2 error
+		})
+	}
+	fake.launchBrowserReturnsOnCall[i] = struct {
+		result1 *playwright.BrowserSession
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) ListProfiles(arg1 context.Context) ([]playwright.Profile, error) {
+	fake.listProfilesMutex.Lock()
+	ret, specificReturn := fake.listProfilesReturnsOnCall[len(fake.listProfilesArgsForCall)]
+	fake.listProfilesArgsForCall = append(fake.listProfilesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListProfilesStub
+	fakeReturns := fake.listProfilesReturns
+	fake.recordInvocation("ListProfiles", []interface{}{arg1})
+	fake.listProfilesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) ListProfilesCallCount() int {
+	fake.listProfilesMutex.RLock()
+	defer fake.listProfilesMutex.RUnlock()
+	return len(fake.listProfilesArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) ListProfilesCalls(stub func(context.Context) ([]playwright.Profile, error)) {
+	fake.listProfilesMutex.Lock()
+	defer fake.listProfilesMutex.Unlock()
+	fake.ListProfilesStub = stub
+}
+
+func (fake *FakeBrowserAutomation) ListProfilesArgsForCall(i int) context.Context {
+	fake.listProfilesMutex.RLock()
+	defer fake.listProfilesMutex.RUnlock()
+	argsForCall := fake.listProfilesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBrowserAutomation) ListProfilesReturns(result1 []playwright.Profile, result2 error) {
+	fake.listProfilesMutex.Lock()
+	defer fake.listProfilesMutex.Unlock()
+	fake.ListProfilesStub = nil
+	fake.listProfilesReturns = struct {
+		result1 []playwright.Profile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) ListProfilesReturnsOnCall(i int, result1 []playwright.Profile, result2 error) {
+	fake.listProfilesMutex.Lock()
+	defer fake.listProfilesMutex.Unlock()
+	fake.ListProfilesStub = nil
+	if fake.listProfilesReturnsOnCall == nil {
+		fake.listProfilesReturnsOnCall = make(map[int]struct {
+			result1 []playwright.Profile
+			result2 error
+		})
+	}
+	fake.listProfilesReturnsOnCall[i] = struct {
+		result1 []playwright.Profile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) ListRoutes(arg1 context.Context, arg2 string) ([]playwright.RouteRule, error) {
+	fake.listRoutesMutex.Lock()
+	ret, specificReturn := fake.listRoutesReturnsOnCall[len(fake.listRoutesArgsForCall)]
+	fake.listRoutesArgsForCall = append(fake.listRoutesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ListRoutesStub
+	fakeReturns := fake.listRoutesReturns
+	fake.recordInvocation("ListRoutes", []interface{}{arg1, arg2})
+	fake.listRoutesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) ListRoutesCallCount() int {
+	fake.listRoutesMutex.RLock()
+	defer fake.listRoutesMutex.RUnlock()
+	return len(fake.listRoutesArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) ListRoutesCalls(stub func(context.Context, string) ([]playwright.RouteRule, error)) {
+	fake.listRoutesMutex.Lock()
+	defer fake.listRoutesMutex.Unlock()
+	fake.ListRoutesStub = stub
+}
+
+func (fake *FakeBrowserAutomation) ListRoutesArgsForCall(i int) (context.Context, string) {
+	fake.listRoutesMutex.RLock()
+	defer fake.listRoutesMutex.RUnlock()
+	argsForCall := fake.listRoutesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBrowserAutomation) ListRoutesReturns(result1 []playwright.RouteRule, result2 error) {
+	fake.listRoutesMutex.Lock()
+	defer fake.listRoutesMutex.Unlock()
+	fake.ListRoutesStub = nil
+	fake.listRoutesReturns = struct {
+		result1 []playwright.RouteRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) ListRoutesReturnsOnCall(i int, result1 []playwright.RouteRule, result2 error) {
+	fake.listRoutesMutex.Lock()
+	defer fake.listRoutesMutex.Unlock()
+	fake.ListRoutesStub = nil
+	if fake.listRoutesReturnsOnCall == nil {
+		fake.listRoutesReturnsOnCall = make(map[int]struct {
+			result1 []playwright.RouteRule
+			result2 error
+		})
+	}
+	fake.listRoutesReturnsOnCall[i] = struct {
+		result1 []playwright.RouteRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) ListTabs(arg1 context.Context, arg2 string) ([]playwright.Tab, error) {
+	fake.listTabsMutex.Lock()
+	ret, specificReturn := fake.listTabsReturnsOnCall[len(fake.listTabsArgsForCall)]
+	fake.listTabsArgsForCall = append(fake.listTabsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ListTabsStub
+	fakeReturns := fake.listTabsReturns
+	fake.recordInvocation("ListTabs", []interface{}{arg1, arg2})
+	fake.listTabsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) ListTabsCallCount() int {
+	fake.listTabsMutex.RLock()
+	defer fake.listTabsMutex.RUnlock()
+	return len(fake.listTabsArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) ListTabsCalls(stub func(context.Context, string) ([]playwright.Tab, error)) {
+	fake.listTabsMutex.Lock()
+	defer fake.listTabsMutex.Unlock()
+	fake.ListTabsStub = stub
+}
+
+func (fake *FakeBrowserAutomation) ListTabsArgsForCall(i int) (context.Context, string) {
+	fake.listTabsMutex.RLock()
+	defer fake.listTabsMutex.RUnlock()
+	argsForCall := fake.listTabsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBrowserAutomation) ListTabsReturns(result1 []playwright.Tab, result2 error) {
+	fake.listTabsMutex.Lock()
+	defer fake.listTabsMutex.Unlock()
+	fake.ListTabsStub = nil
+	fake.listTabsReturns = struct {
+		result1 []playwright.Tab
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) ListTabsReturnsOnCall(i int, result1 []playwright.Tab, result2 error) {
+	fake.listTabsMutex.Lock()
+	defer fake.listTabsMutex.Unlock()
+	fake.ListTabsStub = nil
+	if fake.listTabsReturnsOnCall == nil {
+		fake.listTabsReturnsOnCall = make(map[int]struct {
+			result1 []playwright.Tab
+			result2 error
+		})
+	}
+	fake.listTabsReturnsOnCall[i] = struct {
+		result1 []playwright.Tab
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) NavigateHistory(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 time.Duration) (*playwright.NavigationResult, error) {
+	fake.navigateHistoryMutex.Lock()
+	ret, specificReturn := fake.navigateHistoryReturnsOnCall[len(fake.navigateHistoryArgsForCall)]
+	fake.navigateHistoryArgsForCall = append(fake.navigateHistoryArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 time.Duration
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.NavigateHistoryStub
+	fakeReturns := fake.navigateHistoryReturns
+	fake.recordInvocation("NavigateHistory", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.navigateHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) NavigateHistoryCallCount() int {
+	fake.navigateHistoryMutex.RLock()
+	defer fake.navigateHistoryMutex.RUnlock()
+	return len(fake.navigateHistoryArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) NavigateHistoryCalls(stub func(context.Context, string, string, string, time.Duration) (*playwright.NavigationResult, error)) {
+	fake.navigateHistoryMutex.Lock()
+	defer fake.navigateHistoryMutex.Unlock()
+	fake.NavigateHistoryStub = stub
+}
+
+func (fake *FakeBrowserAutomation) NavigateHistoryArgsForCall(i int) (context.Context, string, string, string, time.Duration) {
+	fake.navigateHistoryMutex.RLock()
+	defer fake.navigateHistoryMutex.RUnlock()
+	argsForCall := fake.navigateHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeBrowserAutomation) NavigateHistoryReturns(result1 *playwright.NavigationResult, result2 error) {
+	fake.navigateHistoryMutex.Lock()
+	defer fake.navigateHistoryMutex.Unlock()
+	fake.NavigateHistoryStub = nil
+	fake.navigateHistoryReturns = struct {
+		result1 *playwright.NavigationResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) NavigateHistoryReturnsOnCall(i int, result1 *playwright.NavigationResult, result2 error) {
+	fake.navigateHistoryMutex.Lock()
+	defer fake.navigateHistoryMutex.Unlock()
+	fake.NavigateHistoryStub = nil
+	if fake.navigateHistoryReturnsOnCall == nil {
+		fake.navigateHistoryReturnsOnCall = make(map[int]struct {
+			result1 *playwright.NavigationResult
+			result2 error
+		})
+	}
+	fake.navigateHistoryReturnsOnCall[i] = struct {
+		result1 *playwright.NavigationResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) NavigateToURL(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 time.Duration) (*playwright.NavigationResult, error) {
+	fake.navigateToURLMutex.Lock()
+	ret, specificReturn := fake.navigateToURLReturnsOnCall[len(fake.navigateToURLArgsForCall)]
+	fake.navigateToURLArgsForCall = append(fake.navigateToURLArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 time.Duration
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.NavigateToURLStub
+	fakeReturns := fake.navigateToURLReturns
+	fake.recordInvocation("NavigateToURL", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.navigateToURLMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) NavigateToURLCallCount() int {
+	fake.navigateToURLMutex.RLock()
+	defer fake.navigateToURLMutex.RUnlock()
+	return len(fake.navigateToURLArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) NavigateToURLCalls(stub func(context.Context, string, string, string, time.Duration) (*playwright.NavigationResult, error)) {
+	fake.navigateToURLMutex.Lock()
+	defer fake.navigateToURLMutex.Unlock()
+	fake.NavigateToURLStub = stub
+}
+
+func (fake *FakeBrowserAutomation) NavigateToURLArgsForCall(i int) (context.Context, string, string, string, time.Duration) {
+	fake.navigateToURLMutex.RLock()
+	defer fake.navigateToURLMutex.RUnlock()
+	argsForCall := fake.navigateToURLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeBrowserAutomation) NavigateToURLReturns(result1 *playwright.NavigationResult, result2 error) {
+	fake.navigateToURLMutex.Lock()
+	defer fake.navigateToURLMutex.Unlock()
+	fake.NavigateToURLStub = nil
+	fake.navigateToURLReturns = struct {
+		result1 *playwright.NavigationResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) NavigateToURLReturnsOnCall(i int, result1 *playwright.NavigationResult, result2 error) {
+	fake.navigateToURLMutex.Lock()
+	defer fake.navigateToURLMutex.Unlock()
+	fake.NavigateToURLStub = nil
+	if fake.navigateToURLReturnsOnCall == nil {
+		fake.navigateToURLReturnsOnCall = make(map[int]struct {
+			result1 *playwright.NavigationResult
+			result2 error
+		})
+	}
+	fake.navigateToURLReturnsOnCall[i] = struct {
+		result1 *playwright.NavigationResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) OpenTab(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 time.Duration) (*playwright.Tab, error) {
+	fake.openTabMutex.Lock()
+	ret, specificReturn := fake.openTabReturnsOnCall[len(fake.openTabArgsForCall)]
+	fake.openTabArgsForCall = append(fake.openTabArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 time.Duration
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.OpenTabStub
+	fakeReturns := fake.openTabReturns
+	fake.recordInvocation("OpenTab", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.openTabMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) OpenTabCallCount() int {
+	fake.openTabMutex.RLock()
+	defer fake.openTabMutex.RUnlock()
+	return len(fake.openTabArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) OpenTabCalls(stub func(context.Context, string, string, string, time.Duration) (*playwright.Tab, error)) {
+	fake.openTabMutex.Lock()
+	defer fake.openTabMutex.Unlock()
+	fake.OpenTabStub = stub
+}
+
+func (fake *FakeBrowserAutomation) OpenTabArgsForCall(i int) (context.Context, string, string, string, time.Duration) {
+	fake.openTabMutex.RLock()
+	defer fake.openTabMutex.RUnlock()
+	argsForCall := fake.openTabArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeBrowserAutomation) OpenTabReturns(result1 *playwright.Tab, result2 error) {
+	fake.openTabMutex.Lock()
+	defer fake.openTabMutex.Unlock()
+	fake.OpenTabStub = nil
+	fake.openTabReturns = struct {
+		result1 *playwright.Tab
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) OpenTabReturnsOnCall(i int, result1 *playwright.Tab, result2 error) {
+	fake.openTabMutex.Lock()
+	defer fake.openTabMutex.Unlock()
+	fake.OpenTabStub = nil
+	if fake.openTabReturnsOnCall == nil {
+		fake.openTabReturnsOnCall = make(map[int]struct {
+			result1 *playwright.Tab
+			result2 error
+		})
+	}
+	fake.openTabReturnsOnCall[i] = struct {
+		result1 *playwright.Tab
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) PressKeys(arg1 context.Context, arg2 string, arg3 []string, arg4 playwright.KeyboardOptions) error {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.pressKeysMutex.Lock()
+	ret, specificReturn := fake.pressKeysReturnsOnCall[len(fake.pressKeysArgsForCall)]
+	fake.pressKeysArgsForCall = append(fake.pressKeysArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []string
+		arg4 playwright.KeyboardOptions
+	}{arg1, arg2, arg3Copy, arg4})
+	stub := fake.PressKeysStub
+	fakeReturns := fake.pressKeysReturns
+	fake.recordInvocation("PressKeys", []interface{}{arg1, arg2, arg3Copy, arg4})
+	fake.pressKeysMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBrowserAutomation) PressKeysCallCount() int {
+	fake.pressKeysMutex.RLock()
+	defer fake.pressKeysMutex.RUnlock()
+	return len(fake.pressKeysArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) PressKeysCalls(stub func(context.Context, string, []string, playwright.KeyboardOptions) error) {
+	fake.pressKeysMutex.Lock()
+	defer fake.pressKeysMutex.Unlock()
+	fake.PressKeysStub = stub
+}
+
+func (fake *FakeBrowserAutomation) PressKeysArgsForCall(i int) (context.Context, string, []string, playwright.KeyboardOptions) {
+	fake.pressKeysMutex.RLock()
+	defer fake.pressKeysMutex.RUnlock()
+	argsForCall := fake.pressKeysArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeBrowserAutomation) PressKeysReturns(result1 error) {
+	fake.pressKeysMutex.Lock()
+	defer fake.pressKeysMutex.Unlock()
+	fake.PressKeysStub = nil
+	fake.pressKeysReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserAutomation) PressKeysReturnsOnCall(i int, result1 error) {
+	fake.pressKeysMutex.Lock()
+	defer fake.pressKeysMutex.Unlock()
+	fake.PressKeysStub = nil
+	if fake.pressKeysReturnsOnCall == nil {
+		fake.pressKeysReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pressKeysReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserAutomation) RemoveRoutes(arg1 context.Context, arg2 string, arg3 []string) ([]playwright.RouteRule, error) {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.removeRoutesMutex.Lock()
+	ret, specificReturn := fake.removeRoutesReturnsOnCall[len(fake.removeRoutesArgsForCall)]
+	fake.removeRoutesArgsForCall = append(fake.removeRoutesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	stub := fake.RemoveRoutesStub
+	fakeReturns := fake.removeRoutesReturns
+	fake.recordInvocation("RemoveRoutes", []interface{}{arg1, arg2, arg3Copy})
+	fake.removeRoutesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) RemoveRoutesCallCount() int {
+	fake.removeRoutesMutex.RLock()
+	defer fake.removeRoutesMutex.RUnlock()
+	return len(fake.removeRoutesArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) RemoveRoutesCalls(stub func(context.Context, string, []string) ([]playwright.RouteRule, error)) {
+	fake.removeRoutesMutex.Lock()
+	defer fake.removeRoutesMutex.Unlock()
+	fake.RemoveRoutesStub = stub
+}
+
+func (fake *FakeBrowserAutomation) RemoveRoutesArgsForCall(i int) (context.Context, string, []string) {
+	fake.removeRoutesMutex.RLock()
+	defer fake.removeRoutesMutex.RUnlock()
+	argsForCall := fake.removeRoutesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrowserAutomation) RemoveRoutesReturns(result1 []playwright.RouteRule, result2 error) {
+	fake.removeRoutesMutex.Lock()
+	defer fake.removeRoutesMutex.Unlock()
+	fake.RemoveRoutesStub = nil
+	fake.removeRoutesReturns = struct {
+		result1 []playwright.RouteRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) RemoveRoutesReturnsOnCall(i int, result1 []playwright.RouteRule, result2 error) {
+	fake.removeRoutesMutex.Lock()
+	defer fake.removeRoutesMutex.Unlock()
+	fake.RemoveRoutesStub = nil
+	if fake.removeRoutesReturnsOnCall == nil {
+		fake.removeRoutesReturnsOnCall = make(map[int]struct {
+			result1 []playwright.RouteRule
+			result2 error
+		})
+	}
+	fake.removeRoutesReturnsOnCall[i] = struct {
+		result1 []playwright.RouteRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) SaveProfile(arg1 context.Context, arg2 string, arg3 string) (*playwright.Profile, error) {
+	fake.saveProfileMutex.Lock()
+	ret, specificReturn := fake.saveProfileReturnsOnCall[len(fake.saveProfileArgsForCall)]
+	fake.saveProfileArgsForCall = append(fake.saveProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SaveProfileStub
+	fakeReturns := fake.saveProfileReturns
+	fake.recordInvocation("SaveProfile", []interface{}{arg1, arg2, arg3})
+	fake.saveProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) SaveProfileCallCount() int {
+	fake.saveProfileMutex.RLock()
+	defer fake.saveProfileMutex.RUnlock()
+	return len(fake.saveProfileArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) SaveProfileCalls(stub func(context.Context, string, string) (*playwright.Profile, error)) {
+	fake.saveProfileMutex.Lock()
+	defer fake.saveProfileMutex.Unlock()
+	fake.SaveProfileStub = stub
+}
+
+func (fake *FakeBrowserAutomation) SaveProfileArgsForCall(i int) (context.Context, string, string) {
+	fake.saveProfileMutex.RLock()
+	defer fake.saveProfileMutex.RUnlock()
+	argsForCall := fake.saveProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrowserAutomation) SaveProfileReturns(result1 *playwright.Profile, result2 error) {
+	fake.saveProfileMutex.Lock()
+	defer fake.saveProfileMutex.Unlock()
+	fake.SaveProfileStub = nil
+	fake.saveProfileReturns = struct {
+		result1 *playwright.Profile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) SaveProfileReturnsOnCall(i int, result1 *playwright.Profile, result2 error) {
+	fake.saveProfileMutex.Lock()
+	defer fake.saveProfileMutex.Unlock()
+	fake.SaveProfileStub = nil
+	if fake.saveProfileReturnsOnCall == nil {
+		fake.saveProfileReturnsOnCall = make(map[int]struct {
+			result1 *playwright.Profile
+			result2 error
+		})
+	}
+	fake.saveProfileReturnsOnCall[i] = struct {
+		result1 *playwright.Profile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) SaveStorageState(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.saveStorageStateMutex.Lock()
+	ret, specificReturn := fake.saveStorageStateReturnsOnCall[len(fake.saveStorageStateArgsForCall)]
+	fake.saveStorageStateArgsForCall = append(fake.saveStorageStateArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SaveStorageStateStub
+	fakeReturns := fake.saveStorageStateReturns
+	fake.recordInvocation("SaveStorageState", []interface{}{arg1, arg2, arg3})
+	fake.saveStorageStateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBrowserAutomation) SaveStorageStateCallCount() int {
+	fake.saveStorageStateMutex.RLock()
+	defer fake.saveStorageStateMutex.RUnlock()
+	return len(fake.saveStorageStateArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) SaveStorageStateCalls(stub func(context.Context, string, string) error) {
+	fake.saveStorageStateMutex.Lock()
+	defer fake.saveStorageStateMutex.Unlock()
+	fake.SaveStorageStateStub = stub
+}
+
+func (fake *FakeBrowserAutomation) SaveStorageStateArgsForCall(i int) (context.Context, string, string) {
+	fake.saveStorageStateMutex.RLock()
+	defer fake.saveStorageStateMutex.RUnlock()
+	argsForCall := fake.saveStorageStateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrowserAutomation) SaveStorageStateReturns(result1 error) {
+	fake.saveStorageStateMutex.Lock()
+	defer fake.saveStorageStateMutex.Unlock()
+	fake.SaveStorageStateStub = nil
+	fake.saveStorageStateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserAutomation) SaveStorageStateReturnsOnCall(i int, result1 error) {
+	fake.saveStorageStateMutex.Lock()
+	defer fake.saveStorageStateMutex.Unlock()
+	fake.SaveStorageStateStub = nil
+	if fake.saveStorageStateReturnsOnCall == nil {
+		fake.saveStorageStateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveStorageStateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserAutomation) Scroll(arg1 context.Context, arg2 string, arg3 playwright.ScrollOptions) (*playwright.ScrollPosition, error) {
+	fake.scrollMutex.Lock()
+	ret, specificReturn := fake.scrollReturnsOnCall[len(fake.scrollArgsForCall)]
+	fake.scrollArgsForCall = append(fake.scrollArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.ScrollOptions
+	}{arg1, arg2, arg3})
+	stub := fake.ScrollStub
+	fakeReturns := fake.scrollReturns
+	fake.recordInvocation("Scroll", []interface{}{arg1, arg2, arg3})
+	fake.scrollMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) ScrollCallCount() int {
+	fake.scrollMutex.RLock()
+	defer fake.scrollMutex.RUnlock()
+	return len(fake.scrollArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) ScrollCalls(stub func(context.Context, string, playwright.ScrollOptions) (*playwright.ScrollPosition, error)) {
+	fake.scrollMutex.Lock()
+	defer fake.scrollMutex.Unlock()
+	fake.ScrollStub = stub
+}
+
+func (fake *FakeBrowserAutomation) ScrollArgsForCall(i int) (context.Context, string, playwright.ScrollOptions) {
+	fake.scrollMutex.RLock()
+	defer fake.scrollMutex.RUnlock()
+	argsForCall := fake.scrollArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrowserAutomation) ScrollReturns(result1 *playwright.ScrollPosition, result2 error) {
+	fake.scrollMutex.Lock()
+	defer fake.scrollMutex.Unlock()
+	fake.ScrollStub = nil
+	fake.scrollReturns = struct {
+		result1 *playwright.ScrollPosition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) ScrollReturnsOnCall(i int, result1 *playwright.ScrollPosition, result2 error) {
+	fake.scrollMutex.Lock()
+	defer fake.scrollMutex.Unlock()
+	fake.ScrollStub = nil
+	if fake.scrollReturnsOnCall == nil {
+		fake.scrollReturnsOnCall = make(map[int]struct {
+			result1 *playwright.ScrollPosition
+			result2 error
+		})
+	}
+	fake.scrollReturnsOnCall[i] = struct {
+		result1 *playwright.ScrollPosition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) ScrollIntoView(arg1 context.Context, arg2 string, arg3 string, arg4 playwright.MouseOptions) error {
+	fake.scrollIntoViewMutex.Lock()
+	ret, specificReturn := fake.scrollIntoViewReturnsOnCall[len(fake.scrollIntoViewArgsForCall)]
+	fake.scrollIntoViewArgsForCall = append(fake.scrollIntoViewArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 playwright.MouseOptions
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ScrollIntoViewStub
+	fakeReturns := fake.scrollIntoViewReturns
+	fake.recordInvocation("ScrollIntoView", []interface{}{arg1, arg2, arg3, arg4})
+	fake.scrollIntoViewMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBrowserAutomation) ScrollIntoViewCallCount() int {
+	fake.scrollIntoViewMutex.RLock()
+	defer fake.scrollIntoViewMutex.RUnlock()
+	return len(fake.scrollIntoViewArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) ScrollIntoViewCalls(stub func(context.Context, string, string, playwright.MouseOptions) error) {
+	fake.scrollIntoViewMutex.Lock()
+	defer fake.scrollIntoViewMutex.Unlock()
+	fake.ScrollIntoViewStub = stub
+}
+
+func (fake *FakeBrowserAutomation) ScrollIntoViewArgsForCall(i int) (context.Context, string, string, playwright.MouseOptions) {
+	fake.scrollIntoViewMutex.RLock()
+	defer fake.scrollIntoViewMutex.RUnlock()
+	argsForCall := fake.scrollIntoViewArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeBrowserAutomation) ScrollIntoViewReturns(result1 error) {
+	fake.scrollIntoViewMutex.Lock()
+	defer fake.scrollIntoViewMutex.Unlock()
+	fake.ScrollIntoViewStub = nil
+	fake.scrollIntoViewReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserAutomation) ScrollIntoViewReturnsOnCall(i int, result1 error) {
+	fake.scrollIntoViewMutex.Lock()
+	defer fake.scrollIntoViewMutex.Unlock()
+	fake.ScrollIntoViewStub = nil
+	if fake.scrollIntoViewReturnsOnCall == nil {
+		fake.scrollIntoViewReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.getSessionReturnsOnCall[i] = struct {
-		result1 *playwright.BrowserSession
-		result2 error
-	}{result1, result2}
+	fake.scrollIntoViewReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeBrowserAutomation) HandleAuthentication(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 string, arg6 string, arg7 map[string]string) error {
-	fake.handleAuthenticationMutex.Lock()
-	ret, specificReturn := fake.handleAuthenticationReturnsOnCall[len(fake.handleAuthenticationArgsForCall)]
-	fake.handleAuthenticationArgsForCall = append(fake.handleAuthenticationArgsForCall, struct {
+func (fake *FakeBrowserAutomation) SetCookies(arg1 context.Context, arg2 string, arg3 []playwright.Cookie) error {
+	var arg3Copy []playwright.Cookie
+	if arg3 != nil {
+		arg3Copy = make([]playwright.Cookie, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.setCookiesMutex.Lock()
+	ret, specificReturn := fake.setCookiesReturnsOnCall[len(fake.setCookiesArgsForCall)]
+	fake.setCookiesArgsForCall = append(fake.setCookiesArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-		arg3 string
-		arg4 string
-		arg5 string
-		arg6 string
-		arg7 map[string]string
-	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
-	stub := fake.HandleAuthenticationStub
-	fakeReturns := fake.handleAuthenticationReturns
-	fake.recordInvocation("HandleAuthentication", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
-	fake.handleAuthenticationMutex.Unlock()
+		arg3 []playwright.Cookie
+	}{arg1, arg2, arg3Copy})
+	stub := fake.SetCookiesStub
+	fakeReturns := fake.setCookiesReturns
+	fake.recordInvocation("SetCookies", []interface{}{arg1, arg2, arg3Copy})
+	fake.setCookiesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -982,61 +3289,62 @@ func (fake *FakeBrowserAutomation) HandleAuthentication(arg1 context.Context, ar
 	return fakeReturns.result1
 }
 
-func (fake *FakeBrowserAutomation) HandleAuthenticationCallCount() int {
-	fake.handleAuthenticationMutex.RLock()
-	defer fake.handleAuthenticationMutex.RUnlock()
-	return len(fake.handleAuthenticationArgsForCall)
+func (fake *FakeBrowserAutomation) SetCookiesCallCount() int {
+	fake.setCookiesMutex.RLock()
+	defer fake.setCookiesMutex.RUnlock()
+	return len(fake.setCookiesArgsForCall)
 }
 
-func (fake *FakeBrowserAutomation) HandleAuthenticationCalls(stub func(context.Context, string, string, string, string, string, map[string]string) error) {
-	fake.handleAuthenticationMutex.Lock()
-	defer fake.handleAuthenticationMutex.Unlock()
-	fake.HandleAuthenticationStub = stub
+func (fake *FakeBrowserAutomation) SetCookiesCalls(stub func(context.Context, string, []playwright.Cookie) error) {
+	fake.setCookiesMutex.Lock()
+	defer fake.setCookiesMutex.Unlock()
+	fake.SetCookiesStub = stub
 }
 
-func (fake *FakeBrowserAutomation) HandleAuthenticationArgsForCall(i int) (context.Context, string, string, string, string, string, map[string]string) {
-	fake.handleAuthenticationMutex.RLock()
-	defer fake.handleAuthenticationMutex.RUnlock()
-	argsForCall := fake.handleAuthenticationArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+func (fake *FakeBrowserAutomation) SetCookiesArgsForCall(i int) (context.Context, string, []playwright.Cookie) {
+	fake.setCookiesMutex.RLock()
+	defer fake.setCookiesMutex.RUnlock()
+	argsForCall := fake.setCookiesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeBrowserAutomation) HandleAuthenticationReturns(result1 error) {
-	fake.handleAuthenticationMutex.Lock()
-	defer fake.handleAuthenticationMutex.Unlock()
-	fake.HandleAuthenticationStub = nil
-	fake.handleAuthenticationReturns = struct {
+func (fake *FakeBrowserAutomation) SetCookiesReturns(result1 error) {
+	fake.setCookiesMutex.Lock()
+	defer fake.setCookiesMutex.Unlock()
+	fake.SetCookiesStub = nil
+	fake.setCookiesReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeBrowserAutomation) HandleAuthenticationReturnsOnCall(i int, result1 error) {
-	fake.handleAuthenticationMutex.Lock()
-	defer fake.handleAuthenticationMutex.Unlock()
-	fake.HandleAuthenticationStub = nil
-	if fake.handleAuthenticationReturnsOnCall == nil {
-		fake.handleAuthenticationReturnsOnCall = make(map[int]struct {
+func (fake *FakeBrowserAutomation) SetCookiesReturnsOnCall(i int, result1 error) {
+	fake.setCookiesMutex.Lock()
+	defer fake.setCookiesMutex.Unlock()
+	fake.SetCookiesStub = nil
+	if fake.setCookiesReturnsOnCall == nil {
+		fake.setCookiesReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.handleAuthenticationReturnsOnCall[i] = struct {
+	fake.setCookiesReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeBrowserAutomation) LaunchBrowser(arg1 context.Context, arg2 *playwright.BrowserConfig) (*playwright.BrowserSession, error) {
-	fake.launchBrowserMutex.Lock()
-	ret, specificReturn := fake.launchBrowserReturnsOnCall[len(fake.launchBrowserArgsForCall)]
-	fake.launchBrowserArgsForCall = append(fake.launchBrowserArgsForCall, struct {
+func (fake *FakeBrowserAutomation) SetProxy(arg1 context.Context, arg2 string, arg3 playwright.ProxyChange) (*proxy.Settings, error) {
+	fake.setProxyMutex.Lock()
+	ret, specificReturn := fake.setProxyReturnsOnCall[len(fake.setProxyArgsForCall)]
+	fake.setProxyArgsForCall = append(fake.setProxyArgsForCall, struct {
 		arg1 context.Context
-		arg2 *playwright.BrowserConfig
-	}{arg1, arg2})
-	stub := fake.LaunchBrowserStub
-	fakeReturns := fake.launchBrowserReturns
-	fake.recordInvocation("LaunchBrowser", []interface{}{arg1, arg2})
-	fake.launchBrowserMutex.Unlock()
+		arg2 string
+		arg3 playwright.ProxyChange
+	}{arg1, arg2, arg3})
+	stub := fake.SetProxyStub
+	fakeReturns := fake.setProxyReturns
+	fake.recordInvocation("SetProxy", []interface{}{arg1, arg2, arg3})
+	fake.setProxyMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1044,114 +3352,115 @@ func (fake *FakeBrowserAutomation) LaunchBrowser(arg1 context.Context, arg2 *pla
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeBrowserAutomation) LaunchBrowserCallCount() int {
-	fake.launchBrowserMutex.RLock()
-	defer fake.launchBrowserMutex.RUnlock()
-	return len(fake.launchBrowserArgsForCall)
+func (fake *FakeBrowserAutomation) SetProxyCallCount() int {
+	fake.setProxyMutex.RLock()
+	defer fake.setProxyMutex.RUnlock()
+	return len(fake.setProxyArgsForCall)
 }
 
-func (fake *FakeBrowserAutomation) LaunchBrowserCalls(stub func(context.Context, *playwright.BrowserConfig) (*playwright.BrowserSession, error)) {
-	fake.launchBrowserMutex.Lock()
-	defer fake.launchBrowserMutex.Unlock()
-	fake.LaunchBrowserStub = stub
+func (fake *FakeBrowserAutomation) SetProxyCalls(stub func(context.Context, string, playwright.ProxyChange) (*proxy.Settings, error)) {
+	fake.setProxyMutex.Lock()
+	defer fake.setProxyMutex.Unlock()
+	fake.SetProxyStub = stub
 }
 
-func (fake *FakeBrowserAutomation) LaunchBrowserArgsForCall(i int) (context.Context, *playwright.BrowserConfig) {
-	fake.launchBrowserMutex.RLock()
-	defer fake.launchBrowserMutex.RUnlock()
-	argsForCall := fake.launchBrowserArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeBrowserAutomation) SetProxyArgsForCall(i int) (context.Context, string, playwright.ProxyChange) {
+	fake.setProxyMutex.RLock()
+	defer fake.setProxyMutex.RUnlock()
+	argsForCall := fake.setProxyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeBrowserAutomation) LaunchBrowserReturns(result1 *playwright.BrowserSession, result2 error) {
-	fake.launchBrowserMutex.Lock()
-	defer fake.launchBrowserMutex.Unlock()
-	fake.LaunchBrowserStub = nil
-	fake.launchBrowserReturns = struct {
-		result1 *playwright.BrowserSession
+func (fake *FakeBrowserAutomation) SetProxyReturns(result1 *proxy.Settings, result2 error) {
+	fake.setProxyMutex.Lock()
+	defer fake.setProxyMutex.Unlock()
+	fake.SetProxyStub = nil
+	fake.setProxyReturns = struct {
+		result1 *proxy.Settings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeBrowserAutomation) LaunchBrowserReturnsOnCall(i int, result1 *playwright.BrowserSession, result2 error) {
-	fake.launchBrowserMutex.Lock()
-	defer fake.launchBrowserMutex.Unlock()
-	fake.LaunchBrowserStub = nil
-	if fake.launchBrowserReturnsOnCall == nil {
-		fake.launchBrowserReturnsOnCall = make(map[int]struct {
-			result1 *playwright.BrowserSession
+func (fake *FakeBrowserAutomation) SetProxyReturnsOnCall(i int, result1 *proxy.Settings, result2 error) {
+	fake.setProxyMutex.Lock()
+	defer fake.setProxyMutex.Unlock()
+	fake.SetProxyStub = nil
+	if fake.setProxyReturnsOnCall == nil {
+		fake.setProxyReturnsOnCall = make(map[int]struct {
+			result1 *proxy.Settings
 			result2 error
 		})
 	}
-	fake.launchBrowserReturnsOnCall[i] = struct {
-		result1 *playwright.BrowserSession
+	fake.setProxyReturnsOnCall[i] = struct {
+		result1 *proxy.Settings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeBrowserAutomation) NavigateToURL(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 time.Duration) error {
-	fake.navigateToURLMutex.Lock()
-	ret, specificReturn := fake.navigateToURLReturnsOnCall[len(fake.navigateToURLArgsForCall)]
-	fake.navigateToURLArgsForCall = append(fake.navigateToURLArgsForCall, struct {
+func (fake *FakeBrowserAutomation) SetStorage(arg1 context.Context, arg2 string, arg3 playwright.StorageUpdate) (*playwright.WebStorage, error) {
+	fake.setStorageMutex.Lock()
+	ret, specificReturn := fake.setStorageReturnsOnCall[len(fake.setStorageArgsForCall)]
+	fake.setStorageArgsForCall = append(fake.setStorageArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-		arg3 string
-		arg4 string
-		arg5 time.Duration
-	}{arg1, arg2, arg3, arg4, arg5})
-	stub := fake.NavigateToURLStub
-	fakeReturns := fake.navigateToURLReturns
-	fake.recordInvocation("NavigateToURL", []interface{}{arg1, arg2, arg3, arg4, arg5})
-	fake.navigateToURLMutex.Unlock()
+		arg3 playwright.StorageUpdate
+	}{arg1, arg2, arg3})
+	stub := fake.SetStorageStub
+	fakeReturns := fake.setStorageReturns
+	fake.recordInvocation("SetStorage", []interface{}{arg1, arg2, arg3})
+	fake.setStorageMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeBrowserAutomation) NavigateToURLCallCount() int {
-	fake.navigateToURLMutex.RLock()
-	defer fake.navigateToURLMutex.RUnlock()
-	return len(fake.navigateToURLArgsForCall)
+func (fake *FakeBrowserAutomation) SetStorageCallCount() int {
+	fake.setStorageMutex.RLock()
+	defer fake.setStorageMutex.RUnlock()
+	return len(fake.setStorageArgsForCall)
 }
 
-func (fake *FakeBrowserAutomation) NavigateToURLCalls(stub func(context.Context, string, string, string, time.Duration) error) {
-	fake.navigateToURLMutex.Lock()
-	defer fake.navigateToURLMutex.Unlock()
-	fake.NavigateToURLStub = stub
+func (fake *FakeBrowserAutomation) SetStorageCalls(stub func(context.Context, string, playwright.StorageUpdate) (*playwright.WebStorage, error)) {
+	fake.setStorageMutex.Lock()
+	defer fake.setStorageMutex.Unlock()
+	fake.SetStorageStub = stub
 }
 
-func (fake *FakeBrowserAutomation) NavigateToURLArgsForCall(i int) (context.Context, string, string, string, time.Duration) {
-	fake.navigateToURLMutex.RLock()
-	defer fake.navigateToURLMutex.RUnlock()
-	argsForCall := fake.navigateToURLArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+func (fake *FakeBrowserAutomation) SetStorageArgsForCall(i int) (context.Context, string, playwright.StorageUpdate) {
+	fake.setStorageMutex.RLock()
+	defer fake.setStorageMutex.RUnlock()
+	argsForCall := fake.setStorageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeBrowserAutomation) NavigateToURLReturns(result1 error) {
-	fake.navigateToURLMutex.Lock()
-	defer fake.navigateToURLMutex.Unlock()
-	fake.NavigateToURLStub = nil
-	fake.navigateToURLReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakeBrowserAutomation) SetStorageReturns(result1 *playwright.WebStorage, result2 error) {
+	fake.setStorageMutex.Lock()
+	defer fake.setStorageMutex.Unlock()
+	fake.SetStorageStub = nil
+	fake.setStorageReturns = struct {
+		result1 *playwright.WebStorage
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeBrowserAutomation) NavigateToURLReturnsOnCall(i int, result1 error) {
-	fake.navigateToURLMutex.Lock()
-	defer fake.navigateToURLMutex.Unlock()
-	fake.NavigateToURLStub = nil
-	if fake.navigateToURLReturnsOnCall == nil {
-		fake.navigateToURLReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeBrowserAutomation) SetStorageReturnsOnCall(i int, result1 *playwright.WebStorage, result2 error) {
+	fake.setStorageMutex.Lock()
+	defer fake.setStorageMutex.Unlock()
+	fake.SetStorageStub = nil
+	if fake.setStorageReturnsOnCall == nil {
+		fake.setStorageReturnsOnCall = make(map[int]struct {
+			result1 *playwright.WebStorage
+			result2 error
 		})
 	}
-	fake.navigateToURLReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.setStorageReturnsOnCall[i] = struct {
+		result1 *playwright.WebStorage
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeBrowserAutomation) Shutdown(arg1 context.Context) error {
@@ -1215,6 +3524,72 @@ func (fake *FakeBrowserAutomation) ShutdownReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBrowserAutomation) SwitchTab(arg1 context.Context, arg2 string, arg3 string) (*playwright.Tab, error) {
+	fake.switchTabMutex.Lock()
+	ret, specificReturn := fake.switchTabReturnsOnCall[len(fake.switchTabArgsForCall)]
+	fake.switchTabArgsForCall = append(fake.switchTabArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SwitchTabStub
+	fakeReturns := fake.switchTabReturns
+	fake.recordInvocation("SwitchTab", []interface{}{arg1, arg2, arg3})
+	fake.switchTabMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) SwitchTabCallCount() int {
+	fake.switchTabMutex.RLock()
+	defer fake.switchTabMutex.RUnlock()
+	return len(fake.switchTabArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) SwitchTabCalls(stub func(context.Context, string, string) (*playwright.Tab, error)) {
+	fake.switchTabMutex.Lock()
+	defer fake.switchTabMutex.Unlock()
+	fake.SwitchTabStub = stub
+}
+
+func (fake *FakeBrowserAutomation) SwitchTabArgsForCall(i int) (context.Context, string, string) {
+	fake.switchTabMutex.RLock()
+	defer fake.switchTabMutex.RUnlock()
+	argsForCall := fake.switchTabArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrowserAutomation) SwitchTabReturns(result1 *playwright.Tab, result2 error) {
+	fake.switchTabMutex.Lock()
+	defer fake.switchTabMutex.Unlock()
+	fake.SwitchTabStub = nil
+	fake.switchTabReturns = struct {
+		result1 *playwright.Tab
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) SwitchTabReturnsOnCall(i int, result1 *playwright.Tab, result2 error) {
+	fake.switchTabMutex.Lock()
+	defer fake.switchTabMutex.Unlock()
+	fake.SwitchTabStub = nil
+	if fake.switchTabReturnsOnCall == nil {
+		fake.switchTabReturnsOnCall = make(map[int]struct {
+			result1 *playwright.Tab
+			result2 error
+		})
+	}
+	fake.switchTabReturnsOnCall[i] = struct {
+		result1 *playwright.Tab
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBrowserAutomation) TakeScreenshot(arg1 context.Context, arg2 string, arg3 string, arg4 bool, arg5 string, arg6 string, arg7 int) error {
 	fake.takeScreenshotMutex.Lock()
 	ret, specificReturn := fake.takeScreenshotReturnsOnCall[len(fake.takeScreenshotArgsForCall)]
@@ -1282,29 +3657,90 @@ func (fake *FakeBrowserAutomation) TakeScreenshotReturnsOnCall(i int, result1 er
 	}{result1}
 }
 
-func (fake *FakeBrowserAutomation) WaitForCondition(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 string, arg6 time.Duration, arg7 string) error {
+func (fake *FakeBrowserAutomation) TypeText(arg1 context.Context, arg2 string, arg3 string, arg4 playwright.KeyboardOptions) error {
+	fake.typeTextMutex.Lock()
+	ret, specificReturn := fake.typeTextReturnsOnCall[len(fake.typeTextArgsForCall)]
+	fake.typeTextArgsForCall = append(fake.typeTextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 playwright.KeyboardOptions
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.TypeTextStub
+	fakeReturns := fake.typeTextReturns
+	fake.recordInvocation("TypeText", []interface{}{arg1, arg2, arg3, arg4})
+	fake.typeTextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBrowserAutomation) TypeTextCallCount() int {
+	fake.typeTextMutex.RLock()
+	defer fake.typeTextMutex.RUnlock()
+	return len(fake.typeTextArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) TypeTextCalls(stub func(context.Context, string, string, playwright.KeyboardOptions) error) {
+	fake.typeTextMutex.Lock()
+	defer fake.typeTextMutex.Unlock()
+	fake.TypeTextStub = stub
+}
+
+func (fake *FakeBrowserAutomation) TypeTextArgsForCall(i int) (context.Context, string, string, playwright.KeyboardOptions) {
+	fake.typeTextMutex.RLock()
+	defer fake.typeTextMutex.RUnlock()
+	argsForCall := fake.typeTextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeBrowserAutomation) TypeTextReturns(result1 error) {
+	fake.typeTextMutex.Lock()
+	defer fake.typeTextMutex.Unlock()
+	fake.TypeTextStub = nil
+	fake.typeTextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserAutomation) TypeTextReturnsOnCall(i int, result1 error) {
+	fake.typeTextMutex.Lock()
+	defer fake.typeTextMutex.Unlock()
+	fake.TypeTextStub = nil
+	if fake.typeTextReturnsOnCall == nil {
+		fake.typeTextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.typeTextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBrowserAutomation) WaitForCondition(arg1 context.Context, arg2 string, arg3 string, arg4 playwright.WaitOptions) (*playwright.WaitResult, error) {
 	fake.waitForConditionMutex.Lock()
 	ret, specificReturn := fake.waitForConditionReturnsOnCall[len(fake.waitForConditionArgsForCall)]
 	fake.waitForConditionArgsForCall = append(fake.waitForConditionArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 		arg3 string
-		arg4 string
-		arg5 string
-		arg6 time.Duration
-		arg7 string
-	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+		arg4 playwright.WaitOptions
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.WaitForConditionStub
 	fakeReturns := fake.waitForConditionReturns
-	fake.recordInvocation("WaitForCondition", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.recordInvocation("WaitForCondition", []interface{}{arg1, arg2, arg3, arg4})
 	fake.waitForConditionMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
 func (fake *FakeBrowserAutomation) WaitForConditionCallCount() int {
@@ -1313,51 +3749,136 @@ func (fake *FakeBrowserAutomation) WaitForConditionCallCount() int {
 	return len(fake.waitForConditionArgsForCall)
 }
 
-func (fake *FakeBrowserAutomation) WaitForConditionCalls(stub func(context.Context, string, string, string, string, time.Duration, string) error) {
+func (fake *FakeBrowserAutomation) WaitForConditionCalls(stub func(context.Context, string, string, playwright.WaitOptions) (*playwright.WaitResult, error)) {
 	fake.waitForConditionMutex.Lock()
 	defer fake.waitForConditionMutex.Unlock()
 	fake.WaitForConditionStub = stub
 }
 
-func (fake *FakeBrowserAutomation) WaitForConditionArgsForCall(i int) (context.Context, string, string, string, string, time.Duration, string) {
+func (fake *FakeBrowserAutomation) WaitForConditionArgsForCall(i int) (context.Context, string, string, playwright.WaitOptions) {
 	fake.waitForConditionMutex.RLock()
 	defer fake.waitForConditionMutex.RUnlock()
 	argsForCall := fake.waitForConditionArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeBrowserAutomation) WaitForConditionReturns(result1 error) {
+func (fake *FakeBrowserAutomation) WaitForConditionReturns(result1 *playwright.WaitResult, result2 error) {
 	fake.waitForConditionMutex.Lock()
 	defer fake.waitForConditionMutex.Unlock()
 	fake.WaitForConditionStub = nil
 	fake.waitForConditionReturns = struct {
-		result1 error
-	}{result1}
+		result1 *playwright.WaitResult
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeBrowserAutomation) WaitForConditionReturnsOnCall(i int, result1 error) {
+func (fake *FakeBrowserAutomation) WaitForConditionReturnsOnCall(i int, result1 *playwright.WaitResult, result2 error) {
 	fake.waitForConditionMutex.Lock()
 	defer fake.waitForConditionMutex.Unlock()
 	fake.WaitForConditionStub = nil
 	if fake.waitForConditionReturnsOnCall == nil {
 		fake.waitForConditionReturnsOnCall = make(map[int]struct {
-			result1 error
+			result1 *playwright.WaitResult
+			result2 error
 		})
 	}
 	fake.waitForConditionReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+		result1 *playwright.WaitResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) WaitForDownload(arg1 context.Context, arg2 string, arg3 playwright.DownloadOptions) (*playwright.Download, error) {
+	fake.waitForDownloadMutex.Lock()
+	ret, specificReturn := fake.waitForDownloadReturnsOnCall[len(fake.waitForDownloadArgsForCall)]
+	fake.waitForDownloadArgsForCall = append(fake.waitForDownloadArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 playwright.DownloadOptions
+	}{arg1, arg2, arg3})
+	stub := fake.WaitForDownloadStub
+	fakeReturns := fake.waitForDownloadReturns
+	fake.recordInvocation("WaitForDownload", []interface{}{arg1, arg2, arg3})
+	fake.waitForDownloadMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBrowserAutomation) WaitForDownloadCallCount() int {
+	fake.waitForDownloadMutex.RLock()
+	defer fake.waitForDownloadMutex.RUnlock()
+	return len(fake.waitForDownloadArgsForCall)
+}
+
+func (fake *FakeBrowserAutomation) WaitForDownloadCalls(stub func(context.Context, string, playwright.DownloadOptions) (*playwright.Download, error)) {
+	fake.waitForDownloadMutex.Lock()
+	defer fake.waitForDownloadMutex.Unlock()
+	fake.WaitForDownloadStub = stub
+}
+
+func (fake *FakeBrowserAutomation) WaitForDownloadArgsForCall(i int) (context.Context, string, playwright.DownloadOptions) {
+	fake.waitForDownloadMutex.RLock()
+	defer fake.waitForDownloadMutex.RUnlock()
+	argsForCall := fake.waitForDownloadArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBrowserAutomation) WaitForDownloadReturns(result1 *playwright.Download, result2 error) {
+	fake.waitForDownloadMutex.Lock()
+	defer fake.waitForDownloadMutex.Unlock()
+	fake.WaitForDownloadStub = nil
+	fake.waitForDownloadReturns = struct {
+		result1 *playwright.Download
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBrowserAutomation) WaitForDownloadReturnsOnCall(i int, result1 *playwright.Download, result2 error) {
+	fake.waitForDownloadMutex.Lock()
+	defer fake.waitForDownloadMutex.Unlock()
+	fake.WaitForDownloadStub = nil
+	if fake.waitForDownloadReturnsOnCall == nil {
+		fake.waitForDownloadReturnsOnCall = make(map[int]struct {
+			result1 *playwright.Download
+			result2 error
+		})
+	}
+	fake.waitForDownloadReturnsOnCall[i] = struct {
+		result1 *playwright.Download
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeBrowserAutomation) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.addRouteMutex.RLock()
+	defer fake.addRouteMutex.RUnlock()
+	fake.clearCookiesMutex.RLock()
+	defer fake.clearCookiesMutex.RUnlock()
+	fake.clickAtMutex.RLock()
+	defer fake.clickAtMutex.RUnlock()
 	fake.clickElementMutex.RLock()
 	defer fake.clickElementMutex.RUnlock()
 	fake.closeBrowserMutex.RLock()
 	defer fake.closeBrowserMutex.RUnlock()
 	fake.closeExpiredSessionsMutex.RLock()
 	defer fake.closeExpiredSessionsMutex.RUnlock()
+	fake.closeTabMutex.RLock()
+	defer fake.closeTabMutex.RUnlock()
+	fake.configureBrowserMutex.RLock()
+	defer fake.configureBrowserMutex.RUnlock()
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	fake.downloadStoredMutex.RLock()
+	defer fake.downloadStoredMutex.RUnlock()
+	fake.dragAndDropMutex.RLock()
+	defer fake.dragAndDropMutex.RUnlock()
 	fake.executeScriptMutex.RLock()
 	defer fake.executeScriptMutex.RUnlock()
 	fake.extractDataMutex.RLock()
@@ -1366,26 +3887,72 @@ func (fake *FakeBrowserAutomation) Invocations() map[string][][]interface{} {
 	defer fake.fillFormMutex.RUnlock()
 	fake.getConfigMutex.RLock()
 	defer fake.getConfigMutex.RUnlock()
+	fake.getConsoleLogsMutex.RLock()
+	defer fake.getConsoleLogsMutex.RUnlock()
+	fake.getCookiesMutex.RLock()
+	defer fake.getCookiesMutex.RUnlock()
 	fake.getHealthMutex.RLock()
 	defer fake.getHealthMutex.RUnlock()
-	fake.getOrCreateDefaultSessionMutex.RLock()
-	defer fake.getOrCreateDefaultSessionMutex.RUnlock()
+	fake.getNetworkLogMutex.RLock()
+	defer fake.getNetworkLogMutex.RUnlock()
 	fake.getOrCreateTaskSessionMutex.RLock()
 	defer fake.getOrCreateTaskSessionMutex.RUnlock()
+	fake.getPageContentMutex.RLock()
+	defer fake.getPageContentMutex.RUnlock()
+	fake.getPageSnapshotMutex.RLock()
+	defer fake.getPageSnapshotMutex.RUnlock()
 	fake.getSessionMutex.RLock()
 	defer fake.getSessionMutex.RUnlock()
+	fake.getStorageMutex.RLock()
+	defer fake.getStorageMutex.RUnlock()
 	fake.handleAuthenticationMutex.RLock()
 	defer fake.handleAuthenticationMutex.RUnlock()
+	fake.hoverMutex.RLock()
+	defer fake.hoverMutex.RUnlock()
 	fake.launchBrowserMutex.RLock()
 	defer fake.launchBrowserMutex.RUnlock()
+	fake.listProfilesMutex.RLock()
+	defer fake.listProfilesMutex.RUnlock()
+	fake.listRoutesMutex.RLock()
+	defer fake.listRoutesMutex.RUnlock()
+	fake.listTabsMutex.RLock()
+	defer fake.listTabsMutex.RUnlock()
+	fake.navigateHistoryMutex.RLock()
+	defer fake.navigateHistoryMutex.RUnlock()
 	fake.navigateToURLMutex.RLock()
 	defer fake.navigateToURLMutex.RUnlock()
+	fake.openTabMutex.RLock()
+	defer fake.openTabMutex.RUnlock()
+	fake.pressKeysMutex.RLock()
+	defer fake.pressKeysMutex.RUnlock()
+	fake.removeRoutesMutex.RLock()
+	defer fake.removeRoutesMutex.RUnlock()
+	fake.saveProfileMutex.RLock()
+	defer fake.saveProfileMutex.RUnlock()
+	fake.saveStorageStateMutex.RLock()
+	defer fake.saveStorageStateMutex.RUnlock()
+	fake.scrollMutex.RLock()
+	defer fake.scrollMutex.RUnlock()
+	fake.scrollIntoViewMutex.RLock()
+	defer fake.scrollIntoViewMutex.RUnlock()
+	fake.setCookiesMutex.RLock()
+	defer fake.setCookiesMutex.RUnlock()
+	fake.setProxyMutex.RLock()
+	defer fake.setProxyMutex.RUnlock()
+	fake.setStorageMutex.RLock()
+	defer fake.setStorageMutex.RUnlock()
 	fake.shutdownMutex.RLock()
 	defer fake.shutdownMutex.RUnlock()
+	fake.switchTabMutex.RLock()
+	defer fake.switchTabMutex.RUnlock()
 	fake.takeScreenshotMutex.RLock()
 	defer fake.takeScreenshotMutex.RUnlock()
+	fake.typeTextMutex.RLock()
+	defer fake.typeTextMutex.RUnlock()
 	fake.waitForConditionMutex.RLock()
 	defer fake.waitForConditionMutex.RUnlock()
+	fake.waitForDownloadMutex.RLock()
+	defer fake.waitForDownloadMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
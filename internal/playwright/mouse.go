@@ -0,0 +1,284 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+)
+
+const (
+	// DefaultMaxScrolls bounds the rounds of a scroll to the bottom of an
+	// infinite feed
+	DefaultMaxScrolls = 10
+	// scrollSettleTime is how long a scroll to the bottom waits for a feed
+	// to load more before taking the bottom as reached
+	scrollSettleTime = 2 * time.Second
+)
+
+const (
+	// scrollPositionScript reads the scroll position of an element, or of
+	// the document for the root element, once pending scrolls have painted
+	scrollPositionScript = `el => new Promise(resolve => requestAnimationFrame(() => requestAnimationFrame(() => {
+		const s = el === document.documentElement ? document.scrollingElement : el;
+		resolve({
+			x: s.scrollLeft,
+			y: s.scrollTop,
+			height: s.scrollHeight,
+			viewport_height: s.clientHeight,
+			at_bottom: s.scrollTop + s.clientHeight >= s.scrollHeight - 1,
+		});
+	})))`
+	// scrollToBottomScript scrolls an element, or the document, to the
+	// bottom and waits up to ms for its content to grow. It resolves to
+	// whether it grew.
+	scrollToBottomScript = `(el, ms) => new Promise(resolve => {
+		const s = el === document.documentElement ? document.scrollingElement : el;
+		const height = s.scrollHeight;
+		s.scrollTop = height;
+		const started = performance.now();
+		(function poll() {
+			if (s.scrollHeight > height) return resolve(true);
+			if (performance.now() - started > ms) return resolve(false);
+			setTimeout(poll, 100);
+		})();
+	})`
+)
+
+// MouseOptions configures the element actions of the mouse
+type MouseOptions struct {
+	Frame   string
+	Timeout time.Duration
+}
+
+// ScrollOptions configures Scroll. Without Selector the page scrolls.
+type ScrollOptions struct {
+	// Selector names a scrollable element, such as a feed's container
+	Selector string
+	Frame    string
+	// DeltaX and DeltaY scroll with the mouse wheel, in CSS pixels
+	DeltaX float64
+	DeltaY float64
+	// ToBottom scrolls to the bottom again and again while the content
+	// grows, up to MaxScrolls times, loading an infinite feed
+	ToBottom   bool
+	MaxScrolls int
+	Timeout    time.Duration
+}
+
+// ScrollPosition is where a page or element is scrolled to
+type ScrollPosition struct {
+	X              float64 `json:"x"`
+	Y              float64 `json:"y"`
+	Height         float64 `json:"height"`
+	ViewportHeight float64 `json:"viewport_height"`
+	AtBottom       bool    `json:"at_bottom"`
+	// Loads counts the times a scroll to the bottom made the content grow
+	Loads int `json:"loads,omitempty"`
+}
+
+// ClickAtOptions configures ClickAt
+type ClickAtOptions struct {
+	// Button is left, right or middle; left when empty
+	Button     string
+	ClickCount int
+}
+
+// Hover moves the mouse over an element, revealing menus and tooltips that
+// open on hover
+func (p *playwrightImpl) Hover(ctx context.Context, sessionID, selector string, options MouseOptions) error {
+	locator, err := p.mouseTarget(sessionID, selector, options.Frame)
+	if err != nil {
+		return err
+	}
+	p.logger.Info("hovering element", zap.String("sessionID", sessionID), zap.String("selector", selector))
+	return locator.Hover(playwright.LocatorHoverOptions{Timeout: playwrightTimeout(options.Timeout)})
+}
+
+// ScrollIntoView scrolls an element into the viewport, unless it is already
+// visible
+func (p *playwrightImpl) ScrollIntoView(ctx context.Context, sessionID, selector string, options MouseOptions) error {
+	locator, err := p.mouseTarget(sessionID, selector, options.Frame)
+	if err != nil {
+		return err
+	}
+	p.logger.Info("scrolling element into view", zap.String("sessionID", sessionID), zap.String("selector", selector))
+	return locator.ScrollIntoViewIfNeeded(playwright.LocatorScrollIntoViewIfNeededOptions{Timeout: playwrightTimeout(options.Timeout)})
+}
+
+// Scroll scrolls the page, or the element options.Selector names, by a
+// delta with the mouse wheel or to the bottom, and returns where it ended
+func (p *playwrightImpl) Scroll(ctx context.Context, sessionID string, options ScrollOptions) (*ScrollPosition, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	page := session.ActivePage()
+
+	target := page.Locator(":root")
+	if options.Selector != "" {
+		if target, err = p.locate(page, options.Frame, options.Selector); err != nil {
+			return nil, err
+		}
+		target = target.First()
+	}
+
+	loads := 0
+	if options.ToBottom {
+		maxScrolls := options.MaxScrolls
+		if maxScrolls <= 0 {
+			maxScrolls = DefaultMaxScrolls
+		}
+		settle := scrollSettleTime
+		if options.Timeout > 0 && options.Timeout < settle {
+			settle = options.Timeout
+		}
+		p.logger.Info("scrolling to bottom",
+			zap.String("sessionID", sessionID),
+			zap.String("selector", options.Selector),
+			zap.Int("maxScrolls", maxScrolls))
+		for range maxScrolls {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			grew, err := target.Evaluate(scrollToBottomScript, settle.Milliseconds(),
+				playwright.LocatorEvaluateOptions{Timeout: playwrightTimeout(options.Timeout + settle)})
+			if err != nil {
+				return nil, fmt.Errorf("failed to scroll to the bottom: %w", err)
+			}
+			if grew != true {
+				break
+			}
+			loads++
+		}
+	} else {
+		if options.Selector != "" {
+			// The wheel scrolls whatever is under the mouse
+			if err := target.Hover(playwright.LocatorHoverOptions{Timeout: playwrightTimeout(options.Timeout)}); err != nil {
+				return nil, fmt.Errorf("failed to move the mouse over %s: %w", options.Selector, err)
+			}
+		}
+		p.logger.Info("scrolling",
+			zap.String("sessionID", sessionID),
+			zap.Float64("deltaX", options.DeltaX),
+			zap.Float64("deltaY", options.DeltaY))
+		if err := page.Mouse().Wheel(options.DeltaX, options.DeltaY); err != nil {
+			return nil, fmt.Errorf("failed to scroll: %w", err)
+		}
+	}
+
+	raw, err := target.Evaluate(scrollPositionScript, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the scroll position: %w", err)
+	}
+	position := parseScrollPosition(raw)
+	position.Loads = loads
+	return position, nil
+}
+
+// DragAndDrop drags the source element onto the target element, both
+// looked up in options.Frame
+func (p *playwrightImpl) DragAndDrop(ctx context.Context, sessionID, source, target string, options MouseOptions) error {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return err
+	}
+	page := session.ActivePage()
+
+	from, err := p.locate(page, options.Frame, source)
+	if err != nil {
+		return err
+	}
+	to, err := p.locate(page, options.Frame, target)
+	if err != nil {
+		return err
+	}
+
+	p.logger.Info("dragging element",
+		zap.String("sessionID", sessionID),
+		zap.String("source", source),
+		zap.String("target", target))
+	if err := from.DragTo(to, playwright.LocatorDragToOptions{Timeout: playwrightTimeout(options.Timeout)}); err != nil {
+		return fmt.Errorf("failed to drag %s to %s: %w", source, target, err)
+	}
+	return nil
+}
+
+// ClickAt clicks at a point of the viewport, in CSS pixels from its top
+// left corner, for when the target is known from a screenshot rather than a
+// selector
+func (p *playwrightImpl) ClickAt(ctx context.Context, sessionID string, x, y float64, options ClickAtOptions) error {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return err
+	}
+	page := session.ActivePage()
+
+	if viewport := page.ViewportSize(); viewport != nil &&
+		(x < 0 || y < 0 || x > float64(viewport.Width) || y > float64(viewport.Height)) {
+		return fmt.Errorf("point (%g, %g) is outside the %dx%d viewport", x, y, viewport.Width, viewport.Height)
+	}
+
+	clickOptions := playwright.MouseClickOptions{Button: playwright.MouseButtonLeft}
+	switch options.Button {
+	case "right":
+		clickOptions.Button = playwright.MouseButtonRight
+	case "middle":
+		clickOptions.Button = playwright.MouseButtonMiddle
+	}
+	if options.ClickCount > 0 {
+		clickOptions.ClickCount = &options.ClickCount
+	}
+
+	p.logger.Info("clicking at point",
+		zap.String("sessionID", sessionID),
+		zap.Float64("x", x),
+		zap.Float64("y", y))
+	return page.Mouse().Click(x, y, clickOptions)
+}
+
+// mouseTarget locates the element a mouse action applies to
+func (p *playwrightImpl) mouseTarget(sessionID, selector, frame string) (playwright.Locator, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	locator, err := p.locate(session.ActivePage(), frame, selector)
+	if err != nil {
+		return nil, err
+	}
+	return locator.First(), nil
+}
+
+// playwrightTimeout converts a timeout for Playwright's options, nil when unset
+func playwrightTimeout(timeout time.Duration) *float64 {
+	if timeout <= 0 {
+		return nil
+	}
+	ms := float64(timeout.Milliseconds())
+	return &ms
+}
+
+// parseScrollPosition converts the position read by scrollPositionScript
+func parseScrollPosition(raw any) *ScrollPosition {
+	values, _ := raw.(map[string]any)
+	number := func(key string) float64 {
+		switch n := values[key].(type) {
+		case int:
+			return float64(n)
+		case float64:
+			return n
+		}
+		return 0
+	}
+	atBottom, _ := values["at_bottom"].(bool)
+	return &ScrollPosition{
+		X:              number("x"),
+		Y:              number("y"),
+		Height:         number("height"),
+		ViewportHeight: number("viewport_height"),
+		AtBottom:       atBottom,
+	}
+}
@@ -0,0 +1,96 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestParseScrollPosition(t *testing.T) {
+	position := parseScrollPosition(map[string]any{
+		"x": 0, "y": float64(1200), "height": 3000, "viewport_height": float64(800), "at_bottom": false,
+	})
+	assert.Equal(t, &ScrollPosition{Y: 1200, Height: 3000, ViewportHeight: 800}, position)
+	assert.Equal(t, &ScrollPosition{}, parseScrollPosition(nil))
+}
+
+func TestMouseActions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `
+<style>
+  #menu:hover #submenu { display: block }
+  #submenu { display: none }
+  #feed { height: 200px; overflow: auto }
+  .item { height: 100px }
+  #drop { width: 100px; height: 100px; margin-top: 20px; background: #eee }
+  #spot { position: absolute; left: 300px; top: 10px; width: 40px; height: 40px }
+</style>
+<div id="menu">Menu <a id="submenu" href="#">Settings</a></div>
+<div id="feed"></div>
+<div id="drag" draggable="true">Card</div>
+<div id="drop"></div>
+<button id="spot" onclick="this.textContent = 'hit'"></button>
+<script>
+  const feed = document.getElementById('feed');
+  let pages = 0;
+  const load = () => { for (let i = 0; i < 5; i++) feed.insertAdjacentHTML('beforeend', '<div class="item">item</div>'); pages++; };
+  load();
+  feed.addEventListener('scroll', () => {
+    if (pages < 3 && feed.scrollTop + feed.clientHeight >= feed.scrollHeight - 1) setTimeout(load, 50);
+  });
+  const drop = document.getElementById('drop');
+  drop.addEventListener('dragover', e => e.preventDefault());
+  drop.addEventListener('drop', e => { e.preventDefault(); drop.textContent = 'dropped'; });
+</script>`)
+	}))
+	defer srv.Close()
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium", DataDir: t.TempDir()},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	ctx := context.Background()
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL, "load", 10*time.Second)
+	require.NoError(t, err)
+	options := MouseOptions{Timeout: 5 * time.Second}
+
+	require.NoError(t, service.Hover(ctx, session.ID, "#menu", options))
+	visible, err := service.ExecuteScript(ctx, session.ID, `() => getComputedStyle(document.getElementById('submenu')).display`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "block", visible, "hovering opens the submenu")
+
+	position, err := service.Scroll(ctx, session.ID, ScrollOptions{Selector: "#feed", DeltaY: 150, Timeout: 5 * time.Second})
+	require.NoError(t, err)
+	assert.Equal(t, float64(150), position.Y)
+
+	position, err = service.Scroll(ctx, session.ID, ScrollOptions{Selector: "#feed", ToBottom: true, Timeout: 5 * time.Second})
+	require.NoError(t, err)
+	assert.Equal(t, 2, position.Loads, "the feed loads twice more, then stops")
+	assert.Equal(t, float64(1500), position.Height)
+	assert.True(t, position.AtBottom)
+
+	require.NoError(t, service.DragAndDrop(ctx, session.ID, "#drag", "#drop", options))
+	require.NoError(t, service.ClickAt(ctx, session.ID, 320, 30, ClickAtOptions{}))
+	texts, err := service.ExecuteScript(ctx, session.ID,
+		`() => [document.getElementById('drop').textContent, document.getElementById('spot').textContent]`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"dropped", "hit"}, texts)
+
+	err = service.ClickAt(ctx, session.ID, 100000, 10, ClickAtOptions{})
+	assert.ErrorContains(t, err, "outside")
+}
@@ -0,0 +1,186 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+)
+
+// History actions of NavigateHistory
+const (
+	HistoryBack    = "back"
+	HistoryForward = "forward"
+	HistoryReload  = "reload"
+)
+
+// HistoryActions are the actions NavigateHistory accepts
+var HistoryActions = []string{HistoryBack, HistoryForward, HistoryReload}
+
+// NavigationResult describes the page a navigation ended on
+type NavigationResult struct {
+	// URL is the final URL, after redirects
+	URL   string `json:"url"`
+	Title string `json:"title"`
+	// Status is the HTTP status of the main response, or 0 when the
+	// navigation had none, such as a back to a page served from cache or
+	// within the same document
+	Status     int    `json:"status,omitempty"`
+	StatusText string `json:"status_text,omitempty"`
+	// Redirects are the HTTP redirects the main request followed, oldest
+	// first
+	Redirects []Redirect `json:"redirects,omitempty"`
+	// Headers are the main response's headers, without the cookie-related
+	// ones Playwright withholds
+	Headers map[string]string `json:"headers,omitempty"`
+	Timing  *NavigationTiming `json:"timing,omitempty"`
+}
+
+// Redirect is a response that redirected the main request
+type Redirect struct {
+	URL    string `json:"url"`
+	Status int    `json:"status"`
+}
+
+// NavigationTiming is how long a navigation took, in milliseconds. The
+// page's events are measured from the start of the main request and are 0
+// when they had not fired by the time the navigation was done waiting.
+type NavigationTiming struct {
+	// DurationMs is the whole navigation, including the wait for the
+	// wait_until condition
+	DurationMs         int64   `json:"duration_ms"`
+	ResponseStartMs    float64 `json:"response_start_ms,omitempty"`
+	ResponseEndMs      float64 `json:"response_end_ms,omitempty"`
+	DOMContentLoadedMs float64 `json:"dom_content_loaded_ms,omitempty"`
+	LoadMs             float64 `json:"load_ms,omitempty"`
+}
+
+// navigationEventsScript reads when the document's DOMContentLoaded and
+// load events ended
+const navigationEventsScript = `() => {
+	const entry = performance.getEntriesByType('navigation')[0];
+	return entry ? { dom_content_loaded: entry.domContentLoadedEventEnd, load: entry.loadEventEnd } : null;
+}`
+
+// NavigateToURL navigates the active tab to url, waits for waitUntil and
+// describes the response the page ended on
+func (p *playwrightImpl) NavigateToURL(ctx context.Context, sessionID, url string, waitUntil string, timeout time.Duration) (*NavigationResult, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	page := session.ActivePage()
+
+	timeoutMs := float64(timeout.Milliseconds())
+	options := playwright.PageGotoOptions{
+		WaitUntil: waitUntilState(waitUntil),
+		Timeout:   &timeoutMs,
+	}
+
+	p.logger.Info("navigating to URL", zap.String("sessionID", sessionID), zap.String("url", url))
+	started := time.Now()
+	response, err := page.Goto(url, options)
+	if err != nil {
+		return nil, err
+	}
+	return navigationResult(page, response, started), nil
+}
+
+// NavigateHistory goes back or forward in the active tab's history, or
+// reloads it, and waits for waitUntil like NavigateToURL
+func (p *playwrightImpl) NavigateHistory(ctx context.Context, sessionID, action, waitUntil string, timeout time.Duration) (*NavigationResult, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	page := session.ActivePage()
+	before := page.URL()
+
+	timeoutMs := float64(timeout.Milliseconds())
+	state := waitUntilState(waitUntil)
+	p.logger.Info("navigating history", zap.String("sessionID", sessionID), zap.String("action", action))
+	started := time.Now()
+
+	var response playwright.Response
+	switch action {
+	case HistoryBack:
+		response, err = page.GoBack(playwright.PageGoBackOptions{WaitUntil: state, Timeout: &timeoutMs})
+	case HistoryForward:
+		response, err = page.GoForward(playwright.PageGoForwardOptions{WaitUntil: state, Timeout: &timeoutMs})
+	case HistoryReload:
+		response, err = page.Reload(playwright.PageReloadOptions{WaitUntil: state, Timeout: &timeoutMs})
+	default:
+		return nil, fmt.Errorf("unknown history action %q", action)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Playwright returns no response when there is no entry to go to
+	if response == nil && action != HistoryReload && page.URL() == before {
+		return nil, fmt.Errorf("there is no page to go %s to", action)
+	}
+
+	return navigationResult(page, response, started), nil
+}
+
+// navigationResult describes the page a navigation started at started
+// ended on, with response as its main response, if any
+func navigationResult(page playwright.Page, response playwright.Response, started time.Time) *NavigationResult {
+	result := &NavigationResult{
+		URL:    page.URL(),
+		Timing: &NavigationTiming{DurationMs: time.Since(started).Milliseconds()},
+	}
+	if title, err := page.Title(); err == nil {
+		result.Title = title
+	}
+	if response == nil {
+		return result
+	}
+
+	result.Status = response.Status()
+	result.StatusText = response.StatusText()
+	result.Headers = response.Headers()
+	request := response.Request()
+	result.Redirects = redirectChain(request)
+	if timing := request.Timing(); timing != nil {
+		result.Timing.ResponseStartMs = nonNegative(timing.ResponseStart)
+		result.Timing.ResponseEndMs = nonNegative(timing.ResponseEnd)
+	}
+	if events, err := page.Evaluate(navigationEventsScript); err == nil {
+		events, _ := events.(map[string]any)
+		result.Timing.DOMContentLoadedMs = milliseconds(events["dom_content_loaded"])
+		result.Timing.LoadMs = milliseconds(events["load"])
+	}
+	return result
+}
+
+// redirectChain lists the redirects that led to request, oldest first
+func redirectChain(request playwright.Request) []Redirect {
+	var redirects []Redirect
+	for from := request.RedirectedFrom(); from != nil; from = from.RedirectedFrom() {
+		redirect := Redirect{URL: from.URL()}
+		if response, err := from.Response(); err == nil && response != nil {
+			redirect.Status = response.Status()
+		}
+		redirects = append([]Redirect{redirect}, redirects...)
+	}
+	return redirects
+}
+
+// nonNegative turns Playwright's -1 for an unavailable timing into 0
+func nonNegative(ms float64) float64 {
+	return max(ms, 0)
+}
+
+// milliseconds reads a time measured by a script
+func milliseconds(raw any) float64 {
+	switch n := raw.(type) {
+	case int:
+		return float64(n)
+	case float64:
+		return n
+	}
+	return 0
+}
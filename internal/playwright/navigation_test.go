@@ -0,0 +1,117 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestNavigation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/old":
+			http.Redirect(w, r, "/moved", http.StatusMovedPermanently)
+			return
+		case "/moved":
+			http.Redirect(w, r, "/first", http.StatusFound)
+			return
+		}
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("X-Page", r.URL.Path)
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		_, _ = fmt.Fprintf(w, `<title>%s</title>`, r.URL.Path)
+	}))
+	defer srv.Close()
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium", DataDir: t.TempDir()},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	ctx := context.Background()
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+	result, err := service.NavigateToURL(ctx, session.ID, srv.URL+"/old", "load", 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL+"/first", result.URL)
+	assert.Equal(t, "/first", result.Title)
+	assert.Equal(t, http.StatusOK, result.Status)
+	assert.Equal(t, []Redirect{
+		{URL: srv.URL + "/old", Status: http.StatusMovedPermanently},
+		{URL: srv.URL + "/moved", Status: http.StatusFound},
+	}, result.Redirects)
+	assert.Equal(t, "/first", result.Headers["x-page"])
+	require.NotNil(t, result.Timing)
+	assert.Positive(t, result.Timing.LoadMs)
+
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL+"/missing", "load", 10*time.Second)
+	require.NoError(t, err)
+
+	_, err = service.NavigateHistory(ctx, session.ID, HistoryForward, "load", 10*time.Second)
+	assert.ErrorContains(t, err, "no page to go forward to")
+
+	result, err = service.NavigateHistory(ctx, session.ID, HistoryBack, "load", 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL+"/first", result.URL)
+	assert.Equal(t, "/first", result.Title)
+
+	result, err = service.NavigateHistory(ctx, session.ID, HistoryForward, "load", 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL+"/missing", result.URL)
+
+	result, err = service.NavigateHistory(ctx, session.ID, HistoryReload, "domcontentloaded", 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, result.Status)
+	assert.Equal(t, "/missing", result.Title)
+}
+
+func TestNavigateToURL_Cancelled(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			<-release
+		}
+		_, _ = fmt.Fprint(w, `<title>ok</title>`)
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium", DataDir: t.TempDir()},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	session, err := service.GetOrCreateTaskSession(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(200*time.Millisecond, cancel)
+	started := time.Now()
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL+"/slow", "load", 30*time.Second)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(started), 5*time.Second)
+
+	tabs, err := service.ListTabs(context.Background(), session.ID)
+	require.NoError(t, err)
+	assert.Len(t, tabs, 1, "the cancelled tab is replaced with a blank one")
+
+	result, err := service.NavigateToURL(context.Background(), session.ID, srv.URL, "load", 10*time.Second)
+	require.NoError(t, err, "the session stays usable after a cancellation")
+	assert.Equal(t, "ok", result.Title)
+}
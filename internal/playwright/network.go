@@ -0,0 +1,311 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+)
+
+// DefaultNetworkLogSize is how many requests a session's network log keeps
+const DefaultNetworkLogSize = 500
+
+// NetworkEntry is one request made by a session's pages, with what is known
+// of its response so far
+type NetworkEntry struct {
+	ID           int       `json:"id"`
+	URL          string    `json:"url"`
+	Method       string    `json:"method"`
+	ResourceType string    `json:"resource_type"`
+	Status       int       `json:"status,omitempty"`
+	StatusText   string    `json:"status_text,omitempty"`
+	MimeType     string    `json:"mime_type,omitempty"`
+	Size         *int64    `json:"size,omitempty"`
+	StartedAt    time.Time `json:"started_at"`
+	DurationMs   float64   `json:"duration_ms,omitempty"`
+	Finished     bool      `json:"finished"`
+	Failure      string    `json:"failure,omitempty"`
+	Body         string    `json:"body,omitempty"`
+	BodyError    string    `json:"body_error,omitempty"`
+	Truncated    bool      `json:"body_truncated,omitempty"`
+
+	request  playwright.Request
+	response playwright.Response
+}
+
+// NetworkFilter selects entries from a network log. Zero values match
+// everything.
+type NetworkFilter struct {
+	// URLPattern is a glob, a /regex/ or a substring of the request URL
+	URLPattern    string
+	Method        string
+	ResourceTypes []string
+	// StatusMin and StatusMax bound the response status, inclusive
+	StatusMin int
+	StatusMax int
+	// FailedOnly keeps requests that failed without a response
+	FailedOnly bool
+	// Limit keeps only the most recent matches
+	Limit int
+	// IncludeBodies adds the bodies of JSON responses, cut at MaxBodyBytes
+	IncludeBodies bool
+	MaxBodyBytes  int
+}
+
+// NetworkLog is the result of GetNetworkLog, oldest request first
+type NetworkLog struct {
+	Entries []NetworkEntry `json:"entries"`
+	// Matched counts the entries that passed the filter before Limit
+	Matched int `json:"matched"`
+	// Recorded is how many requests the log holds
+	Recorded int `json:"recorded"`
+	// Dropped is how many older requests were evicted from the full log
+	Dropped int `json:"dropped"`
+}
+
+// networkLog is a bounded ring buffer of a session's requests. It is fed by
+// the context's request events, which Playwright dispatches from its own
+// goroutine.
+type networkLog struct {
+	mu       sync.Mutex
+	entries  []*NetworkEntry
+	start    int
+	count    int
+	nextID   int
+	dropped  int
+	inFlight map[playwright.Request]*NetworkEntry
+}
+
+// newNetworkLog returns a log holding up to size requests, or nil when
+// size is not positive and capture is disabled
+func newNetworkLog(size int) *networkLog {
+	if size <= 0 {
+		return nil
+	}
+	return &networkLog{
+		entries:  make([]*NetworkEntry, size),
+		inFlight: map[playwright.Request]*NetworkEntry{},
+	}
+}
+
+// record appends an entry, evicting the oldest one when the log is full
+func (l *networkLog) record(entry *NetworkEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.nextID++
+	entry.ID = l.nextID
+	if l.count == len(l.entries) {
+		evicted := l.entries[l.start]
+		delete(l.inFlight, evicted.request)
+		l.entries[l.start] = entry
+		l.start = (l.start + 1) % len(l.entries)
+		l.dropped++
+	} else {
+		l.entries[(l.start+l.count)%len(l.entries)] = entry
+		l.count++
+	}
+	if entry.request != nil {
+		l.inFlight[entry.request] = entry
+	}
+}
+
+// update applies fn to the in-flight entry of request, if it is still held.
+// With done the request leaves the in-flight set.
+func (l *networkLog) update(request playwright.Request, done bool, fn func(*NetworkEntry)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry, ok := l.inFlight[request]
+	if !ok {
+		return
+	}
+	fn(entry)
+	if done {
+		delete(l.inFlight, request)
+	}
+}
+
+// snapshot copies the held entries, oldest first
+func (l *networkLog) snapshot() ([]NetworkEntry, int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entries := make([]NetworkEntry, 0, l.count)
+	for i := range l.count {
+		entries = append(entries, *l.entries[(l.start+i)%len(l.entries)])
+	}
+	return entries, l.dropped
+}
+
+func (l *networkLog) onRequest(request playwright.Request) {
+	l.record(&NetworkEntry{
+		URL:          request.URL(),
+		Method:       request.Method(),
+		ResourceType: request.ResourceType(),
+		StartedAt:    time.Now(),
+		request:      request,
+	})
+}
+
+func (l *networkLog) onResponse(response playwright.Response) {
+	headers := response.Headers()
+	l.update(response.Request(), false, func(entry *NetworkEntry) {
+		entry.response = response
+		entry.Status = response.Status()
+		entry.StatusText = response.StatusText()
+		if mediaType, _, err := mime.ParseMediaType(headers["content-type"]); err == nil {
+			entry.MimeType = mediaType
+		}
+		if length, err := strconv.ParseInt(headers["content-length"], 10, 64); err == nil {
+			entry.Size = &length
+		}
+	})
+}
+
+func (l *networkLog) onRequestFinished(request playwright.Request) {
+	l.update(request, true, func(entry *NetworkEntry) {
+		entry.Finished = true
+		entry.DurationMs = requestDuration(request, entry.StartedAt)
+	})
+}
+
+func (l *networkLog) onRequestFailed(request playwright.Request) {
+	l.update(request, true, func(entry *NetworkEntry) {
+		entry.Finished = true
+		entry.DurationMs = requestDuration(request, entry.StartedAt)
+		if failure := request.Failure(); failure != nil {
+			entry.Failure = failure.Error()
+		} else {
+			entry.Failure = "failed"
+		}
+	})
+}
+
+// requestDuration is the time from the request's start to the last byte of
+// its response, from the browser's timing when it reported one
+func requestDuration(request playwright.Request, started time.Time) float64 {
+	if timing := request.Timing(); timing != nil && timing.ResponseEnd > 0 {
+		return timing.ResponseEnd
+	}
+	return float64(time.Since(started).Microseconds()) / 1000
+}
+
+// captureNetwork records the requests of the session's current context
+func (p *playwrightImpl) captureNetwork(session *BrowserSession) {
+	log := session.network
+	if log == nil || session.Context == nil {
+		return
+	}
+	session.Context.OnRequest(log.onRequest)
+	session.Context.OnResponse(log.onResponse)
+	session.Context.OnRequestFinished(log.onRequestFinished)
+	session.Context.OnRequestFailed(log.onRequestFailed)
+}
+
+// matches reports whether entry passes every set field of the filter
+func (f NetworkFilter) matches(entry NetworkEntry, matchURL func(string) bool) bool {
+	if matchURL != nil && !matchURL(entry.URL) {
+		return false
+	}
+	if f.Method != "" && !strings.EqualFold(f.Method, entry.Method) {
+		return false
+	}
+	if len(f.ResourceTypes) > 0 && !slices.Contains(f.ResourceTypes, entry.ResourceType) {
+		return false
+	}
+	if f.FailedOnly && entry.Failure == "" {
+		return false
+	}
+	if f.StatusMin > 0 && entry.Status < f.StatusMin {
+		return false
+	}
+	if f.StatusMax > 0 && (entry.Status == 0 || entry.Status > f.StatusMax) {
+		return false
+	}
+	return true
+}
+
+// isJSONMimeType reports whether a response carries JSON
+func isJSONMimeType(mimeType string) bool {
+	return mimeType == "application/json" || strings.HasSuffix(mimeType, "+json")
+}
+
+// GetNetworkLog returns the requests recorded for a session that match
+// filter. Sizes missing from the response headers are looked up from the
+// browser, and JSON bodies are fetched when asked for, only for the entries
+// returned.
+func (p *playwrightImpl) GetNetworkLog(ctx context.Context, sessionID string, filter NetworkFilter) (*NetworkLog, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.network == nil {
+		return nil, fmt.Errorf("network capture is disabled; set BROWSER_NETWORK_LOG_SIZE to enable it")
+	}
+
+	var matchURL func(string) bool
+	if filter.URLPattern != "" {
+		if matchURL, err = urlMatcher(filter.URLPattern); err != nil {
+			return nil, err
+		}
+	}
+
+	recorded, dropped := session.network.snapshot()
+	matched := make([]NetworkEntry, 0, len(recorded))
+	for _, entry := range recorded {
+		if filter.matches(entry, matchURL) {
+			matched = append(matched, entry)
+		}
+	}
+
+	entries := matched
+	if filter.Limit > 0 && len(entries) > filter.Limit {
+		entries = entries[len(entries)-filter.Limit:]
+	}
+	for i := range entries {
+		p.completeNetworkEntry(&entries[i], filter)
+	}
+
+	return &NetworkLog{
+		Entries:  entries,
+		Matched:  len(matched),
+		Recorded: len(recorded),
+		Dropped:  dropped,
+	}, nil
+}
+
+// completeNetworkEntry fills in the size and, for JSON responses when
+// filter asks for it, the body of a finished entry
+func (p *playwrightImpl) completeNetworkEntry(entry *NetworkEntry, filter NetworkFilter) {
+	if !entry.Finished || entry.response == nil {
+		return
+	}
+
+	if entry.Size == nil {
+		if sizes, err := entry.request.Sizes(); err == nil {
+			size := int64(sizes.ResponseBodySize)
+			entry.Size = &size
+		}
+	}
+
+	if !filter.IncludeBodies || !isJSONMimeType(entry.MimeType) {
+		return
+	}
+	body, err := entry.response.Body()
+	if err != nil {
+		entry.BodyError = err.Error()
+		return
+	}
+	if filter.MaxBodyBytes > 0 && len(body) > filter.MaxBodyBytes {
+		body = body[:filter.MaxBodyBytes]
+		entry.Truncated = true
+	}
+	entry.Body = strings.ToValidUTF8(string(body), "")
+}
@@ -0,0 +1,113 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestNetworkLogRingBuffer(t *testing.T) {
+	assert.Nil(t, newNetworkLog(0), "a zero size disables capture")
+
+	log := newNetworkLog(3)
+	for i := 1; i <= 5; i++ {
+		log.record(&NetworkEntry{URL: fmt.Sprintf("https://example.com/%d", i)})
+	}
+
+	entries, dropped := log.snapshot()
+	assert.Equal(t, 2, dropped)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "https://example.com/3", entries[0].URL, "oldest kept entry first")
+	assert.Equal(t, 3, entries[0].ID)
+	assert.Equal(t, "https://example.com/5", entries[2].URL)
+}
+
+func TestNetworkFilterMatches(t *testing.T) {
+	api := NetworkEntry{URL: "https://shop.example.com/api/items?page=2", Method: "GET", ResourceType: "fetch", Status: 200}
+	failed := NetworkEntry{URL: "https://cdn.example.com/app.js", Method: "GET", ResourceType: "script", Failure: "net::ERR_FAILED"}
+
+	matchAPI, err := urlMatcher("**/api/**")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		filter   NetworkFilter
+		matchURL func(string) bool
+		entry    NetworkEntry
+		expected bool
+	}{
+		{name: "empty filter", entry: api, expected: true},
+		{name: "url glob", matchURL: matchAPI, entry: api, expected: true},
+		{name: "url glob miss", matchURL: matchAPI, entry: failed, expected: false},
+		{name: "method is case-insensitive", filter: NetworkFilter{Method: "get"}, entry: api, expected: true},
+		{name: "resource type", filter: NetworkFilter{ResourceTypes: []string{"xhr", "fetch"}}, entry: failed, expected: false},
+		{name: "status range", filter: NetworkFilter{StatusMin: 200, StatusMax: 299}, entry: api, expected: true},
+		{name: "status range excludes no response", filter: NetworkFilter{StatusMin: 400, StatusMax: 599}, entry: failed, expected: false},
+		{name: "failed only", filter: NetworkFilter{FailedOnly: true}, entry: failed, expected: true},
+		{name: "failed only skips answered", filter: NetworkFilter{FailedOnly: true}, entry: api, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.filter.matches(tt.entry, tt.matchURL))
+		})
+	}
+}
+
+func TestGetNetworkLogFindsAPICalls(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `<ul id="items"></ul><script>
+			fetch('/api/items').then(r => r.json()).then(items => {
+				document.getElementById('items').innerHTML = items.map(i => '<li>' + i + '</li>').join('')
+			})</script>`)
+	})
+	mux.HandleFunc("/api/items", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `["tea","coffee"]`)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium"},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	ctx := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name()})
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL, "networkidle", 10*time.Second)
+	require.NoError(t, err)
+
+	log, err := service.GetNetworkLog(ctx, session.ID, NetworkFilter{
+		URLPattern:    "**/api/**",
+		ResourceTypes: []string{"fetch"},
+		IncludeBodies: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, log.Entries, 1)
+
+	entry := log.Entries[0]
+	assert.Equal(t, srv.URL+"/api/items", entry.URL)
+	assert.Equal(t, 200, entry.Status)
+	assert.Equal(t, "application/json", entry.MimeType)
+	assert.JSONEq(t, `["tea","coffee"]`, entry.Body)
+	require.NotNil(t, entry.Size)
+	assert.Equal(t, int64(len(`["tea","coffee"]`)), *entry.Size)
+}
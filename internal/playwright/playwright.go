@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -18,6 +19,7 @@ import (
 	types "github.com/inference-gateway/adk/types"
 
 	config "github.com/inference-gateway/browser-agent/config"
+	proxy "github.com/inference-gateway/browser-agent/internal/proxy"
 )
 
 // BrowserEngine represents the browser type
@@ -32,27 +34,44 @@ const (
 
 const (
 	CleanupInterval = 2 * time.Minute
+
+	// DefaultPoolSize is how many long-lived browsers the shared pool keeps
+	DefaultPoolSize = 2
+	// DefaultPoolMaxContexts is how many contexts a pooled browser serves before it is recycled
+	DefaultPoolMaxContexts = 50
 )
 
 // BrowserConfig holds browser configuration options
 type BrowserConfig struct {
-	Engine         BrowserEngine
-	Headless       bool
-	Timeout        time.Duration
-	ViewportWidth  int
-	ViewportHeight int
-	Args           []string
-	CDPURL         string
+	Engine          BrowserEngine
+	Headless        bool
+	Timeout         time.Duration
+	ViewportWidth   int
+	ViewportHeight  int
+	Args            []string
+	CDPURL          string
+	PoolSize        int
+	PoolMaxContexts int
+	// NetworkLogSize is how many requests each session records; zero
+	// disables network capture
+	NetworkLogSize int
+	// ConsoleLogSize is how many console messages and page errors each
+	// session records; zero disables console capture
+	ConsoleLogSize int
 }
 
 // DefaultBrowserConfig returns default browser configuration
 func DefaultBrowserConfig() *BrowserConfig {
 	return &BrowserConfig{
-		Engine:         Chromium,
-		Headless:       true,
-		Timeout:        30 * time.Second,
-		ViewportWidth:  1920,
-		ViewportHeight: 1080,
+		Engine:          Chromium,
+		Headless:        true,
+		Timeout:         30 * time.Second,
+		ViewportWidth:   1920,
+		ViewportHeight:  1080,
+		PoolSize:        DefaultPoolSize,
+		PoolMaxContexts: DefaultPoolMaxContexts,
+		NetworkLogSize:  DefaultNetworkLogSize,
+		ConsoleLogSize:  DefaultConsoleLogSize,
 		Args: []string{
 			"--disable-dev-shm-usage",
 			"--no-sandbox",
@@ -87,6 +106,26 @@ func NewBrowserConfigFromConfig(cfg *config.Config) *BrowserConfig {
 		height = 1080
 	}
 
+	poolSize, _ := strconv.Atoi(cfg.Browser.PoolSize)
+	if poolSize < 1 {
+		poolSize = DefaultPoolSize
+	}
+
+	poolMaxContexts, err := strconv.Atoi(cfg.Browser.PoolMaxContexts)
+	if err != nil || poolMaxContexts < 0 {
+		poolMaxContexts = DefaultPoolMaxContexts
+	}
+
+	networkLogSize, err := strconv.Atoi(cfg.Browser.NetworkLogSize)
+	if err != nil || networkLogSize < 0 {
+		networkLogSize = DefaultNetworkLogSize
+	}
+
+	consoleLogSize, err := strconv.Atoi(cfg.Browser.ConsoleLogSize)
+	if err != nil || consoleLogSize < 0 {
+		consoleLogSize = DefaultConsoleLogSize
+	}
+
 	var engine BrowserEngine
 	switch strings.ToLower(cfg.Browser.Engine) {
 	case "firefox":
@@ -108,17 +147,34 @@ func NewBrowserConfigFromConfig(cfg *config.Config) *BrowserConfig {
 	}
 
 	return &BrowserConfig{
-		Engine:         engine,
-		Headless:       cfg.Browser.Headless,
-		Timeout:        30 * time.Second,
-		ViewportWidth:  width,
-		ViewportHeight: height,
-		Args:           args,
-		CDPURL:         cfg.Browser.CDPURL,
+		Engine:          engine,
+		Headless:        cfg.Browser.Headless,
+		Timeout:         30 * time.Second,
+		ViewportWidth:   width,
+		ViewportHeight:  height,
+		Args:            args,
+		CDPURL:          cfg.Browser.CDPURL,
+		PoolSize:        poolSize,
+		PoolMaxContexts: poolMaxContexts,
+		NetworkLogSize:  networkLogSize,
+		ConsoleLogSize:  consoleLogSize,
 	}
 }
 
-// BrowserSession represents an active browser session
+// sameLaunchOptions reports whether two configs would launch identical
+// browsers, i.e. whether a browser started for one can host contexts for
+// the other. Viewport and timeout are context-level and deliberately ignored.
+func sameLaunchOptions(a, b *BrowserConfig) bool {
+	return a.Engine == b.Engine &&
+		a.Headless == b.Headless &&
+		a.CDPURL == b.CDPURL &&
+		slices.Equal(a.Args, b.Args)
+}
+
+// BrowserSession represents an active browser session. Browser is shared
+// with other sessions when it came from the pool; each session always owns
+// its Context and every page in it. Page is the active tab, the one all page
+// operations act on; use ActivePage to read it while tabs may be changing.
 type BrowserSession struct {
 	ID        string
 	Browser   playwright.Browser
@@ -128,6 +184,41 @@ type BrowserSession struct {
 	LastUsed  time.Time
 	ExpiresAt time.Time
 	TaskID    string
+	// Profile is the saved profile the session started from, if any
+	Profile string
+
+	// pooled is the pool slot backing Browser, or nil when the session
+	// owns a dedicated browser that must be closed with it.
+	pooled *pooledBrowser
+	// contextOptions are the options Context was created with
+	contextOptions playwright.BrowserNewContextOptions
+	// storageState is the storage state new contexts of the session start
+	// from, kept as JSON so that its IndexedDB survives
+	storageState []byte
+	// device is the device descriptor the context emulates, if any
+	device string
+
+	// tabsMux guards Page and tabs, which change from Playwright event handlers
+	tabsMux   sync.RWMutex
+	tabs      []*sessionTab
+	nextTabID int
+
+	// network records the requests of the session's pages across context
+	// recreation; nil when capture is disabled
+	network *networkLog
+	// console records the console output, uncaught errors and failed
+	// requests of the session's pages; nil when capture is disabled
+	console *consoleLog
+	// routes intercept the requests of the session's pages
+	routes sessionRoutes
+	// storedDownloads are the downloaded files kept elsewhere, such as in
+	// task artifacts, and deleted with the session; guarded by sessionsMux
+	storedDownloads []string
+}
+
+// connected reports whether the session's browser is still usable
+func (s *BrowserSession) connected() bool {
+	return s.Browser == nil || s.Browser.IsConnected()
 }
 
 // BrowserAutomation represents the playwright dependency interface
@@ -141,16 +232,62 @@ type BrowserAutomation interface {
 	// Task-scoped session management
 	GetOrCreateTaskSession(ctx context.Context) (*BrowserSession, error)
 	CloseExpiredSessions(ctx context.Context) error
+	SaveStorageState(ctx context.Context, sessionID, path string) error
+
+	// Saved profiles
+	SaveProfile(ctx context.Context, sessionID, name string) (*Profile, error)
+	ListProfiles(ctx context.Context) ([]Profile, error)
+	DeleteProfile(ctx context.Context, name string) error
+
+	// Device emulation
+	ConfigureBrowser(ctx context.Context, sessionID string, emulation BrowserEmulation) (*EmulationSettings, error)
+	SetProxy(ctx context.Context, sessionID string, change ProxyChange) (*proxy.Settings, error)
 
 	// Page operations
-	NavigateToURL(ctx context.Context, sessionID, url string, waitUntil string, timeout time.Duration) error
+	NavigateToURL(ctx context.Context, sessionID, url string, waitUntil string, timeout time.Duration) (*NavigationResult, error)
+	NavigateHistory(ctx context.Context, sessionID, action, waitUntil string, timeout time.Duration) (*NavigationResult, error)
 	ClickElement(ctx context.Context, sessionID, selector string, options map[string]any) error
-	FillForm(ctx context.Context, sessionID string, fields []map[string]any, submit bool, submitSelector string) error
+	FillForm(ctx context.Context, sessionID string, fields []map[string]any, submit bool, submitSelector string) ([]FieldResult, error)
 	ExtractData(ctx context.Context, sessionID string, extractors []map[string]any, format string) (string, error)
 	TakeScreenshot(ctx context.Context, sessionID, path string, fullPage bool, selector string, format string, quality int) error
 	ExecuteScript(ctx context.Context, sessionID, script string, args []any) (any, error)
-	WaitForCondition(ctx context.Context, sessionID, condition, selector, state string, timeout time.Duration, customFunction string) error
-	HandleAuthentication(ctx context.Context, sessionID, authType, username, password, loginURL string, selectors map[string]string) error
+	WaitForCondition(ctx context.Context, sessionID, condition string, options WaitOptions) (*WaitResult, error)
+	HandleAuthentication(ctx context.Context, sessionID string, options AuthenticationOptions) (*AuthenticationResult, error)
+	GetPageSnapshot(ctx context.Context, sessionID string, options SnapshotOptions) (*PageSnapshot, error)
+	GetPageContent(ctx context.Context, sessionID, selector, frame string, timeout time.Duration) (*PageContent, error)
+	GetNetworkLog(ctx context.Context, sessionID string, filter NetworkFilter) (*NetworkLog, error)
+	GetConsoleLogs(ctx context.Context, sessionID string, filter ConsoleFilter) (*ConsoleLogs, error)
+	WaitForDownload(ctx context.Context, sessionID string, options DownloadOptions) (*Download, error)
+	DownloadStored(ctx context.Context, sessionID, path string) error
+
+	// Keyboard input
+	PressKeys(ctx context.Context, sessionID string, keys []string, options KeyboardOptions) error
+	TypeText(ctx context.Context, sessionID, text string, options KeyboardOptions) error
+
+	// Mouse actions
+	Hover(ctx context.Context, sessionID, selector string, options MouseOptions) error
+	ScrollIntoView(ctx context.Context, sessionID, selector string, options MouseOptions) error
+	Scroll(ctx context.Context, sessionID string, options ScrollOptions) (*ScrollPosition, error)
+	DragAndDrop(ctx context.Context, sessionID, source, target string, options MouseOptions) error
+	ClickAt(ctx context.Context, sessionID string, x, y float64, options ClickAtOptions) error
+
+	// Cookies and web storage
+	GetCookies(ctx context.Context, sessionID string, filter CookieFilter) ([]Cookie, error)
+	SetCookies(ctx context.Context, sessionID string, cookies []Cookie) error
+	ClearCookies(ctx context.Context, sessionID string, filter CookieFilter) (int, error)
+	GetStorage(ctx context.Context, sessionID string, query StorageQuery) (*WebStorage, error)
+	SetStorage(ctx context.Context, sessionID string, update StorageUpdate) (*WebStorage, error)
+
+	// Request interception
+	AddRoute(ctx context.Context, sessionID string, rule RouteRule) (*RouteRule, error)
+	RemoveRoutes(ctx context.Context, sessionID string, ids []string) ([]RouteRule, error)
+	ListRoutes(ctx context.Context, sessionID string) ([]RouteRule, error)
+
+	// Tab management
+	ListTabs(ctx context.Context, sessionID string) ([]Tab, error)
+	SwitchTab(ctx context.Context, sessionID, tabID string) (*Tab, error)
+	OpenTab(ctx context.Context, sessionID, url, waitUntil string, timeout time.Duration) (*Tab, error)
+	CloseTab(ctx context.Context, sessionID, tabID string) (*Tab, error)
 
 	// Service management
 	GetHealth(ctx context.Context) error
@@ -163,16 +300,26 @@ type playwrightImpl struct {
 	logger         *zap.Logger
 	config         *config.Config
 	pw             *playwright.Playwright
+	browserConfig  *BrowserConfig
+	pool           *browserPool
 	sessions       map[string]*BrowserSession
 	sessionsMux    sync.RWMutex
 	sessionTimeout time.Duration
 	isInstalled    bool
 	cleanupStop    chan struct{}
 	cleanupDone    chan struct{}
+
+	// stateCipher encrypts saved storage states; nil when encryption is off
+	stateCipher *stateCipher
+	// proxies picks the proxy of each task's contexts; nil when the service
+	// is used without one, as in tests
+	proxies *proxy.Router
 }
 
-// NewPlaywrightService creates a new instance of BrowserAutomation
-func NewPlaywrightService(logger *zap.Logger, cfg *config.Config) (BrowserAutomation, error) {
+// NewPlaywrightService creates a new instance of BrowserAutomation. Each
+// task's contexts use the proxy proxies assigns to the task, which may be
+// nil to connect directly.
+func NewPlaywrightService(logger *zap.Logger, cfg *config.Config, proxies *proxy.Router) (BrowserAutomation, error) {
 	logger.Info("initializing playwright dependency")
 
 	sessionTimeout := 2 * time.Minute
@@ -187,14 +334,23 @@ func NewPlaywrightService(logger *zap.Logger, cfg *config.Config) (BrowserAutoma
 		}
 	}
 
+	stateCipher, err := newStateCipher(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	service := &playwrightImpl{
 		logger:         logger,
 		config:         cfg,
 		sessions:       make(map[string]*BrowserSession),
 		sessionTimeout: sessionTimeout,
+		stateCipher:    stateCipher,
+		proxies:        proxies,
 		cleanupStop:    make(chan struct{}),
 		cleanupDone:    make(chan struct{}),
 	}
+	service.sweepScratch()
+	service.reencryptStorageStates()
 
 	if err := service.ensurePlaywrightInstalled(); err != nil {
 		return nil, fmt.Errorf("failed to ensure playwright installation: %w", err)
@@ -207,6 +363,7 @@ func NewPlaywrightService(logger *zap.Logger, cfg *config.Config) (BrowserAutoma
 	service.pw = pw
 
 	browserConfig := NewBrowserConfigFromConfig(cfg)
+	service.browserConfig = browserConfig
 
 	if browserConfig.Engine == Lightpanda || browserConfig.CDPURL != "" {
 		browser, err := service.acquireBrowser(browserConfig)
@@ -218,16 +375,28 @@ func NewPlaywrightService(logger *zap.Logger, cfg *config.Config) (BrowserAutoma
 		}
 	}
 
+	// Lightpanda serves a single browser context per CDP connection, so its
+	// pooled connections are recycled after every session.
+	poolMaxContexts := browserConfig.PoolMaxContexts
+	if browserConfig.Engine == Lightpanda {
+		poolMaxContexts = 1
+	}
+	service.pool = newBrowserPool(logger, browserConfig.PoolSize, poolMaxContexts, func() (playwright.Browser, error) {
+		return service.acquireBrowser(browserConfig)
+	})
+
 	logger.Info("playwright service initialized successfully",
 		zap.String("engine", string(browserConfig.Engine)),
 		zap.Bool("headless", browserConfig.Headless),
 		zap.Int("viewport_width", browserConfig.ViewportWidth),
 		zap.Int("viewport_height", browserConfig.ViewportHeight),
+		zap.Int("pool_size", browserConfig.PoolSize),
+		zap.Int("pool_max_contexts", poolMaxContexts),
 		zap.Duration("session_timeout", sessionTimeout))
 
 	go service.sessionCleanupWorker()
 
-	return service, nil
+	return WithCancellation(service), nil
 }
 
 // ensurePlaywrightInstalled checks and installs playwright browsers if needed
@@ -299,7 +468,9 @@ func (p *playwrightImpl) acquireBrowser(config *BrowserConfig) (playwright.Brows
 	return browser, nil
 }
 
-// LaunchBrowser launches a new browser instance with the given configuration
+// LaunchBrowser opens a new session with the given configuration. The
+// browser comes from the shared pool unless config requests different
+// launch options, in which case the session gets a dedicated browser.
 func (p *playwrightImpl) LaunchBrowser(ctx context.Context, config *BrowserConfig) (*BrowserSession, error) {
 	if config == nil {
 		config = NewBrowserConfigFromConfig(p.config)
@@ -309,19 +480,121 @@ func (p *playwrightImpl) LaunchBrowser(ctx context.Context, config *BrowserConfi
 		zap.String("engine", string(config.Engine)),
 		zap.Bool("headless", config.Headless))
 
-	browser, err := p.acquireBrowser(config)
+	sessionID := fmt.Sprintf("session_%d", time.Now().UnixNano())
+	session, err := p.newSession(sessionID, config, "")
 	if err != nil {
 		return nil, err
 	}
 
+	p.sessionsMux.Lock()
+	p.sessions[sessionID] = session
+	p.sessionsMux.Unlock()
+
+	p.logger.Info("browser session created", zap.String("sessionID", sessionID))
+	return session, nil
+}
+
+// newSession creates a fresh context and page for a session, borrowing the
+// browser from the pool when config is compatible with it. The context
+// starts from the storage state at storageState, when set, instead of the
+// shared one.
+func (p *playwrightImpl) newSession(sessionID string, config *BrowserConfig, storageState string) (*BrowserSession, error) {
+	var (
+		browser playwright.Browser
+		pooled  *pooledBrowser
+		err     error
+	)
+
+	if p.pool != nil && p.browserConfig != nil && sameLaunchOptions(config, p.browserConfig) {
+		pooled, err = p.pool.acquire()
+		if err != nil {
+			return nil, err
+		}
+		browser = pooled.browser
+	} else {
+		browser, err = p.acquireBrowser(config)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	contextOptions := p.createContextOptions(config)
+	if p.proxies != nil {
+		contextOptions.Proxy = playwrightProxy(p.proxies.ForTask(sessionID))
+	}
+	if storageState == "" {
+		storageState = storageStatePath(p.config)
+	}
+	state := p.readStorageState(storageState)
+	if state != nil {
+		p.logger.Debug("using existing storage state", zap.String("path", storageState))
+	} else {
+		p.logger.Debug("no usable storage state, creating fresh browser context", zap.String("path", storageState))
+	}
+
+	context, page, err := p.openContext(browser, contextOptions, state)
+	if err != nil {
+		p.releaseBrowser(browser, pooled)
+		return nil, err
+	}
 
+	now := time.Now()
+	session := &BrowserSession{
+		ID:             sessionID,
+		Browser:        browser,
+		Context:        context,
+		Page:           page,
+		Created:        now,
+		LastUsed:       now,
+		ExpiresAt:      now.Add(p.sessionTimeout),
+		pooled:         pooled,
+		contextOptions: contextOptions,
+		storageState:   state,
+		network:        newNetworkLog(config.NetworkLogSize),
+		console:        newConsoleLog(config.ConsoleLogSize),
+	}
+	p.watchContext(session)
+	return session, nil
+}
+
+// watchContext subscribes the session to the events of its current
+// context: pages opening and closing, and network traffic. Request routes
+// added earlier are installed again.
+func (p *playwrightImpl) watchContext(session *BrowserSession) {
+	p.trackTabs(session)
+	p.captureNetwork(session)
+	p.captureConsole(session)
+	if err := p.installRoutes(session); err != nil {
+		p.logger.Warn("failed to restore request routes",
+			zap.String("sessionID", session.ID),
+			zap.Error(err))
+	}
+}
+
+// openContext creates a browser context with its first page, applying the
+// stealth script when enabled. The context starts from state, a storage
+// state as saved by Playwright, when set.
+func (p *playwrightImpl) openContext(browser playwright.Browser, contextOptions playwright.BrowserNewContextOptions, state []byte) (playwright.BrowserContext, playwright.Page, error) {
 	context, err := browser.NewContext(contextOptions)
 	if err != nil {
-		if closeErr := browser.Close(); closeErr != nil {
-			p.logger.Error("failed to close browser after context creation error", zap.Error(closeErr))
+		return nil, nil, fmt.Errorf("failed to create browser context: %w", err)
+	}
+	if state != nil {
+		if err := p.setStorageState(context, state); err != nil {
+			if closeErr := context.Close(); closeErr != nil {
+				p.logger.Error("failed to close context after storage state error", zap.Error(closeErr))
+			}
+			return nil, nil, fmt.Errorf("failed to load storage state: %w", err)
+		}
+	}
+
+	// Injected at context level so new tabs and popups are covered as well
+	if p.config.Browser.StealthMode {
+		if err := context.AddInitScript(playwright.Script{Content: playwright.String(stealth.StealthJS)}); err != nil {
+			p.logger.Warn("failed to inject stealth script", zap.Error(err))
+		} else {
+			p.logger.Info("stealth mode enabled - stealth script injected")
 		}
-		return nil, fmt.Errorf("failed to create browser context: %w", err)
 	}
 
 	page, err := context.NewPage()
@@ -329,42 +602,133 @@ func (p *playwrightImpl) LaunchBrowser(ctx context.Context, config *BrowserConfi
 		if closeErr := context.Close(); closeErr != nil {
 			p.logger.Error("failed to close context after page creation error", zap.Error(closeErr))
 		}
-		if closeErr := browser.Close(); closeErr != nil {
-			p.logger.Error("failed to close browser after page creation error", zap.Error(closeErr))
-		}
-		return nil, fmt.Errorf("failed to create page: %w", err)
+		return nil, nil, fmt.Errorf("failed to create page: %w", err)
 	}
 
-	if p.config.Browser.StealthMode {
-		if err := page.AddInitScript(playwright.Script{Content: playwright.String(stealth.StealthJS)}); err != nil {
-			p.logger.Warn("failed to inject stealth script", zap.Error(err))
-		} else {
-			p.logger.Info("stealth mode enabled - stealth script injected")
+	return context, page, nil
+}
+
+// recreateContext replaces the session's context and tabs with a fresh one
+// built from the session's current context options after apply has modified
+// them. Options such as HTTP credentials can only be set when a context is
+// created, so this is how they are changed on a live session. The new
+// options stick, so a later recreate keeps them. Cookies and storage of the
+// old context are discarded; the new one starts from the session's storage
+// state.
+func (p *playwrightImpl) recreateContext(session *BrowserSession, apply func(*playwright.BrowserNewContextOptions)) error {
+	return p.replaceContext(session, apply, session.storageState)
+}
+
+// replaceContext replaces the session's context like recreateContext, with
+// a new context that starts from state
+func (p *playwrightImpl) replaceContext(session *BrowserSession, apply func(*playwright.BrowserNewContextOptions), state []byte) error {
+	if session.Browser == nil {
+		return fmt.Errorf("session %s has no browser to create a context in", session.ID)
+	}
+
+	contextOptions := session.contextOptions
+	apply(&contextOptions)
+
+	context, page, err := p.openContext(session.Browser, contextOptions, state)
+	if err != nil {
+		return err
+	}
+
+	session.tabsMux.Lock()
+	replaced := session.Context
+	session.Context = context
+	session.Page = page
+	session.contextOptions = contextOptions
+	session.tabsMux.Unlock()
+	p.watchContext(session)
+
+	// Closed after the swap so the old pages' close events find nothing to
+	// switch to
+	if replaced != nil {
+		if err := replaced.Close(); err != nil {
+			p.logger.Warn("failed to close replaced context",
+				zap.String("sessionID", session.ID),
+				zap.Error(err))
 		}
 	}
 
-	sessionID := fmt.Sprintf("session_%d", time.Now().UnixNano())
-	now := time.Now()
-	session := &BrowserSession{
-		ID:        sessionID,
-		Browser:   browser,
-		Context:   context,
-		Page:      page,
-		Created:   now,
-		LastUsed:  now,
-		ExpiresAt: now.Add(p.sessionTimeout),
+	p.logger.Info("browser context recreated", zap.String("sessionID", session.ID))
+	return nil
+}
+
+// rebuildContext recreates the session's context like recreateContext, but
+// carries the cookies, local storage and IndexedDB of the old context over
+// and loads the active tab's page again, so settings can change in the
+// middle of a task. It returns the URL that was loaded again, if any.
+func (p *playwrightImpl) rebuildContext(session *BrowserSession, apply func(*playwright.BrowserNewContextOptions)) (string, error) {
+	// The carried-over state only seeds this context; later recreations
+	// start from the storage state the session was created with
+	state, err := p.captureStorageState(session.Context)
+	if err != nil {
+		return "", fmt.Errorf("failed to keep cookies and storage: %w", err)
+	}
+	reopen := ""
+	if page := session.ActivePage(); pageOrigin(page) != "" {
+		reopen = page.URL()
 	}
 
-	p.sessionsMux.Lock()
-	p.sessions[sessionID] = session
-	p.sessionsMux.Unlock()
+	if err := p.replaceContext(session, apply, state); err != nil {
+		return "", err
+	}
+	return p.reopenPage(session, reopen), nil
+}
 
-	p.logger.Info("browser session created", zap.String("sessionID", sessionID))
-	return session, nil
+// reopenPage loads url in the active tab of a recreated context. It returns
+// url, or an empty string when there was nothing to load or loading failed.
+func (p *playwrightImpl) reopenPage(session *BrowserSession, url string) string {
+	if url == "" {
+		return ""
+	}
+	timeoutMs := float64(reopenTimeout.Milliseconds())
+	if _, err := session.ActivePage().Goto(url, playwright.PageGotoOptions{Timeout: &timeoutMs}); err != nil {
+		p.logger.Warn("failed to reopen page in the recreated context",
+			zap.String("sessionID", session.ID),
+			zap.String("url", url),
+			zap.Error(err))
+		return ""
+	}
+	return url
+}
+
+// releaseBrowser hands a pooled browser back to the pool, or closes a
+// dedicated one.
+func (p *playwrightImpl) releaseBrowser(browser playwright.Browser, pooled *pooledBrowser) {
+	if pooled != nil {
+		p.pool.release(pooled)
+		return
+	}
+	if browser != nil {
+		if err := browser.Close(); err != nil {
+			p.logger.Error("failed to close browser", zap.Error(err))
+		}
+	}
+}
+
+// closeSession closes the session's context and releases its browser. It
+// does not touch p.sessions; callers remove the entry themselves.
+func (p *playwrightImpl) closeSession(session *BrowserSession) {
+	if session.Context != nil {
+		if err := session.Context.Close(); err != nil {
+			p.logger.Error("failed to close context",
+				zap.String("sessionID", session.ID),
+				zap.Error(err))
+		}
+	}
+	p.releaseBrowser(session.Browser, session.pooled)
+	p.removeDownloads(session)
 }
 
 // CloseBrowser closes a browser session
 func (p *playwrightImpl) CloseBrowser(ctx context.Context, sessionID string) error {
+	if p.proxies != nil {
+		p.proxies.Release(sessionID)
+	}
+
 	p.sessionsMux.Lock()
 	defer p.sessionsMux.Unlock()
 
@@ -373,16 +737,7 @@ func (p *playwrightImpl) CloseBrowser(ctx context.Context, sessionID string) err
 		return fmt.Errorf("session not found: %s", sessionID)
 	}
 
-	if session.Context != nil {
-		if err := session.Context.Close(); err != nil {
-			p.logger.Error("failed to close context", zap.Error(err))
-		}
-	}
-	if session.Browser != nil {
-		if err := session.Browser.Close(); err != nil {
-			p.logger.Error("failed to close browser", zap.Error(err))
-		}
-	}
+	p.closeSession(session)
 
 	delete(p.sessions, sessionID)
 	p.logger.Info("browser session closed", zap.String("sessionID", sessionID))
@@ -419,9 +774,10 @@ func (p *playwrightImpl) GetOrCreateTaskSession(ctx context.Context) (*BrowserSe
 	if taskID == "" {
 		return nil, fmt.Errorf("no task ID found in context - cannot create task-scoped session")
 	}
+	profile := ProfileFromContext(ctx)
 
 	p.sessionsMux.RLock()
-	if session, exists := p.sessions[taskID]; exists && !time.Now().After(session.ExpiresAt) {
+	if session, exists := p.sessions[taskID]; exists && !time.Now().After(session.ExpiresAt) && session.connected() && !switchesProfile(session, profile) {
 		session.LastUsed = time.Now()
 		p.sessionsMux.RUnlock()
 		p.logger.Debug("reusing existing task-scoped session", zap.String("sessionID", taskID))
@@ -432,61 +788,42 @@ func (p *playwrightImpl) GetOrCreateTaskSession(ctx context.Context) (*BrowserSe
 	p.sessionsMux.Lock()
 	defer p.sessionsMux.Unlock()
 
-	if session, exists := p.sessions[taskID]; exists && !time.Now().After(session.ExpiresAt) {
-		session.LastUsed = time.Now()
-		p.logger.Debug("reusing existing task-scoped session (double-check)", zap.String("sessionID", taskID))
-		return session, nil
-	}
-
-	p.logger.Info("creating new task-scoped browser session", zap.String("sessionID", taskID))
-
-	config := NewBrowserConfigFromConfig(p.config)
-
-	browser, err := p.acquireBrowser(config)
-	if err != nil {
-		return nil, err
-	}
-
-	contextOptions := p.createContextOptions(config)
-
-	context, err := browser.NewContext(contextOptions)
-	if err != nil {
-		if closeErr := browser.Close(); closeErr != nil {
-			p.logger.Error("failed to close browser after context creation error", zap.Error(closeErr))
-		}
-		return nil, fmt.Errorf("failed to create browser context: %w", err)
-	}
-
-	page, err := context.NewPage()
-	if err != nil {
-		if closeErr := context.Close(); closeErr != nil {
-			p.logger.Error("failed to close context after page creation error", zap.Error(closeErr))
+	if session, exists := p.sessions[taskID]; exists {
+		if !time.Now().After(session.ExpiresAt) && session.connected() {
+			if switchesProfile(session, profile) {
+				if err := p.loadProfile(session, profile); err != nil {
+					return nil, err
+				}
+			}
+			session.LastUsed = time.Now()
+			p.logger.Debug("reusing existing task-scoped session (double-check)", zap.String("sessionID", taskID))
+			return session, nil
 		}
-		if closeErr := browser.Close(); closeErr != nil {
-			p.logger.Error("failed to close browser after page creation error", zap.Error(closeErr))
+		p.logger.Info("replacing stale task-scoped session",
+			zap.String("sessionID", taskID),
+			zap.Bool("browserConnected", session.connected()))
+		p.closeSession(session)
+		delete(p.sessions, taskID)
+	}
+
+	var storageState string
+	if profile != "" {
+		var err error
+		if storageState, err = p.profileStorageState(profile); err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("failed to create page: %w", err)
 	}
 
-	if p.config.Browser.StealthMode {
-		if err := page.AddInitScript(playwright.Script{Content: playwright.String(stealth.StealthJS)}); err != nil {
-			p.logger.Warn("failed to inject stealth script", zap.Error(err))
-		} else {
-			p.logger.Info("stealth mode enabled - stealth script injected")
-		}
-	}
+	p.logger.Info("creating new task-scoped browser session",
+		zap.String("sessionID", taskID),
+		zap.String("profile", profile))
 
-	now := time.Now()
-	session := &BrowserSession{
-		ID:        taskID,
-		Browser:   browser,
-		Context:   context,
-		Page:      page,
-		Created:   now,
-		LastUsed:  now,
-		ExpiresAt: now.Add(p.sessionTimeout),
-		TaskID:    taskID,
+	session, err := p.newSession(taskID, NewBrowserConfigFromConfig(p.config), storageState)
+	if err != nil {
+		return nil, err
 	}
+	session.TaskID = taskID
+	session.Profile = profile
 
 	p.sessions[taskID] = session
 
@@ -516,22 +853,12 @@ func (p *playwrightImpl) CloseExpiredSessions(ctx context.Context) error {
 			zap.String("sessionID", sessionID),
 			zap.Time("expiredAt", session.ExpiresAt))
 
-		if session.Context != nil {
-			if err := session.Context.Close(); err != nil {
-				p.logger.Error("failed to close expired session context",
-					zap.String("sessionID", sessionID),
-					zap.Error(err))
-			}
-		}
-		if session.Browser != nil {
-			if err := session.Browser.Close(); err != nil {
-				p.logger.Error("failed to close expired session browser",
-					zap.String("sessionID", sessionID),
-					zap.Error(err))
-			}
-		}
+		p.closeSession(session)
 
 		delete(p.sessions, sessionID)
+		if p.proxies != nil {
+			p.proxies.Release(sessionID)
+		}
 	}
 
 	if len(expiredSessions) > 0 {
@@ -567,32 +894,16 @@ func (p *playwrightImpl) sessionCleanupWorker() {
 	}
 }
 
-// NavigateToURL navigates to a URL in the specified session
-func (p *playwrightImpl) NavigateToURL(ctx context.Context, sessionID, url string, waitUntil string, timeout time.Duration) error {
-	session, err := p.GetSession(sessionID)
-	if err != nil {
-		return err
-	}
-
-	var waitOption *playwright.WaitUntilState
+// waitUntilState maps a wait_until value to Playwright's state, defaulting to load
+func waitUntilState(waitUntil string) *playwright.WaitUntilState {
 	switch waitUntil {
 	case "domcontentloaded":
-		waitOption = playwright.WaitUntilStateDomcontentloaded
+		return playwright.WaitUntilStateDomcontentloaded
 	case "networkidle":
-		waitOption = playwright.WaitUntilStateNetworkidle
+		return playwright.WaitUntilStateNetworkidle
 	default:
-		waitOption = playwright.WaitUntilStateLoad
-	}
-
-	timeoutMs := float64(timeout.Milliseconds())
-	options := playwright.PageGotoOptions{
-		WaitUntil: waitOption,
-		Timeout:   &timeoutMs,
+		return playwright.WaitUntilStateLoad
 	}
-
-	p.logger.Info("navigating to URL", zap.String("sessionID", sessionID), zap.String("url", url))
-	_, err = session.Page.Goto(url, options)
-	return err
 }
 
 // ClickElement clicks an element in the specified session
@@ -625,8 +936,14 @@ func (p *playwrightImpl) ClickElement(ctx context.Context, sessionID, selector s
 		}
 	}
 
+	frame, _ := options["frame"].(string)
+	locator, err := p.locate(session.ActivePage(), frame, selector)
+	if err != nil {
+		return err
+	}
+
 	p.logger.Info("clicking element", zap.String("sessionID", sessionID), zap.String("selector", selector))
-	return session.Page.Locator(selector).Click(playwright.LocatorClickOptions{
+	return locator.Click(playwright.LocatorClickOptions{
 		Timeout:    clickOptions.Timeout,
 		Force:      clickOptions.Force,
 		ClickCount: clickOptions.ClickCount,
@@ -634,58 +951,85 @@ func (p *playwrightImpl) ClickElement(ctx context.Context, sessionID, selector s
 	})
 }
 
-// FillForm fills form fields in the specified session
-func (p *playwrightImpl) FillForm(ctx context.Context, sessionID string, fields []map[string]any, submit bool, submitSelector string) error {
+// FillForm fills form fields in the specified session. Each field may name
+// the frame it lives in under "frame"; the submit button is looked up in the
+// frame of the last field. Select fields take their options under "values",
+// matched as "option_by" says, and report the options they end up with.
+func (p *playwrightImpl) FillForm(ctx context.Context, sessionID string, fields []map[string]any, submit bool, submitSelector string) ([]FieldResult, error) {
 	session, err := p.GetSession(sessionID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	p.logger.Info("filling form", zap.String("sessionID", sessionID), zap.Int("fields", len(fields)))
 
+	page := session.ActivePage()
+	frame := ""
+	results := make([]FieldResult, 0, len(fields))
 	for _, field := range fields {
 		selector, ok := field["selector"].(string)
 		if !ok {
-			return fmt.Errorf("field selector is required")
+			return nil, fmt.Errorf("field selector is required")
 		}
 
+		fieldType, _ := field["type"].(string)
+		values, hasValues := field["values"].([]string)
 		value, ok := field["value"].(string)
-		if !ok {
-			return fmt.Errorf("field value is required")
+		if !ok && fieldType != "file" && !(fieldType == "select" && hasValues) {
+			return nil, fmt.Errorf("field value is required")
 		}
 
-		fieldType, _ := field["type"].(string)
+		frame, _ = field["frame"].(string)
+
+		locator, err := p.locate(page, frame, selector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fill field %s: %w", selector, err)
+		}
 
+		result := FieldResult{Selector: selector, Type: fieldType}
 		switch fieldType {
 		case "select":
-			_, err = session.Page.Locator(selector).SelectOption(playwright.SelectOptionValues{Values: &[]string{value}}, playwright.LocatorSelectOptionOptions{})
+			if !hasValues {
+				values = []string{value}
+			}
+			optionBy, _ := field["option_by"].(string)
+			multiple, _ := field["multiple"].(bool)
+			result.Selected, err = p.selectOptions(page, frame, locator, values, optionBy, multiple)
 		case "checkbox", "radio":
 			if value == "true" || value == "1" {
-				err = session.Page.Locator(selector).Check()
+				err = locator.Check()
 			} else {
-				err = session.Page.Locator(selector).Uncheck()
+				err = locator.Uncheck()
 			}
+		case "file":
+			files, _ := field["files"].([]UploadFile)
+			err = setInputFiles(page, locator, files)
 		default:
-			err = session.Page.Locator(selector).Fill(value)
+			err = locator.Fill(value)
 		}
 
 		if err != nil {
-			return fmt.Errorf("failed to fill field %s: %w", selector, err)
+			return nil, fmt.Errorf("failed to fill field %s: %w", selector, err)
 		}
+		results = append(results, result)
 	}
 
 	if submit && submitSelector != "" {
 		p.logger.Info("submitting form", zap.String("sessionID", sessionID), zap.String("submitSelector", submitSelector))
-		err = session.Page.Locator(submitSelector).Click()
+		locator, err := p.locate(page, frame, submitSelector)
+		if err == nil {
+			err = locator.Click()
+		}
 		if err != nil {
-			return fmt.Errorf("failed to submit form: %w", err)
+			return nil, fmt.Errorf("failed to submit form: %w", err)
 		}
 	}
 
-	return nil
+	return results, nil
 }
 
-// ExtractData extracts data from the page using selectors
+// ExtractData extracts data from the page using selectors. An extractor may
+// name the frame to read from under "frame".
 func (p *playwrightImpl) ExtractData(ctx context.Context, sessionID string, extractors []map[string]any, format string) (string, error) {
 	session, err := p.GetSession(sessionID)
 	if err != nil {
@@ -695,6 +1039,7 @@ func (p *playwrightImpl) ExtractData(ctx context.Context, sessionID string, extr
 	p.logger.Info("extracting data", zap.String("sessionID", sessionID), zap.Int("extractors", len(extractors)))
 
 	results := make(map[string]any)
+	page := session.ActivePage()
 
 	for _, extractor := range extractors {
 		name, ok := extractor["name"].(string)
@@ -713,9 +1058,14 @@ func (p *playwrightImpl) ExtractData(ctx context.Context, sessionID string, extr
 		}
 
 		multiple, _ := extractor["multiple"].(bool)
+		frame, _ := extractor["frame"].(string)
+
+		locator, err := p.locate(page, frame, selector)
+		if err != nil {
+			return "", fmt.Errorf("failed to extract %s: %w", name, err)
+		}
 
 		if multiple {
-			locator := session.Page.Locator(selector)
 			count, err := locator.Count()
 			if err != nil {
 				return "", fmt.Errorf("failed to count elements for %s: %w", name, err)
@@ -736,7 +1086,7 @@ func (p *playwrightImpl) ExtractData(ctx context.Context, sessionID string, extr
 			}
 			results[name] = values
 		} else {
-			locator := session.Page.Locator(selector).First()
+			locator = locator.First()
 			var value any
 			if attribute == "text" {
 				value, err = locator.InnerText()
@@ -788,7 +1138,7 @@ func (p *playwrightImpl) TakeScreenshot(ctx context.Context, sessionID, path str
 	}
 
 	if selector != "" {
-		locator := session.Page.Locator(selector)
+		locator := session.ActivePage().Locator(selector)
 		_, err = locator.Screenshot(playwright.LocatorScreenshotOptions{
 			Path: &path,
 			Type: options.Type,
@@ -796,7 +1146,7 @@ func (p *playwrightImpl) TakeScreenshot(ctx context.Context, sessionID, path str
 		return err
 	}
 
-	_, err = session.Page.Screenshot(options)
+	_, err = session.ActivePage().Screenshot(options)
 	return err
 }
 
@@ -809,7 +1159,7 @@ func (p *playwrightImpl) ExecuteScript(ctx context.Context, sessionID, script st
 
 	p.logger.Info("executing script", zap.String("sessionID", sessionID))
 
-	result, err := session.Page.Evaluate(script, args...)
+	result, err := session.ActivePage().Evaluate(script, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute script: %w", err)
 	}
@@ -817,131 +1167,6 @@ func (p *playwrightImpl) ExecuteScript(ctx context.Context, sessionID, script st
 	return result, nil
 }
 
-// WaitForCondition waits for specific conditions
-func (p *playwrightImpl) WaitForCondition(ctx context.Context, sessionID, condition, selector, state string, timeout time.Duration, customFunction string) error {
-	session, err := p.GetSession(sessionID)
-	if err != nil {
-		return err
-	}
-
-	p.logger.Info("waiting for condition", zap.String("sessionID", sessionID), zap.String("condition", condition))
-
-	switch condition {
-	case "selector":
-		var waitState *playwright.WaitForSelectorState
-		switch state {
-		case "hidden":
-			waitState = playwright.WaitForSelectorStateHidden
-		case "attached":
-			waitState = playwright.WaitForSelectorStateAttached
-		case "detached":
-			waitState = playwright.WaitForSelectorStateDetached
-		default:
-			waitState = playwright.WaitForSelectorStateVisible
-		}
-
-		timeoutMs := float64(timeout.Milliseconds())
-		options := playwright.LocatorWaitForOptions{
-			State:   waitState,
-			Timeout: &timeoutMs,
-		}
-
-		err = session.Page.Locator(selector).WaitFor(options)
-		return err
-
-	case "navigation":
-		time.Sleep(timeout)
-		return nil
-
-	case "function":
-		if customFunction == "" {
-			return fmt.Errorf("custom function is required for function condition")
-		}
-
-		timeoutMs := float64(timeout.Milliseconds())
-		options := playwright.PageWaitForFunctionOptions{
-			Timeout: &timeoutMs,
-		}
-
-		_, err = session.Page.WaitForFunction(customFunction, options)
-		return err
-
-	case "timeout":
-		time.Sleep(timeout)
-		return nil
-
-	case "networkidle":
-		timeoutMs := float64(timeout.Milliseconds())
-		return session.Page.WaitForLoadState(playwright.PageWaitForLoadStateOptions{
-			State:   playwright.LoadStateNetworkidle,
-			Timeout: &timeoutMs,
-		})
-
-	default:
-		return fmt.Errorf("unsupported condition type: %s", condition)
-	}
-}
-
-// HandleAuthentication handles various authentication scenarios
-func (p *playwrightImpl) HandleAuthentication(ctx context.Context, sessionID, authType, username, password, loginURL string, selectors map[string]string) error {
-	session, err := p.GetSession(sessionID)
-	if err != nil {
-		return err
-	}
-
-	p.logger.Info("handling authentication", zap.String("sessionID", sessionID), zap.String("type", authType))
-
-	switch authType {
-	case "basic":
-		if loginURL != "" {
-			_, err = session.Page.Goto(loginURL)
-			return err
-		}
-		return fmt.Errorf("basic auth requires loginURL")
-
-	case "form":
-		if loginURL != "" {
-			_, err = session.Page.Goto(loginURL)
-			if err != nil {
-				return fmt.Errorf("failed to navigate to login URL: %w", err)
-			}
-		}
-
-		if usernameSelector, ok := selectors["username_selector"]; ok && usernameSelector != "" {
-			err = session.Page.Locator(usernameSelector).Fill(username)
-			if err != nil {
-				return fmt.Errorf("failed to fill username: %w", err)
-			}
-		}
-
-		if passwordSelector, ok := selectors["password_selector"]; ok && passwordSelector != "" {
-			err = session.Page.Locator(passwordSelector).Fill(password)
-			if err != nil {
-				return fmt.Errorf("failed to fill password: %w", err)
-			}
-		}
-
-		if submitSelector, ok := selectors["submit_selector"]; ok && submitSelector != "" {
-			err = session.Page.Locator(submitSelector).Click()
-			if err != nil {
-				return fmt.Errorf("failed to submit form: %w", err)
-			}
-		}
-
-		return nil
-
-	case "oauth":
-		if loginURL != "" {
-			_, err = session.Page.Goto(loginURL)
-			return err
-		}
-		return fmt.Errorf("OAuth implementation not yet supported")
-
-	default:
-		return fmt.Errorf("unsupported authentication type: %s", authType)
-	}
-}
-
 // GetHealth checks the health of the service
 func (p *playwrightImpl) GetHealth(ctx context.Context) error {
 	if p.pw == nil {
@@ -952,7 +1177,16 @@ func (p *playwrightImpl) GetHealth(ctx context.Context) error {
 	activeSessions := len(p.sessions)
 	p.sessionsMux.RUnlock()
 
-	p.logger.Info("playwright service health check", zap.Int("activeSessions", activeSessions))
+	fields := []zap.Field{zap.Int("activeSessions", activeSessions)}
+	if p.pool != nil {
+		stats := p.pool.stats()
+		fields = append(fields,
+			zap.Int("pooledBrowsers", stats.Browsers),
+			zap.Int("retiringBrowsers", stats.Retiring),
+			zap.Int("pooledContexts", stats.ActiveContexts))
+	}
+
+	p.logger.Info("playwright service health check", fields...)
 	return nil
 }
 
@@ -969,23 +1203,18 @@ func (p *playwrightImpl) Shutdown(ctx context.Context) error {
 	}
 
 	p.sessionsMux.Lock()
-	for sessionID := range p.sessions {
-		if session := p.sessions[sessionID]; session != nil {
-			if session.Context != nil {
-				if err := session.Context.Close(); err != nil {
-					p.logger.Error("failed to close context during shutdown", zap.Error(err))
-				}
-			}
-			if session.Browser != nil {
-				if err := session.Browser.Close(); err != nil {
-					p.logger.Error("failed to close browser during shutdown", zap.Error(err))
-				}
-			}
+	for _, session := range p.sessions {
+		if session != nil {
+			p.closeSession(session)
 		}
 	}
 	p.sessions = make(map[string]*BrowserSession)
 	p.sessionsMux.Unlock()
 
+	if p.pool != nil {
+		p.pool.close()
+	}
+
 	if p.pw != nil {
 		err := p.pw.Stop()
 		if err != nil {
@@ -1019,21 +1248,14 @@ func (p *playwrightImpl) createContextOptions(browserConfig *BrowserConfig) play
 			"Connection":                p.config.Browser.HeaderConnection,
 			"Upgrade-Insecure-Requests": p.config.Browser.HeaderUpgradeInsecureRequests,
 		},
-		AcceptDownloads:   playwright.Bool(false),
+		AcceptDownloads:   playwright.Bool(true),
 		JavaScriptEnabled: playwright.Bool(true),
 		BypassCSP:         playwright.Bool(true),
 	}
 
 	if browserConfig.Engine == Lightpanda {
 		contextOptions.BypassCSP = nil
-	}
-
-	storagePath := p.config.Browser.DataDir + "/browser-state"
-	if _, err := os.Stat(storagePath); err == nil {
-		contextOptions.StorageStatePath = &storagePath
-		p.logger.Debug("using existing storage state", zap.String("path", storagePath))
-	} else {
-		p.logger.Debug("storage state file not found, creating fresh browser context", zap.String("path", storagePath))
+		contextOptions.AcceptDownloads = nil
 	}
 
 	return contextOptions
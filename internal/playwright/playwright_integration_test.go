@@ -62,9 +62,9 @@ func TestPlaywrightServiceWithMock(t *testing.T) {
 				waitUntil := "load"
 				timeout := 30 * time.Second
 
-				mockService.NavigateToURLReturns(nil)
+				mockService.NavigateToURLReturns(&playwright.NavigationResult{}, nil)
 
-				err := mockService.NavigateToURL(ctx, sessionID, url, waitUntil, timeout)
+				_, err := mockService.NavigateToURL(ctx, sessionID, url, waitUntil, timeout)
 				if err != nil {
 					t.Fatalf("Expected no error, got: %v", err)
 				}
@@ -148,9 +148,9 @@ func TestPlaywrightServiceWithMock(t *testing.T) {
 				submit := true
 				submitSelector := "button[type='submit']"
 
-				mockService.FillFormReturns(nil)
+				mockService.FillFormReturns(nil, nil)
 
-				err := mockService.FillForm(ctx, sessionID, fields, submit, submitSelector)
+				_, err := mockService.FillForm(ctx, sessionID, fields, submit, submitSelector)
 				if err != nil {
 					t.Fatalf("Expected no error, got: %v", err)
 				}
@@ -381,7 +381,7 @@ func TestPlaywrightServiceIntegrationWithMock(t *testing.T) {
 	}
 
 	mockService.LaunchBrowserReturns(session, nil)
-	mockService.NavigateToURLReturns(nil)
+	mockService.NavigateToURLReturns(&playwright.NavigationResult{}, nil)
 	mockService.TakeScreenshotReturns(nil)
 	mockService.ExecuteScriptReturns("Example Domain", nil)
 	mockService.ExtractDataReturns(`{"title": "Example Domain"}`, nil)
@@ -404,7 +404,7 @@ func TestPlaywrightServiceIntegrationWithMock(t *testing.T) {
 		return
 	}
 
-	err = mockService.NavigateToURL(ctx, session.ID, "https://example.com", "load", 30*time.Second)
+	_, err = mockService.NavigateToURL(ctx, session.ID, "https://example.com", "load", 30*time.Second)
 	if err != nil {
 		t.Fatalf("Failed to navigate to URL: %v", err)
 	}
@@ -478,9 +478,9 @@ func TestPlaywrightServiceErrorHandlingWithMock(t *testing.T) {
 	})
 
 	t.Run("NavigateToURL returns error", func(t *testing.T) {
-		mockService.NavigateToURLReturns(&testError{"navigation failed"})
+		mockService.NavigateToURLReturns(nil, &testError{"navigation failed"})
 
-		err := mockService.NavigateToURL(ctx, "session-id", "https://example.com", "load", 30*time.Second)
+		_, err := mockService.NavigateToURL(ctx, "session-id", "https://example.com", "load", 30*time.Second)
 		if err == nil {
 			t.Fatal("Expected error, got nil")
 		}
@@ -523,7 +523,7 @@ func TestMultipleBrowserSessionsWithMock(t *testing.T) {
 
 	mockService.LaunchBrowserReturnsOnCall(0, session1, nil)
 	mockService.LaunchBrowserReturnsOnCall(1, session2, nil)
-	mockService.NavigateToURLReturns(nil)
+	mockService.NavigateToURLReturns(&playwright.NavigationResult{}, nil)
 	mockService.CloseBrowserReturns(nil)
 
 	config1 := playwright.DefaultBrowserConfig()
@@ -550,12 +550,12 @@ func TestMultipleBrowserSessionsWithMock(t *testing.T) {
 		t.Errorf("Expected session ID 'firefox-session', got %s", launchedSession2.ID)
 	}
 
-	err = mockService.NavigateToURL(ctx, session1.ID, "https://example.com", "load", 30*time.Second)
+	_, err = mockService.NavigateToURL(ctx, session1.ID, "https://example.com", "load", 30*time.Second)
 	if err != nil {
 		t.Fatalf("Failed to navigate in session 1: %v", err)
 	}
 
-	err = mockService.NavigateToURL(ctx, session2.ID, "https://httpbin.org", "load", 30*time.Second)
+	_, err = mockService.NavigateToURL(ctx, session2.ID, "https://httpbin.org", "load", 30*time.Second)
 	if err != nil {
 		t.Fatalf("Failed to navigate in session 2: %v", err)
 	}
@@ -595,8 +595,8 @@ func TestPlaywrightFormFillingWithMock(t *testing.T) {
 	}
 
 	mockService.LaunchBrowserReturns(session, nil)
-	mockService.NavigateToURLReturns(nil)
-	mockService.FillFormReturns(nil)
+	mockService.NavigateToURLReturns(&playwright.NavigationResult{}, nil)
+	mockService.FillFormReturns(nil, nil)
 	mockService.ClickElementReturns(nil)
 	mockService.CloseBrowserReturns(nil)
 
@@ -610,7 +610,7 @@ func TestPlaywrightFormFillingWithMock(t *testing.T) {
 		t.Errorf("Expected session ID 'form-test-session', got %s", launchedSession.ID)
 	}
 
-	err = mockService.NavigateToURL(ctx, session.ID, "https://httpbin.org/forms/post", "load", 30*time.Second)
+	_, err = mockService.NavigateToURL(ctx, session.ID, "https://httpbin.org/forms/post", "load", 30*time.Second)
 	if err != nil {
 		t.Fatalf("Failed to navigate to forms page: %v", err)
 	}
@@ -633,7 +633,7 @@ func TestPlaywrightFormFillingWithMock(t *testing.T) {
 		},
 	}
 
-	err = mockService.FillForm(ctx, session.ID, fields, false, "")
+	_, err = mockService.FillForm(ctx, session.ID, fields, false, "")
 	if err != nil {
 		t.Fatalf("Failed to fill form: %v", err)
 	}
@@ -705,7 +705,7 @@ func TestFullWorkflowWithMock(t *testing.T) {
 	}
 
 	mockService.LaunchBrowserReturns(session, nil)
-	mockService.NavigateToURLReturns(nil)
+	mockService.NavigateToURLReturns(&playwright.NavigationResult{}, nil)
 	mockService.TakeScreenshotReturns(nil)
 	mockService.ExecuteScriptReturns("Example Domain", nil)
 	mockService.ExtractDataReturns(`{"title": "Example Domain"}`, nil)
@@ -728,7 +728,7 @@ func TestFullWorkflowWithMock(t *testing.T) {
 		return
 	}
 
-	err = mockService.NavigateToURL(ctx, session.ID, "https://example.com", "load", 30*time.Second)
+	_, err = mockService.NavigateToURL(ctx, session.ID, "https://example.com", "load", 30*time.Second)
 	if err != nil {
 		t.Fatalf("Failed to navigate to URL: %v", err)
 	}
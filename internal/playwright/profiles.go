@@ -0,0 +1,304 @@
+package playwright
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+// profilesDirName is the directory under the data directory that holds the
+// named profiles
+const profilesDirName = "profiles"
+
+// profileExtension is the file extension of saved profiles
+const profileExtension = ".json"
+
+// profileNamePattern keeps profile names usable as file names
+var profileNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+
+// SensitivePaths are the files and directories that hold the credential
+// vault, the state encryption key and saved browser state. Tools that read
+// local files on the agent's behalf refuse them.
+func SensitivePaths(cfg *config.Config) []string {
+	paths := []string{
+		storageStatePath(cfg),
+		filepath.Join(cfg.Browser.DataDir, profilesDirName),
+		filepath.Join(cfg.Browser.DataDir, scratchDirName),
+	}
+	for _, path := range []string{cfg.Browser.CredentialsPath, cfg.Browser.StateEncryptionKeyFile} {
+		if path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// Profile is a saved storage state: the cookies, local storage and
+// IndexedDB of a session, which new task sessions can start from
+type Profile struct {
+	Name    string    `json:"name"`
+	SavedAt time.Time `json:"saved_at"`
+	Size    int64     `json:"size"`
+}
+
+// profileContextKey carries the profile a task session is started from
+type profileContextKey struct{}
+
+// WithProfile returns a context that makes GetOrCreateTaskSession start a
+// new task session from the named profile
+func WithProfile(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, profileContextKey{}, name)
+}
+
+// ProfileFromContext returns the profile set with WithProfile, if any
+func ProfileFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(profileContextKey{}).(string)
+	return name
+}
+
+// ValidateProfileName checks that name can be used as a profile name
+func ValidateProfileName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q: use up to 64 letters, digits, dots, dashes and underscores, starting with a letter or digit", name)
+	}
+	return nil
+}
+
+// profilePath returns the file the named profile is saved in
+func (p *playwrightImpl) profilePath(name string) string {
+	return filepath.Join(p.config.Browser.DataDir, profilesDirName, name+profileExtension)
+}
+
+// profileStorageState returns the file to start a new session of the named
+// profile from, or an error when there is no such profile
+func (p *playwrightImpl) profileStorageState(name string) (string, error) {
+	if err := ValidateProfileName(name); err != nil {
+		return "", err
+	}
+	path := p.profilePath(name)
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("profile %q not found", name)
+		}
+		return "", fmt.Errorf("failed to read profile %q: %w", name, err)
+	}
+	return path, nil
+}
+
+// switchesProfile reports whether a live task session is asked for a
+// profile it was not started from
+func switchesProfile(session *BrowserSession, profile string) bool {
+	return profile != "" && profile != session.Profile
+}
+
+// loadProfile restarts a live session from the named profile, as a profile
+// can only be loaded into a new context. The session's cookies and storage
+// are replaced by the profile's and the active tab's page is loaded again;
+// other tabs are lost.
+func (p *playwrightImpl) loadProfile(session *BrowserSession, name string) error {
+	path, err := p.profileStorageState(name)
+	if err != nil {
+		return err
+	}
+	state := p.readStorageState(path)
+	if state == nil {
+		return fmt.Errorf("failed to read profile %q", name)
+	}
+	reopen := ""
+	if page := session.ActivePage(); pageOrigin(page) != "" {
+		reopen = page.URL()
+	}
+
+	if err := p.replaceContext(session, func(*playwright.BrowserNewContextOptions) {}, state); err != nil {
+		return fmt.Errorf("failed to load profile %q: %w", name, err)
+	}
+	session.storageState = state
+	session.Profile = name
+	p.reopenPage(session, reopen)
+	p.logger.Info("loaded profile into live session",
+		zap.String("sessionID", session.ID),
+		zap.String("profile", name))
+	return nil
+}
+
+// Playwright only reads and writes the IndexedDB of a storage state through
+// files, so states go through scratch files that are removed again. They
+// hold the state in plain JSON, so they are kept in a directory only the
+// agent can read, and any a crash left behind are deleted at startup.
+
+// scratchDirName is the directory under the data directory that holds
+// storage states on their way to and from Playwright
+const scratchDirName = "scratch"
+
+// scratchPattern names the scratch files of storage states
+const scratchPattern = ".storage-state-*"
+
+// scratchDir returns the private scratch directory, creating it
+func (p *playwrightImpl) scratchDir() (string, error) {
+	dir := filepath.Join(p.config.Browser.DataDir, scratchDirName)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create scratch directory: %w", err)
+	}
+	// MkdirAll leaves the mode of an existing directory as it is
+	if err := os.Chmod(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to restrict scratch directory: %w", err)
+	}
+	return dir, nil
+}
+
+// sweepScratch deletes the scratch files a crash or kill left behind
+func (p *playwrightImpl) sweepScratch() {
+	stale, _ := filepath.Glob(filepath.Join(p.config.Browser.DataDir, scratchDirName, scratchPattern))
+	for _, path := range stale {
+		if err := os.Remove(path); err != nil {
+			p.logger.Warn("failed to remove stale storage state scratch file", zap.String("path", path), zap.Error(err))
+			continue
+		}
+		p.logger.Info("removed stale storage state scratch file", zap.String("path", path))
+	}
+}
+
+// captureStorageState returns the cookies, local storage and IndexedDB of
+// context as JSON
+func (p *playwrightImpl) captureStorageState(context playwright.BrowserContext) ([]byte, error) {
+	dir, err := p.scratchDir()
+	if err != nil {
+		return nil, err
+	}
+	scratch, err := os.CreateTemp(dir, scratchPattern)
+	if err != nil {
+		return nil, err
+	}
+	scratchPath := scratch.Name()
+	_ = scratch.Close()
+	defer func() { _ = os.Remove(scratchPath) }()
+
+	if _, err := context.StorageState(playwright.BrowserContextStorageStateOptions{
+		IndexedDB: playwright.Bool(true),
+		Path:      &scratchPath,
+	}); err != nil {
+		return nil, err
+	}
+	return os.ReadFile(scratchPath)
+}
+
+// setStorageState replaces the cookies, local storage and IndexedDB of
+// context with state
+func (p *playwrightImpl) setStorageState(context playwright.BrowserContext, state []byte) error {
+	dir, err := p.scratchDir()
+	if err != nil {
+		return err
+	}
+	scratch, err := os.CreateTemp(dir, scratchPattern)
+	if err != nil {
+		return err
+	}
+	scratchPath := scratch.Name()
+	defer func() { _ = os.Remove(scratchPath) }()
+	_, err = scratch.Write(state)
+	if closeErr := scratch.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return context.SetStorageState(scratchPath)
+}
+
+// SaveStorageState writes the cookies, local storage and IndexedDB of a
+// session to path, for new contexts to be created with. The file is
+// replaced in one step, encrypted when a state encryption key is
+// configured, and only readable by the agent, as it holds live session
+// cookies.
+func (p *playwrightImpl) SaveStorageState(ctx context.Context, sessionID, path string) error {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create storage state directory: %w", err)
+	}
+
+	state, err := p.captureStorageState(session.Context)
+	if err != nil {
+		return fmt.Errorf("failed to save storage state: %w", err)
+	}
+	if err := p.writeStorageState(path, state); err != nil {
+		return fmt.Errorf("failed to save storage state: %w", err)
+	}
+	p.logger.Info("saved storage state",
+		zap.String("sessionID", sessionID),
+		zap.String("path", path),
+		zap.Bool("encrypted", p.stateCipher != nil))
+	return nil
+}
+
+// SaveProfile saves the session's cookies, local storage and IndexedDB as
+// the named profile, replacing a profile of the same name
+func (p *playwrightImpl) SaveProfile(ctx context.Context, sessionID, name string) (*Profile, error) {
+	if err := ValidateProfileName(name); err != nil {
+		return nil, err
+	}
+	path := p.profilePath(name)
+	if err := p.SaveStorageState(ctx, sessionID, path); err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read saved profile: %w", err)
+	}
+	p.logger.Info("saved profile", zap.String("sessionID", sessionID), zap.String("profile", name))
+	return &Profile{Name: name, SavedAt: info.ModTime(), Size: info.Size()}, nil
+}
+
+// ListProfiles returns the saved profiles, sorted by name
+func (p *playwrightImpl) ListProfiles(ctx context.Context) ([]Profile, error) {
+	entries, err := os.ReadDir(filepath.Join(p.config.Browser.DataDir, profilesDirName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []Profile{}, nil
+		}
+		return nil, fmt.Errorf("failed to list profiles: %w", err)
+	}
+
+	profiles := []Profile{}
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), profileExtension)
+		if !ok || entry.IsDir() || ValidateProfileName(name) != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		profiles = append(profiles, Profile{Name: name, SavedAt: info.ModTime(), Size: info.Size()})
+	}
+	slices.SortFunc(profiles, func(a, b Profile) int { return strings.Compare(a.Name, b.Name) })
+	return profiles, nil
+}
+
+// DeleteProfile removes the named profile. Sessions already started from
+// it are not affected.
+func (p *playwrightImpl) DeleteProfile(ctx context.Context, name string) error {
+	path, err := p.profileStorageState(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("failed to delete profile %q: %w", name, err)
+	}
+	p.logger.Info("deleted profile", zap.String("profile", name))
+	return nil
+}
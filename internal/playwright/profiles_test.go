@@ -0,0 +1,238 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestSensitivePaths(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Browser.DataDir = "/data"
+	assert.ElementsMatch(t, []string{"/data/browser-state", "/data/profiles", "/data/scratch"}, SensitivePaths(cfg))
+
+	cfg.Browser.CredentialsPath = "/etc/agent/vault.json"
+	cfg.Browser.StateEncryptionKeyFile = "/etc/agent/state.key"
+	assert.ElementsMatch(t, []string{
+		"/data/browser-state",
+		"/data/profiles",
+		"/data/scratch",
+		"/etc/agent/vault.json",
+		"/etc/agent/state.key",
+	}, SensitivePaths(cfg))
+}
+
+func TestScratchDir(t *testing.T) {
+	dataDir := t.TempDir()
+	p := &playwrightImpl{logger: zap.NewNop(), config: &config.Config{Browser: config.BrowserConfig{DataDir: dataDir}}}
+	require.NoError(t, os.MkdirAll(filepath.Join(dataDir, scratchDirName), 0755))
+
+	dir, err := p.scratchDir()
+	require.NoError(t, err)
+	info, err := os.Stat(dir)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0700), info.Mode().Perm(), "an existing directory is made private")
+
+	stale := filepath.Join(dir, ".storage-state-123")
+	require.NoError(t, os.WriteFile(stale, []byte(`{"cookies":[]}`), 0600))
+	other := filepath.Join(dir, "notes.txt")
+	require.NoError(t, os.WriteFile(other, nil, 0600))
+	p.sweepScratch()
+	assert.NoFileExists(t, stale, "scratch files left by a crash are removed at startup")
+	assert.FileExists(t, other)
+}
+
+func TestValidateProfileName(t *testing.T) {
+	for _, name := range []string{"shop-admin", "support_2", "v1.2", "A"} {
+		assert.NoError(t, ValidateProfileName(name), name)
+	}
+	for _, name := range []string{"", ".hidden", "-flag", "a/b", "../secrets", "with space", string(make([]byte, 65))} {
+		assert.Error(t, ValidateProfileName(name), name)
+	}
+}
+
+func TestListAndDeleteProfiles(t *testing.T) {
+	dataDir := t.TempDir()
+	p := &playwrightImpl{logger: zap.NewNop(), config: &config.Config{Browser: config.BrowserConfig{DataDir: dataDir}}}
+
+	profiles, err := p.ListProfiles(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, profiles, "no profiles directory means no profiles")
+
+	dir := filepath.Join(dataDir, profilesDirName)
+	require.NoError(t, os.MkdirAll(dir, 0700))
+	for _, file := range []string{"support.json", "shop-admin.json", "notes.txt", "half.json.partial"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte(`{"cookies":[],"origins":[]}`), 0600))
+	}
+
+	profiles, err = p.ListProfiles(context.Background())
+	require.NoError(t, err)
+	require.Len(t, profiles, 2)
+	assert.Equal(t, "shop-admin", profiles[0].Name)
+	assert.Equal(t, "support", profiles[1].Name)
+	assert.Positive(t, profiles[0].Size)
+
+	require.NoError(t, p.DeleteProfile(context.Background(), "support"))
+	assert.NoFileExists(t, filepath.Join(dir, "support.json"))
+	assert.EqualError(t, p.DeleteProfile(context.Background(), "support"), `profile "support" not found`)
+	assert.ErrorContains(t, p.DeleteProfile(context.Background(), "../profiles/shop-admin"), "invalid profile name")
+}
+
+func TestGetOrCreateTaskSession_UnknownProfile(t *testing.T) {
+	p := &playwrightImpl{
+		logger:   zap.NewNop(),
+		config:   &config.Config{Browser: config.BrowserConfig{DataDir: t.TempDir()}},
+		sessions: make(map[string]*BrowserSession),
+	}
+
+	ctx := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name()})
+	_, err := p.GetOrCreateTaskSession(WithProfile(ctx, "missing"))
+	assert.EqualError(t, err, `profile "missing" not found`)
+	assert.Empty(t, p.sessions)
+}
+
+func TestSwitchesProfile(t *testing.T) {
+	session := &BrowserSession{ID: "task-1", Profile: "shop-admin"}
+	assert.False(t, switchesProfile(session, ""))
+	assert.False(t, switchesProfile(session, "shop-admin"))
+	assert.True(t, switchesProfile(session, "support"))
+	assert.True(t, switchesProfile(&BrowserSession{ID: "task-2"}, "support"))
+}
+
+func TestProfileCarriesLoginAcrossTasks(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "signed-in", Path: "/", HttpOnly: true})
+			_, _ = fmt.Fprint(w, `<script>localStorage.setItem('theme', 'dark')</script>`)
+			return
+		}
+		if _, err := r.Cookie("session"); err != nil {
+			_, _ = fmt.Fprint(w, `<title>Sign in</title>`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `<title>Account</title>`)
+	}))
+	defer srv.Close()
+
+	dataDir := t.TempDir()
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium", DataDir: dataDir},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	first := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name() + "-1"})
+	session, err := service.GetOrCreateTaskSession(first)
+	require.NoError(t, err)
+	_, err = service.NavigateToURL(first, session.ID, srv.URL+"/login", "load", 10*time.Second)
+	require.NoError(t, err)
+	profile, err := service.SaveProfile(first, session.ID, "signed-in")
+	require.NoError(t, err)
+	assert.Equal(t, "signed-in", profile.Name)
+
+	info, err := os.Stat(filepath.Join(dataDir, profilesDirName, "signed-in.json"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm(), "profiles hold live cookies")
+
+	second := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name() + "-2"})
+	session, err = service.GetOrCreateTaskSession(WithProfile(second, "signed-in"))
+	require.NoError(t, err)
+	assert.Equal(t, "signed-in", session.Profile)
+	result, err := service.NavigateToURL(second, session.ID, srv.URL+"/account", "load", 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "Account", result.Title, "the profile's cookies are sent")
+	theme, err := service.ExecuteScript(second, session.ID, "() => localStorage.getItem('theme')", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "dark", theme)
+
+	_, err = service.GetOrCreateTaskSession(WithProfile(second, "other"))
+	assert.EqualError(t, err, `profile "other" not found`)
+
+	third := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name() + "-3"})
+	session, err = service.GetOrCreateTaskSession(third)
+	require.NoError(t, err)
+	result, err = service.NavigateToURL(third, session.ID, srv.URL+"/account", "load", 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "Sign in", result.Title)
+	session, err = service.GetOrCreateTaskSession(WithProfile(third, "signed-in"))
+	require.NoError(t, err, "a live session is restarted from the profile")
+	assert.Equal(t, "signed-in", session.Profile)
+	title, err := service.ExecuteScript(third, session.ID, "() => document.title", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Account", title, "the active page is loaded again with the profile's cookies")
+}
+
+func TestProfileKeepsIndexedDB(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `<title>App</title>`)
+	}))
+	defer srv.Close()
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium", DataDir: t.TempDir(), StateEncryptionKey: newStateKey(t)},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	first := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name() + "-1"})
+	session, err := service.GetOrCreateTaskSession(first)
+	require.NoError(t, err)
+	_, err = service.NavigateToURL(first, session.ID, srv.URL, "load", 10*time.Second)
+	require.NoError(t, err)
+	_, err = service.ExecuteScript(first, session.ID, `() => new Promise((resolve, reject) => {
+		const open = indexedDB.open('auth', 1);
+		open.onupgradeneeded = () => open.result.createObjectStore('tokens');
+		open.onerror = () => reject(open.error);
+		open.onsuccess = () => {
+			const tx = open.result.transaction('tokens', 'readwrite');
+			tx.objectStore('tokens').put('id-token-1', 'current');
+			tx.oncomplete = () => { open.result.close(); resolve(true); };
+			tx.onerror = () => reject(tx.error);
+		};
+	})`, nil)
+	require.NoError(t, err)
+	_, err = service.SaveProfile(first, session.ID, "app")
+	require.NoError(t, err)
+
+	readToken := `() => new Promise((resolve, reject) => {
+		const open = indexedDB.open('auth', 1);
+		open.onupgradeneeded = () => open.result.createObjectStore('tokens');
+		open.onerror = () => reject(open.error);
+		open.onsuccess = () => {
+			const get = open.result.transaction('tokens').objectStore('tokens').get('current');
+			get.onsuccess = () => { open.result.close(); resolve(get.result ?? null); };
+			get.onerror = () => reject(get.error);
+		};
+	})`
+	second := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name() + "-2"})
+	session, err = service.GetOrCreateTaskSession(WithProfile(second, "app"))
+	require.NoError(t, err)
+	_, err = service.NavigateToURL(second, session.ID, srv.URL, "load", 10*time.Second)
+	require.NoError(t, err)
+	token, err := service.ExecuteScript(second, session.ID, readToken, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "id-token-1", token, "IndexedDB is restored with the profile")
+
+	_, err = service.ConfigureBrowser(second, session.ID, BrowserEmulation{Locale: "de-DE"})
+	require.NoError(t, err)
+	token, err = service.ExecuteScript(second, session.ID, readToken, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "id-token-1", token, "IndexedDB is carried over when the context is rebuilt")
+}
@@ -0,0 +1,101 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+
+	proxy "github.com/inference-gateway/browser-agent/internal/proxy"
+)
+
+// Proxy actions a task can take on its session's proxy
+const (
+	// ProxyActionSet switches to the proxy in ProxyChange.Proxy
+	ProxyActionSet = "set"
+	// ProxyActionRotate switches to the next configured proxy
+	ProxyActionRotate = "rotate"
+	// ProxyActionDirect stops using a proxy
+	ProxyActionDirect = "direct"
+)
+
+// ProxyActions are the supported proxy actions
+var ProxyActions = []string{ProxyActionSet, ProxyActionRotate, ProxyActionDirect}
+
+// ProxyChange is a change to the proxy of a task's session
+type ProxyChange struct {
+	Action string
+	// Proxy is the proxy to use with ProxyActionSet
+	Proxy *proxy.Settings
+}
+
+// playwrightProxy converts proxy settings to context options; nil connects
+// directly
+func playwrightProxy(settings *proxy.Settings) *playwright.Proxy {
+	if settings == nil {
+		return nil
+	}
+	p := &playwright.Proxy{Server: settings.Server}
+	if len(settings.Bypass) > 0 {
+		p.Bypass = playwright.String(strings.Join(settings.Bypass, ","))
+	}
+	if settings.Username != "" {
+		p.Username = playwright.String(settings.Username)
+		p.Password = playwright.String(settings.Password)
+	}
+	return p
+}
+
+// SetProxy changes the proxy of the session's task and recreates its context
+// to use it. Fetch requests of the task follow the same proxy. Cookies and
+// local storage are carried over and the active tab's page is loaded again.
+// It returns the proxy now in use, nil for a direct connection.
+func (p *playwrightImpl) SetProxy(ctx context.Context, sessionID string, change ProxyChange) (*proxy.Settings, error) {
+	if p.proxies == nil {
+		return nil, fmt.Errorf("proxy support is not enabled for this browser service")
+	}
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := p.proxies.ForTask(sessionID)
+	var settings *proxy.Settings
+	switch change.Action {
+	case ProxyActionSet:
+		if change.Proxy == nil {
+			return nil, fmt.Errorf("a proxy server is required to set a proxy")
+		}
+		if settings, err = p.proxies.Permit(change.Proxy); err != nil {
+			return nil, err
+		}
+		p.proxies.Set(sessionID, settings)
+	case ProxyActionRotate:
+		if settings, err = p.proxies.Rotate(sessionID); err != nil {
+			return nil, err
+		}
+	case ProxyActionDirect:
+		p.proxies.Set(sessionID, nil)
+	default:
+		return nil, fmt.Errorf("unknown proxy action %q", change.Action)
+	}
+
+	if _, err := p.rebuildContext(session, func(opts *playwright.BrowserNewContextOptions) {
+		opts.Proxy = playwrightProxy(settings)
+	}); err != nil {
+		p.proxies.Set(sessionID, previous)
+		return nil, fmt.Errorf("failed to switch proxy: %w", err)
+	}
+
+	server := "direct"
+	if settings != nil {
+		server = settings.Server
+	}
+	p.logger.Info("proxy changed",
+		zap.String("sessionID", sessionID),
+		zap.String("action", change.Action),
+		zap.String("proxy", server))
+	return settings, nil
+}
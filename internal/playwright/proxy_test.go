@@ -0,0 +1,130 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	config "github.com/inference-gateway/browser-agent/config"
+	proxy "github.com/inference-gateway/browser-agent/internal/proxy"
+)
+
+func TestPlaywrightProxy(t *testing.T) {
+	assert.Nil(t, playwrightProxy(nil))
+	assert.Equal(t, &playwright.Proxy{Server: "http://proxy.example.com:3128"}, playwrightProxy(&proxy.Settings{Server: "http://proxy.example.com:3128"}))
+	assert.Equal(t, &playwright.Proxy{
+		Server:   "socks5://127.0.0.1:1080",
+		Bypass:   playwright.String("localhost,.internal.example.com"),
+		Username: playwright.String("bot"),
+		Password: playwright.String("s3cret"),
+	}, playwrightProxy(&proxy.Settings{
+		Server:   "socks5://127.0.0.1:1080",
+		Bypass:   []string{"localhost", ".internal.example.com"},
+		Username: "bot",
+		Password: "s3cret",
+	}))
+}
+
+func TestSetProxy_Errors(t *testing.T) {
+	p := &playwrightImpl{logger: zap.NewNop()}
+	_, err := p.SetProxy(context.Background(), "task-1", ProxyChange{Action: ProxyActionDirect})
+	assert.ErrorContains(t, err, "proxy support is not enabled")
+
+	router, err := proxy.NewRouter(zap.NewNop(), &config.Config{})
+	require.NoError(t, err)
+	p = &playwrightImpl{logger: zap.NewNop(), proxies: router, sessions: map[string]*BrowserSession{}}
+	_, err = p.SetProxy(context.Background(), "task-1", ProxyChange{Action: ProxyActionDirect})
+	assert.EqualError(t, err, "session not found: task-1")
+
+	p.sessions["task-1"] = &BrowserSession{ID: "task-1", ExpiresAt: time.Now().Add(time.Minute)}
+	_, err = p.SetProxy(context.Background(), "task-1", ProxyChange{
+		Action: ProxyActionSet,
+		Proxy:  &proxy.Settings{Server: "http://elsewhere.example.com:8080"},
+	})
+	assert.ErrorContains(t, err, "proxy http://elsewhere.example.com:8080 is not allowed")
+}
+
+func TestCloseExpiredSessions_ReleasesProxies(t *testing.T) {
+	router, err := proxy.NewRouter(zap.NewNop(), &config.Config{Browser: config.BrowserConfig{
+		ProxyServer:   "proxy-a.example.com",
+		ProxyRotation: "proxy-b.example.com",
+	}})
+	require.NoError(t, err)
+	p := &playwrightImpl{logger: zap.NewNop(), proxies: router, sessions: map[string]*BrowserSession{
+		"task-1": {ID: "task-1", ExpiresAt: time.Now().Add(-time.Minute)},
+	}}
+	router.Set("task-1", nil)
+
+	require.NoError(t, p.CloseExpiredSessions(context.Background()))
+	assert.Empty(t, p.sessions)
+	assert.NotNil(t, router.ForTask("task-1"), "the expired session's proxy is released")
+}
+
+// newProxyStandIn serves every page itself, like a forward proxy in front
+// of a site that does not exist, with its name as the page title
+func newProxyStandIn(name string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = fmt.Fprintf(w, `<title>%s</title><p>%s</p>`, name, r.RequestURI)
+	}))
+}
+
+func TestSetProxy_RoutesContextsThroughProxies(t *testing.T) {
+	first := newProxyStandIn("first")
+	defer first.Close()
+	second := newProxyStandIn("second")
+	defer second.Close()
+
+	cfg := &config.Config{Browser: config.BrowserConfig{
+		Headless:      true,
+		Engine:        "chromium",
+		DataDir:       t.TempDir(),
+		ProxyServer:   first.URL,
+		ProxyRotation: second.URL,
+	}}
+	router, err := proxy.NewRouter(zap.NewNop(), cfg)
+	require.NoError(t, err)
+	service, err := NewPlaywrightService(zap.NewNop(), cfg, router)
+	if err != nil {
+		t.Skipf("skipping browser integration test: Playwright is not available in this environment: %v", err)
+	}
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	// Chromium never proxies loopback hosts, so the pages are on a made-up
+	// host only the proxy stand-ins answer for
+	ctx := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name()})
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+	result, err := service.NavigateToURL(ctx, session.ID, "http://shop.test/cart", "load", 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "first", result.Title)
+
+	settings, err := service.SetProxy(ctx, session.ID, ProxyChange{Action: ProxyActionRotate})
+	require.NoError(t, err)
+	assert.Equal(t, router.Pool()[1], settings)
+	title, err := service.ExecuteScript(ctx, session.ID, `() => document.title`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "second", title, "the page is loaded again through the new proxy")
+
+	settings, err = service.SetProxy(ctx, session.ID, ProxyChange{Action: ProxyActionDirect})
+	require.NoError(t, err)
+	assert.Nil(t, settings)
+	assert.Nil(t, router.ForTask(session.ID))
+
+	require.NoError(t, service.CloseBrowser(ctx, session.ID))
+	assert.NotNil(t, router.ForTask(session.ID), "closing the session releases its proxy")
+}
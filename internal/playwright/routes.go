@@ -0,0 +1,389 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+)
+
+// Route actions
+const (
+	// RouteAbort fails matching requests, as if the network refused them
+	RouteAbort = "abort"
+	// RouteFulfill answers matching requests with a canned response
+	RouteFulfill = "fulfill"
+	// RouteRewriteHeaders changes the headers of matching requests before
+	// they are sent
+	RouteRewriteHeaders = "rewrite_headers"
+)
+
+// routePattern is the pattern the session's single route handler is
+// registered under; rules do their own matching
+const routePattern = "**/*"
+
+// trackerHosts are analytics and advertising hosts blocked by rules with
+// Trackers set. Subdomains match too.
+var trackerHosts = []string{
+	"google-analytics.com",
+	"googletagmanager.com",
+	"googlesyndication.com",
+	"googleadservices.com",
+	"doubleclick.net",
+	"adservice.google.com",
+	"connect.facebook.net",
+	"analytics.tiktok.com",
+	"bat.bing.com",
+	"clarity.ms",
+	"hotjar.com",
+	"segment.io",
+	"cdn.segment.com",
+	"mixpanel.com",
+	"amplitude.com",
+	"fullstory.com",
+	"scorecardresearch.com",
+	"quantserve.com",
+	"criteo.com",
+	"criteo.net",
+	"taboola.com",
+	"outbrain.com",
+	"adnxs.com",
+	"newrelic.com",
+	"nr-data.net",
+}
+
+// RouteRule intercepts requests of a session's pages. A rule matches a
+// request when every set criterion does: URLPattern, ResourceTypes, and
+// Trackers.
+type RouteRule struct {
+	ID     string `json:"id"`
+	Action string `json:"action"`
+
+	// URLPattern is a glob (**/api/*), a /regex/, or a substring of the URL
+	URLPattern    string   `json:"url_pattern,omitempty"`
+	ResourceTypes []string `json:"resource_types,omitempty"`
+	// Trackers matches requests to well-known analytics and ad hosts
+	Trackers bool `json:"trackers,omitempty"`
+
+	// ErrorCode is the network error RouteAbort reports, e.g. "failed" or
+	// "blockedbyclient"
+	ErrorCode string `json:"error_code,omitempty"`
+
+	// Status, ContentType, Body and Headers make up a RouteFulfill response.
+	// For RouteRewriteHeaders, Headers are set on the request instead.
+	Status      int               `json:"status,omitempty"`
+	ContentType string            `json:"content_type,omitempty"`
+	Body        string            `json:"body,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	// RemoveHeaders are dropped from the request by RouteRewriteHeaders
+	RemoveHeaders []string `json:"remove_headers,omitempty"`
+
+	// Times limits how many requests the rule handles before it is removed;
+	// zero means no limit
+	Times int `json:"times,omitempty"`
+	// Hits counts the requests the rule has handled
+	Hits int `json:"hits"`
+}
+
+// sessionRoute is a rule with its compiled URL matcher
+type sessionRoute struct {
+	rule     RouteRule
+	matchURL func(string) bool
+}
+
+// sessionRoutes holds a session's rules. They outlive context recreation
+// and are installed on every new context.
+type sessionRoutes struct {
+	mu     sync.Mutex
+	rules  []*sessionRoute
+	nextID int
+	// installed is the context the route handler is registered on
+	installed playwright.BrowserContext
+}
+
+// routeDecision is what the rules decided for one request
+type routeDecision struct {
+	terminal *RouteRule
+	rewrites []RouteRule
+}
+
+// matches reports whether the rule applies to a request
+func (r *sessionRoute) matches(requestURL, resourceType string) bool {
+	if r.matchURL != nil && !r.matchURL(requestURL) {
+		return false
+	}
+	if len(r.rule.ResourceTypes) > 0 && !slices.Contains(r.rule.ResourceTypes, resourceType) {
+		return false
+	}
+	if r.rule.Trackers && !isTrackerURL(requestURL) {
+		return false
+	}
+	return true
+}
+
+// decide picks the rules for a request. The most recently added rules come
+// first: header rewrites accumulate, and the first abort or fulfill rule
+// ends the search. Rules that reach their Times limit are removed.
+func (s *sessionRoutes) decide(requestURL, resourceType string) routeDecision {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var decision routeDecision
+	for i := len(s.rules) - 1; i >= 0; i-- {
+		route := s.rules[i]
+		if !route.matches(requestURL, resourceType) {
+			continue
+		}
+		route.rule.Hits++
+		if route.rule.Times > 0 && route.rule.Hits >= route.rule.Times {
+			s.rules = slices.Delete(s.rules, i, i+1)
+		}
+		if route.rule.Action == RouteRewriteHeaders {
+			decision.rewrites = append(decision.rewrites, route.rule)
+			continue
+		}
+		rule := route.rule
+		decision.terminal = &rule
+		break
+	}
+	return decision
+}
+
+// list copies the rules in the order they were added
+func (s *sessionRoutes) list() []RouteRule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rules := make([]RouteRule, 0, len(s.rules))
+	for _, route := range s.rules {
+		rules = append(rules, route.rule)
+	}
+	return rules
+}
+
+// isTrackerURL reports whether a URL points at a known tracker host
+func isTrackerURL(requestURL string) bool {
+	parsed, err := url.Parse(requestURL)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(parsed.Hostname())
+	for _, tracker := range trackerHosts {
+		if host == tracker || strings.HasSuffix(host, "."+tracker) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateRouteRule checks that a rule is complete for its action
+func validateRouteRule(rule RouteRule) error {
+	switch rule.Action {
+	case RouteAbort:
+	case RouteFulfill:
+		if rule.Status != 0 && (rule.Status < 100 || rule.Status > 599) {
+			return fmt.Errorf("invalid status %d for fulfill", rule.Status)
+		}
+	case RouteRewriteHeaders:
+		if len(rule.Headers) == 0 && len(rule.RemoveHeaders) == 0 {
+			return fmt.Errorf("rewrite_headers needs headers to set or remove")
+		}
+	default:
+		return fmt.Errorf("unknown route action %q: use %s, %s or %s", rule.Action, RouteAbort, RouteFulfill, RouteRewriteHeaders)
+	}
+	if rule.URLPattern == "" && len(rule.ResourceTypes) == 0 && !rule.Trackers {
+		return fmt.Errorf("a route needs a url_pattern, resource_types or trackers to match requests")
+	}
+	if rule.Times < 0 {
+		return fmt.Errorf("times must not be negative, got %d", rule.Times)
+	}
+	return nil
+}
+
+// AddRoute adds an interception rule to the session. Rules apply to every
+// tab of the session and are kept when its context is recreated.
+func (p *playwrightImpl) AddRoute(ctx context.Context, sessionID string, rule RouteRule) (*RouteRule, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRouteRule(rule); err != nil {
+		return nil, err
+	}
+
+	route := &sessionRoute{rule: rule}
+	if rule.URLPattern != "" {
+		if route.matchURL, err = urlMatcher(rule.URLPattern); err != nil {
+			return nil, err
+		}
+	}
+
+	routes := &session.routes
+	routes.mu.Lock()
+	routes.nextID++
+	route.rule.ID = fmt.Sprintf("route-%d", routes.nextID)
+	route.rule.Hits = 0
+	routes.rules = append(routes.rules, route)
+	routes.mu.Unlock()
+
+	if err := p.installRoutes(session); err != nil {
+		routes.mu.Lock()
+		routes.rules = slices.DeleteFunc(routes.rules, func(r *sessionRoute) bool { return r == route })
+		routes.mu.Unlock()
+		return nil, err
+	}
+
+	p.logger.Info("route added",
+		zap.String("sessionID", sessionID),
+		zap.String("routeID", route.rule.ID),
+		zap.String("action", rule.Action),
+		zap.String("urlPattern", rule.URLPattern),
+		zap.Strings("resourceTypes", rule.ResourceTypes),
+		zap.Bool("trackers", rule.Trackers))
+
+	added := route.rule
+	return &added, nil
+}
+
+// RemoveRoutes removes the rules with the given IDs, or every rule when no
+// IDs are given, and returns the removed rules
+func (p *playwrightImpl) RemoveRoutes(ctx context.Context, sessionID string, ids []string) ([]RouteRule, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := &session.routes
+	routes.mu.Lock()
+	for _, id := range ids {
+		if !slices.ContainsFunc(routes.rules, func(r *sessionRoute) bool { return r.rule.ID == id }) {
+			routes.mu.Unlock()
+			return nil, fmt.Errorf("route not found: %s", id)
+		}
+	}
+	var removed []RouteRule
+	routes.rules = slices.DeleteFunc(routes.rules, func(r *sessionRoute) bool {
+		if len(ids) == 0 || slices.Contains(ids, r.rule.ID) {
+			removed = append(removed, r.rule)
+			return true
+		}
+		return false
+	})
+	var uninstall playwright.BrowserContext
+	if len(routes.rules) == 0 {
+		uninstall, routes.installed = routes.installed, nil
+	}
+	routes.mu.Unlock()
+
+	// Interception slows every request down, so it is switched off when no
+	// rule is left
+	if uninstall != nil {
+		if err := uninstall.Unroute(routePattern); err != nil {
+			p.logger.Warn("failed to remove route handler",
+				zap.String("sessionID", sessionID),
+				zap.Error(err))
+		}
+	}
+
+	p.logger.Info("routes removed", zap.String("sessionID", sessionID), zap.Int("count", len(removed)))
+	return removed, nil
+}
+
+// ListRoutes returns the session's rules in the order they were added
+func (p *playwrightImpl) ListRoutes(ctx context.Context, sessionID string) ([]RouteRule, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return session.routes.list(), nil
+}
+
+// installRoutes registers the session's route handler on its current
+// context, once, when the session has rules
+func (p *playwrightImpl) installRoutes(session *BrowserSession) error {
+	routes := &session.routes
+	routes.mu.Lock()
+	context := session.Context
+	if len(routes.rules) == 0 || context == nil || routes.installed == context {
+		routes.mu.Unlock()
+		return nil
+	}
+	routes.installed = context
+	routes.mu.Unlock()
+
+	// Not under the lock: the handler takes it, and Playwright may dispatch
+	// routed requests while Route is still waiting for its reply
+	if err := context.Route(routePattern, func(route playwright.Route) {
+		p.handleRoute(session, route)
+	}); err != nil {
+		routes.mu.Lock()
+		if routes.installed == context {
+			routes.installed = nil
+		}
+		routes.mu.Unlock()
+		return fmt.Errorf("failed to install request routes: %w", err)
+	}
+	return nil
+}
+
+// handleRoute applies the session's rules to one intercepted request
+func (p *playwrightImpl) handleRoute(session *BrowserSession, route playwright.Route) {
+	request := route.Request()
+	decision := session.routes.decide(request.URL(), request.ResourceType())
+
+	var err error
+	switch {
+	case decision.terminal != nil && decision.terminal.Action == RouteAbort:
+		errorCode := decision.terminal.ErrorCode
+		if errorCode == "" {
+			errorCode = "blockedbyclient"
+		}
+		session.console.markBlocked(request)
+		err = route.Abort(errorCode)
+
+	case decision.terminal != nil:
+		rule := decision.terminal
+		status := rule.Status
+		if status == 0 {
+			status = 200
+		}
+		options := playwright.RouteFulfillOptions{
+			Status:  &status,
+			Body:    rule.Body,
+			Headers: rule.Headers,
+		}
+		if rule.ContentType != "" {
+			options.ContentType = &rule.ContentType
+		}
+		err = route.Fulfill(options)
+
+	case len(decision.rewrites) > 0:
+		headers := request.Headers()
+		// Oldest rule first, so the newest rule has the last word
+		for i := len(decision.rewrites) - 1; i >= 0; i-- {
+			rewrite := decision.rewrites[i]
+			for _, name := range rewrite.RemoveHeaders {
+				delete(headers, strings.ToLower(name))
+			}
+			for name, value := range rewrite.Headers {
+				headers[strings.ToLower(name)] = value
+			}
+		}
+		err = route.Fallback(playwright.RouteFallbackOptions{Headers: headers})
+
+	default:
+		err = route.Fallback()
+	}
+
+	if err != nil {
+		p.logger.Warn("failed to handle routed request",
+			zap.String("sessionID", session.ID),
+			zap.String("url", request.URL()),
+			zap.Error(err))
+	}
+}
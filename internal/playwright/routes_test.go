@@ -0,0 +1,141 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestRouteDecide(t *testing.T) {
+	matchAPI, err := urlMatcher("**/api/**")
+	require.NoError(t, err)
+
+	routes := &sessionRoutes{rules: []*sessionRoute{
+		{rule: RouteRule{ID: "route-1", Action: RouteAbort, ResourceTypes: []string{"image", "font"}}},
+		{rule: RouteRule{ID: "route-2", Action: RouteRewriteHeaders, URLPattern: "**/api/**", Headers: map[string]string{"X-Test": "1"}}, matchURL: matchAPI},
+		{rule: RouteRule{ID: "route-3", Action: RouteFulfill, URLPattern: "**/api/**", Status: 500, Times: 1}, matchURL: matchAPI},
+	}}
+
+	decision := routes.decide("https://shop.example.com/api/orders", "fetch")
+	require.NotNil(t, decision.terminal)
+	assert.Equal(t, "route-3", decision.terminal.ID, "the newest matching rule wins")
+	assert.Empty(t, decision.rewrites, "rules older than the terminal one are not consulted")
+	assert.Len(t, routes.list(), 2, "a rule is removed when it reaches its times limit")
+
+	decision = routes.decide("https://shop.example.com/api/orders", "fetch")
+	assert.Nil(t, decision.terminal)
+	require.Len(t, decision.rewrites, 1)
+	assert.Equal(t, "route-2", decision.rewrites[0].ID)
+
+	decision = routes.decide("https://shop.example.com/logo.png", "image")
+	require.NotNil(t, decision.terminal)
+	assert.Equal(t, RouteAbort, decision.terminal.Action)
+
+	decision = routes.decide("https://shop.example.com/", "document")
+	assert.Nil(t, decision.terminal)
+	assert.Empty(t, decision.rewrites)
+
+	rules := routes.list()
+	assert.Equal(t, 1, rules[0].Hits)
+	assert.Equal(t, 1, rules[1].Hits)
+}
+
+func TestIsTrackerURL(t *testing.T) {
+	assert.True(t, isTrackerURL("https://www.google-analytics.com/g/collect?v=2"))
+	assert.True(t, isTrackerURL("https://static.hotjar.com/c/hotjar.js"))
+	assert.False(t, isTrackerURL("https://notgoogle-analytics.com/"))
+	assert.False(t, isTrackerURL("https://example.com/google-analytics.com"))
+}
+
+func TestValidateRouteRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    RouteRule
+		wantErr string
+	}{
+		{name: "abort by resource type", rule: RouteRule{Action: RouteAbort, ResourceTypes: []string{"image"}}},
+		{name: "fulfill by url", rule: RouteRule{Action: RouteFulfill, URLPattern: "**/api/**", Status: 503}},
+		{name: "block trackers", rule: RouteRule{Action: RouteAbort, Trackers: true}},
+		{name: "unknown action", rule: RouteRule{Action: "redirect", URLPattern: "*"}, wantErr: "unknown route action"},
+		{name: "no criteria", rule: RouteRule{Action: RouteAbort}, wantErr: "url_pattern, resource_types or trackers"},
+		{name: "bad status", rule: RouteRule{Action: RouteFulfill, URLPattern: "*", Status: 42}, wantErr: "invalid status"},
+		{name: "empty rewrite", rule: RouteRule{Action: RouteRewriteHeaders, URLPattern: "*"}, wantErr: "headers to set or remove"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRouteRule(tt.rule)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRoutesMockAndBlockRequests(t *testing.T) {
+	var sawHeader string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `<p id="status">loading</p><img src="/logo.png"><script>
+			fetch('/api/orders').then(r => r.status + ' ' + r.statusText).then(s => document.getElementById('status').textContent = s)
+			fetch('/api/echo')</script>`)
+	})
+	mux.HandleFunc("/api/echo", func(w http.ResponseWriter, r *http.Request) {
+		sawHeader = r.Header.Get("X-Agent-Test")
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium"},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	ctx := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name()})
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+
+	_, err = service.AddRoute(ctx, session.ID, RouteRule{Action: RouteAbort, ResourceTypes: []string{"image"}})
+	require.NoError(t, err)
+	_, err = service.AddRoute(ctx, session.ID, RouteRule{Action: RouteFulfill, URLPattern: "**/api/orders", Status: 503, Body: "down"})
+	require.NoError(t, err)
+	_, err = service.AddRoute(ctx, session.ID, RouteRule{Action: RouteRewriteHeaders, URLPattern: "**/api/echo", Headers: map[string]string{"X-Agent-Test": "yes"}})
+	require.NoError(t, err)
+
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL, "networkidle", 10*time.Second)
+	require.NoError(t, err)
+
+	status, err := service.ExecuteScript(ctx, session.ID, "document.getElementById('status').textContent", nil)
+	require.NoError(t, err)
+	assert.Contains(t, status, "503", "the fulfilled response reaches the page")
+	assert.Equal(t, "yes", sawHeader)
+
+	log, err := service.GetNetworkLog(ctx, session.ID, NetworkFilter{ResourceTypes: []string{"image"}})
+	require.NoError(t, err)
+	require.Len(t, log.Entries, 1)
+	assert.NotEmpty(t, log.Entries[0].Failure, "the image was blocked")
+
+	removed, err := service.RemoveRoutes(ctx, session.ID, nil)
+	require.NoError(t, err)
+	assert.Len(t, removed, 3)
+	rules, err := service.ListRoutes(ctx, session.ID)
+	require.NoError(t, err)
+	assert.Empty(t, rules)
+}
@@ -0,0 +1,211 @@
+package playwright
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	playwright "github.com/mxschmitt/playwright-go"
+)
+
+// How the options of a select field are matched
+const (
+	// OptionByAuto matches the value of a <select> option, then its label
+	OptionByAuto  = "auto"
+	OptionByValue = "value"
+	OptionByLabel = "label"
+	// OptionByIndex matches the zero-based position of the option
+	OptionByIndex = "index"
+)
+
+// OptionMatchers are the ways a select field can pick its options
+var OptionMatchers = []string{OptionByAuto, OptionByValue, OptionByLabel, OptionByIndex}
+
+// SelectedOption is an option a select field ended up with
+type SelectedOption struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+	Index int    `json:"index"`
+}
+
+// FieldResult reports what FillForm did to one field
+type FieldResult struct {
+	Selector string `json:"selector"`
+	Type     string `json:"type"`
+	// Selected holds the options a select field has after filling
+	Selected []SelectedOption `json:"selected,omitempty"`
+}
+
+const (
+	// isNativeSelectScript reports whether an element is a <select>, and
+	// whether it accepts several options
+	isNativeSelectScript = `el => el instanceof HTMLSelectElement ? { multiple: el.multiple } : null`
+	// selectedOptionsScript lists the selected options of a <select>
+	selectedOptionsScript = `el => [...el.selectedOptions].map(o => ({ value: o.value, label: o.label, index: o.index }))`
+	// describeOptionScript describes an option of a custom dropdown; its
+	// value is taken from data-value or value, falling back to its text
+	describeOptionScript = `el => {
+		const label = (el.innerText || el.textContent || '').trim();
+		const list = el.closest('[role=listbox]') || el.parentElement;
+		const options = list ? [...list.querySelectorAll('[role=option]')] : [];
+		return {
+			value: el.getAttribute('data-value') || el.getAttribute('value') || label,
+			label: label,
+			index: options.indexOf(el),
+		};
+	}`
+	// popupScript returns the ID of the listbox a combobox controls, if any
+	popupScript = `el => el.getAttribute('aria-controls') || el.getAttribute('aria-owns') || ''`
+)
+
+// selectOptions picks options on a select field. A <select> gets them with
+// SelectOption; any other element is taken for a custom dropdown, such as a
+// combobox, and is opened so each option can be clicked.
+func (p *playwrightImpl) selectOptions(page playwright.Page, frame string, locator playwright.Locator, options []string, by string, multiple bool) ([]SelectedOption, error) {
+	if len(options) == 0 {
+		return nil, fmt.Errorf("no options to select")
+	}
+	if len(options) > 1 && !multiple {
+		return nil, fmt.Errorf("%d options given for a single select; set multiple to select several", len(options))
+	}
+	if by == "" {
+		by = OptionByAuto
+	}
+
+	native, err := locator.Evaluate(isNativeSelectScript, nil)
+	if err != nil {
+		return nil, err
+	}
+	if native, ok := native.(map[string]any); ok {
+		if len(options) > 1 && native["multiple"] != true {
+			return nil, fmt.Errorf("the select accepts a single option, got %d", len(options))
+		}
+		return selectNativeOptions(locator, options, by)
+	}
+	return p.selectCustomOptions(page, frame, locator, options, by)
+}
+
+// selectNativeOptions sets the selected options of a <select>, replacing
+// the current selection
+func selectNativeOptions(locator playwright.Locator, options []string, by string) ([]SelectedOption, error) {
+	var values playwright.SelectOptionValues
+	switch by {
+	case OptionByValue:
+		values.Values = &options
+	case OptionByLabel:
+		values.Labels = &options
+	case OptionByIndex:
+		indexes, err := optionIndexes(options)
+		if err != nil {
+			return nil, err
+		}
+		values.Indexes = &indexes
+	default:
+		values.ValuesOrLabels = &options
+	}
+	if _, err := locator.SelectOption(values); err != nil {
+		return nil, err
+	}
+
+	selected, err := locator.Evaluate(selectedOptionsScript, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the selected options: %w", err)
+	}
+	return parseSelectedOptions(selected), nil
+}
+
+// selectCustomOptions clicks a custom dropdown open and clicks each option.
+// Values and labels both match the option's accessible name; the dropdown is
+// reopened whenever picking an option closed it.
+func (p *playwrightImpl) selectCustomOptions(page playwright.Page, frame string, combobox playwright.Locator, options []string, by string) ([]SelectedOption, error) {
+	scope := ""
+	if popup, err := combobox.Evaluate(popupScript, nil); err == nil {
+		if id, _ := popup.(string); id != "" {
+			scope = fmt.Sprintf("[id=%s] >> ", strconv.Quote(id))
+		}
+	}
+
+	selected := make([]SelectedOption, 0, len(options))
+	for _, option := range options {
+		selector, err := customOptionSelector(option, by)
+		if err != nil {
+			return nil, err
+		}
+		locator, err := p.locate(page, frame, scope+selector)
+		if err != nil {
+			return nil, err
+		}
+		locator = locator.First()
+
+		if visible, _ := locator.IsVisible(); !visible {
+			if err := combobox.Click(); err != nil {
+				return nil, fmt.Errorf("failed to open the dropdown: %w", err)
+			}
+		}
+		description, err := locator.Evaluate(describeOptionScript, nil)
+		if err != nil {
+			return nil, fmt.Errorf("option %q not found: %w", option, err)
+		}
+		if err := locator.Click(); err != nil {
+			return nil, fmt.Errorf("failed to select option %q: %w", option, err)
+		}
+		selected = append(selected, parseSelectedOptions([]any{description})...)
+	}
+
+	// Close a dropdown that stays open for more picks
+	if len(options) > 1 {
+		if expanded, err := combobox.GetAttribute("aria-expanded"); err == nil && expanded == "true" {
+			_ = combobox.Press("Escape")
+		}
+	}
+	return selected, nil
+}
+
+// customOptionSelector returns the selector of an option of a custom
+// dropdown
+func customOptionSelector(option, by string) (string, error) {
+	if by == OptionByIndex {
+		indexes, err := optionIndexes([]string{option})
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("role=option >> nth=%d", indexes[0]), nil
+	}
+	return fmt.Sprintf("role=option[name=%ss]", strconv.Quote(option)), nil
+}
+
+// optionIndexes parses option indexes
+func optionIndexes(options []string) ([]int, error) {
+	indexes := make([]int, 0, len(options))
+	for _, option := range options {
+		index, err := strconv.Atoi(strings.TrimSpace(option))
+		if err != nil || index < 0 {
+			return nil, fmt.Errorf("option index must be a non-negative integer, got %q", option)
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes, nil
+}
+
+// parseSelectedOptions converts the options described by a script
+func parseSelectedOptions(raw any) []SelectedOption {
+	items, _ := raw.([]any)
+	selected := make([]SelectedOption, 0, len(items))
+	for _, item := range items {
+		option, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		value, _ := option["value"].(string)
+		label, _ := option["label"].(string)
+		index := -1
+		switch n := option["index"].(type) {
+		case int:
+			index = n
+		case float64:
+			index = int(n)
+		}
+		selected = append(selected, SelectedOption{Value: value, Label: label, Index: index})
+	}
+	return selected
+}
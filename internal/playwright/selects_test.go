@@ -0,0 +1,96 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestCustomOptionSelector(t *testing.T) {
+	selector, err := customOptionSelector(`Say "hi"`, OptionByLabel)
+	require.NoError(t, err)
+	assert.Equal(t, `role=option[name="Say \"hi\""s]`, selector)
+
+	selector, err = customOptionSelector("3", OptionByIndex)
+	require.NoError(t, err)
+	assert.Equal(t, "role=option >> nth=3", selector)
+
+	_, err = customOptionSelector("-1", OptionByIndex)
+	assert.Error(t, err)
+}
+
+func TestParseSelectedOptions(t *testing.T) {
+	selected := parseSelectedOptions([]any{
+		map[string]any{"value": "ca", "label": "Canada", "index": float64(1)},
+		map[string]any{"value": "x", "label": "X"},
+		"ignored",
+	})
+	assert.Equal(t, []SelectedOption{
+		{Value: "ca", Label: "Canada", Index: 1},
+		{Value: "x", Label: "X", Index: -1},
+	}, selected)
+}
+
+func TestFillFormSelectsOptions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `
+<select id="toppings" multiple>
+  <option value="ham">Ham</option><option value="pineapple">Pineapple</option><option value="olives">Olives</option>
+</select>
+<select id="size"><option value="s">Small</option><option value="m">Medium</option><option value="l">Large</option></select>
+<div id="color" role="combobox" aria-controls="colors" aria-expanded="false" tabindex="0">Pick a color</div>
+<ul id="colors" role="listbox" hidden>
+  <li role="option" data-value="r">Red</li><li role="option" data-value="g">Green</li>
+</ul>
+<script>
+  const box = document.getElementById('color'), list = document.getElementById('colors');
+  box.onclick = () => { list.hidden = false; box.setAttribute('aria-expanded', 'true'); };
+  list.onclick = e => { box.textContent = e.target.textContent; list.hidden = true; box.setAttribute('aria-expanded', 'false'); };
+</script>`)
+	}))
+	defer srv.Close()
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium", DataDir: t.TempDir()},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	ctx := context.Background()
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL, "load", 10*time.Second)
+	require.NoError(t, err)
+
+	results, err := service.FillForm(ctx, session.ID, []map[string]any{
+		{"selector": "#toppings", "type": "select", "multiple": true, "values": []string{"Ham", "olives"}},
+		{"selector": "#size", "type": "select", "option_by": OptionByIndex, "values": []string{"2"}},
+		{"selector": "#color", "type": "select", "option_by": OptionByLabel, "values": []string{"Green"}},
+	}, false, "")
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	assert.Equal(t, []SelectedOption{
+		{Value: "ham", Label: "Ham", Index: 0},
+		{Value: "olives", Label: "Olives", Index: 2},
+	}, results[0].Selected, "a multi-select takes values and labels")
+	assert.Equal(t, []SelectedOption{{Value: "l", Label: "Large", Index: 2}}, results[1].Selected)
+	assert.Equal(t, []SelectedOption{{Value: "g", Label: "Green", Index: 1}}, results[2].Selected,
+		"the combobox is opened and the option clicked")
+
+	_, err = service.FillForm(ctx, session.ID, []map[string]any{
+		{"selector": "#size", "type": "select", "multiple": true, "values": []string{"s", "m"}},
+	}, false, "")
+	assert.ErrorContains(t, err, "accepts a single option")
+}
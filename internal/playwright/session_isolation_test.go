@@ -24,7 +24,7 @@ import (
 // meaningful to exercise, so a skip is preferable to a hard failure.
 func newPlaywrightServiceOrSkip(t *testing.T, logger *zap.Logger, cfg *config.Config) BrowserAutomation {
 	t.Helper()
-	service, err := NewPlaywrightService(logger, cfg)
+	service, err := NewPlaywrightService(logger, cfg, nil)
 	if err != nil {
 		t.Skipf("skipping browser integration test: Playwright is not available in this environment: %v", err)
 	}
@@ -72,7 +72,8 @@ func TestMultiTenantSessionIsolation(t *testing.T) {
 	assert.Equal(t, "task-2", session2.ID, "Session ID should match task ID")
 	assert.Equal(t, "task-3", session3.ID, "Session ID should match task ID")
 
-	assert.NotEqual(t, session1.Browser, session2.Browser, "Each session should have its own browser instance")
+	assert.NotEqual(t, session1.Browser, session2.Browser, "The pool should spread the first sessions across its browsers")
+	assert.Equal(t, session1.Browser, session3.Browser, "A full pool should reuse its least-loaded browser")
 	assert.NotEqual(t, session1.Context, session2.Context, "Each session should have its own context")
 	assert.NotEqual(t, session1.Page, session2.Page, "Each session should have its own page")
 
@@ -110,7 +111,7 @@ func TestSessionExpiration(t *testing.T) {
 	session, err := service.GetOrCreateTaskSession(ctx)
 	require.NoError(t, err)
 
-	playwrightService := service.(*playwrightImpl)
+	playwrightService := service.(*cancellable).BrowserAutomation.(*playwrightImpl)
 	playwrightService.sessionsMux.Lock()
 	playwrightService.sessions[session.ID].ExpiresAt = time.Now().Add(-1 * time.Minute)
 	playwrightService.sessionsMux.Unlock()
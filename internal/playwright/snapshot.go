@@ -0,0 +1,225 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+)
+
+// RefSelectorPrefix marks a selector as a snapshot ref, e.g. "ref=e12"
+const RefSelectorPrefix = "ref="
+
+var (
+	// snapshotRef matches the ref annotation Playwright adds in AI mode;
+	// refs inside iframes carry a frame prefix such as f1e3
+	snapshotRef = regexp.MustCompile(` \[ref=((?:f\d+)?e\d+)\]`)
+	// snapshotCursor matches the pointer hint, which only costs tokens
+	snapshotCursor = regexp.MustCompile(` \[cursor=[a-z-]+\]`)
+	validRef       = regexp.MustCompile(`^(?:f\d+)?e\d+$`)
+)
+
+// interactiveRoles are the ARIA roles that keep a ref in the snapshot and
+// survive interactive-only pruning
+var interactiveRoles = map[string]bool{
+	"button":           true,
+	"checkbox":         true,
+	"combobox":         true,
+	"link":             true,
+	"listbox":          true,
+	"menuitem":         true,
+	"menuitemcheckbox": true,
+	"menuitemradio":    true,
+	"option":           true,
+	"radio":            true,
+	"searchbox":        true,
+	"slider":           true,
+	"spinbutton":       true,
+	"switch":           true,
+	"tab":              true,
+	"textbox":          true,
+	"treeitem":         true,
+}
+
+// SnapshotOptions controls GetPageSnapshot
+type SnapshotOptions struct {
+	// Selector limits the snapshot to one element's subtree
+	Selector string
+	// InteractiveOnly keeps only interactive nodes and headings, flattened
+	InteractiveOnly bool
+	// MaxDepth limits the depth of the tree; zero means unlimited
+	MaxDepth int
+	Timeout  time.Duration
+}
+
+// PageSnapshot is a pruned accessibility tree of the active tab. Tree is
+// Playwright's YAML-like aria snapshot: one node per line as
+// `- role "name" [state] [ref=eN]: value`. Refs are stable for an element
+// while it stays on the page.
+type PageSnapshot struct {
+	URL      string `json:"url"`
+	Title    string `json:"title"`
+	Tree     string `json:"tree"`
+	RefCount int    `json:"ref_count"`
+}
+
+// IsRefSelector reports whether selector is a snapshot ref such as ref=e12
+func IsRefSelector(selector string) bool {
+	return strings.HasPrefix(strings.TrimSpace(selector), RefSelectorPrefix)
+}
+
+// refLocator resolves a ref=eN selector through Playwright's aria-ref engine
+func refLocator(page playwright.Page, selector string) (playwright.Locator, error) {
+	ref := strings.TrimPrefix(strings.TrimSpace(selector), RefSelectorPrefix)
+	if !validRef.MatchString(ref) {
+		return nil, fmt.Errorf("invalid ref %q: expected a ref from get_page_snapshot such as ref=e12", ref)
+	}
+	return page.Locator("aria-ref=" + ref), nil
+}
+
+// GetPageSnapshot captures the accessibility tree of the active tab,
+// including iframes, and prunes it for the model. Interactive nodes carry a
+// ref that click and fill operations accept as "ref=eN".
+func (p *playwrightImpl) GetPageSnapshot(ctx context.Context, sessionID string, options SnapshotOptions) (*PageSnapshot, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	page := session.ActivePage()
+	timeoutMs := float64(options.Timeout.Milliseconds())
+	var depth *int
+	if options.MaxDepth > 0 {
+		depth = &options.MaxDepth
+	}
+
+	p.logger.Info("capturing page snapshot",
+		zap.String("sessionID", sessionID),
+		zap.String("selector", options.Selector),
+		zap.Bool("interactiveOnly", options.InteractiveOnly))
+
+	var raw string
+	if options.Selector != "" {
+		locator, err := p.locate(page, "", options.Selector)
+		if err != nil {
+			return nil, err
+		}
+		raw, err = locator.First().AriaSnapshot(playwright.LocatorAriaSnapshotOptions{
+			Mode:    playwright.AriaSnapshotModeAi,
+			Depth:   depth,
+			Timeout: &timeoutMs,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to capture snapshot of %s: %w", options.Selector, err)
+		}
+	} else {
+		raw, err = page.AriaSnapshot(playwright.PageAriaSnapshotOptions{
+			Mode:    playwright.AriaSnapshotModeAi,
+			Depth:   depth,
+			Timeout: &timeoutMs,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to capture page snapshot: %w", err)
+		}
+	}
+
+	tree, refCount := pruneSnapshot(raw, options.InteractiveOnly)
+	title, err := page.Title()
+	if err != nil {
+		title = ""
+	}
+
+	return &PageSnapshot{
+		URL:      page.URL(),
+		Title:    title,
+		Tree:     tree,
+		RefCount: refCount,
+	}, nil
+}
+
+// snapshotLine is one node line of an aria snapshot
+type snapshotLine struct {
+	indent int
+	role   string
+	named  bool
+	text   string
+}
+
+// parseSnapshotLine splits "  - role "name" [attrs]: value" into its parts.
+// Property lines such as "- /url: /home" parse with role "/url".
+func parseSnapshotLine(line string) (snapshotLine, bool) {
+	trimmed := strings.TrimLeft(line, " ")
+	if !strings.HasPrefix(trimmed, "- ") {
+		return snapshotLine{}, false
+	}
+	rest := trimmed[2:]
+	end := strings.IndexAny(rest, " :")
+	if end < 0 {
+		end = len(rest)
+	}
+	return snapshotLine{
+		indent: len(line) - len(trimmed),
+		role:   rest[:end],
+		named:  strings.HasPrefix(rest[end:], ` "`),
+		text:   trimmed,
+	}, true
+}
+
+// pruneSnapshot trims an AI-mode aria snapshot for the model: refs are kept
+// only on interactive nodes, pointer hints are dropped and unnamed generic
+// wrappers are collapsed into their parent. With interactiveOnly just the
+// interactive nodes and headings remain, as a flat list. Returns the tree
+// and the number of refs left in it.
+func pruneSnapshot(raw string, interactiveOnly bool) (string, int) {
+	var (
+		out       []string
+		collapsed []int
+		refCount  int
+	)
+
+	for _, line := range strings.Split(raw, "\n") {
+		node, ok := parseSnapshotLine(line)
+		if !ok {
+			// Continuation of a multi-line value
+			if !interactiveOnly && strings.TrimSpace(line) != "" {
+				out = append(out, line)
+			}
+			continue
+		}
+
+		for len(collapsed) > 0 && collapsed[len(collapsed)-1] >= node.indent {
+			collapsed = collapsed[:len(collapsed)-1]
+		}
+
+		text := snapshotCursor.ReplaceAllString(node.text, "")
+		interactive := interactiveRoles[node.role]
+		if interactive {
+			if snapshotRef.MatchString(text) {
+				refCount++
+			}
+		} else {
+			text = snapshotRef.ReplaceAllString(text, "")
+		}
+
+		if interactiveOnly {
+			if interactive || node.role == "heading" {
+				out = append(out, text)
+			}
+			continue
+		}
+
+		if node.role == "generic" && !node.named && strings.HasSuffix(text, ":") {
+			collapsed = append(collapsed, node.indent)
+			continue
+		}
+
+		indent := node.indent - 2*len(collapsed)
+		out = append(out, strings.Repeat(" ", max(indent, 0))+text)
+	}
+
+	return strings.Join(out, "\n"), refCount
+}
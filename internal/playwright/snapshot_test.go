@@ -0,0 +1,110 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+const rawSnapshot = `- generic [ref=e1]:
+  - heading "Checkout" [level=1] [ref=e2]
+  - generic [ref=e3]:
+    - textbox "Email" [ref=e4]: alice@example.com
+    - checkbox "Remember me" [checked] [ref=e5] [cursor=pointer]
+    - paragraph [ref=e6]: Terms apply
+  - link "Help" [ref=e7] [cursor=pointer]:
+    - /url: /help
+  - button "Pay" [disabled] [ref=f1e2]`
+
+func TestPruneSnapshot(t *testing.T) {
+	tree, refs := pruneSnapshot(rawSnapshot, false)
+	assert.Equal(t, 4, refs)
+	assert.Equal(t, `- heading "Checkout" [level=1]
+- textbox "Email" [ref=e4]: alice@example.com
+- checkbox "Remember me" [checked] [ref=e5]
+- paragraph: Terms apply
+- link "Help" [ref=e7]:
+  - /url: /help
+- button "Pay" [disabled] [ref=f1e2]`, tree)
+}
+
+func TestPruneSnapshotInteractiveOnly(t *testing.T) {
+	tree, refs := pruneSnapshot(rawSnapshot, true)
+	assert.Equal(t, 4, refs)
+	assert.Equal(t, `- heading "Checkout" [level=1]
+- textbox "Email" [ref=e4]: alice@example.com
+- checkbox "Remember me" [checked] [ref=e5]
+- link "Help" [ref=e7]:
+- button "Pay" [disabled] [ref=f1e2]`, tree)
+}
+
+func TestRefSelectors(t *testing.T) {
+	assert.True(t, IsRefSelector("ref=e12"))
+	assert.True(t, IsRefSelector(" ref=f1e3"))
+	assert.False(t, IsRefSelector("[ref=e12]"))
+	assert.False(t, IsRefSelector("#ref"))
+
+	_, err := refLocator(nil, "ref=button")
+	assert.ErrorContains(t, err, "invalid ref")
+}
+
+func TestGetPageSnapshotRefsDriveActions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `<h1>Sign up</h1>
+			<label>Email <input id="email"></label>
+			<button onclick="document.querySelector('h1').textContent='Thanks'">Join</button>`)
+	}))
+	defer srv.Close()
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium"},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	ctx := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name()})
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL, "load", 10*time.Second)
+	require.NoError(t, err)
+
+	snapshot, err := service.GetPageSnapshot(ctx, session.ID, SnapshotOptions{InteractiveOnly: true, Timeout: 10 * time.Second})
+	require.NoError(t, err)
+	assert.Equal(t, 2, snapshot.RefCount)
+
+	refOf := func(role string) string {
+		for _, line := range strings.Split(snapshot.Tree, "\n") {
+			if strings.HasPrefix(line, "- "+role) {
+				return "ref=" + snapshotRef.FindStringSubmatch(line)[1]
+			}
+		}
+		t.Fatalf("no %s in snapshot:\n%s", role, snapshot.Tree)
+		return ""
+	}
+
+	_, err = service.FillForm(ctx, session.ID, []map[string]any{
+		{"selector": refOf("textbox"), "value": "alice@example.com", "type": "text"},
+	}, true, refOf("button"))
+	require.NoError(t, err)
+
+	data, err := service.ExtractData(ctx, session.ID, []map[string]any{
+		{"name": "heading", "selector": "h1", "attribute": "text"},
+	}, "json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"heading":"Thanks"}`, data)
+}
@@ -0,0 +1,213 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+)
+
+// Web storage areas of GetStorage and SetStorage
+const (
+	LocalStorage   = "local"
+	SessionStorage = "session"
+)
+
+// StorageTypes are the web storage areas GetStorage and SetStorage accept
+var StorageTypes = []string{LocalStorage, SessionStorage}
+
+// StorageQuery selects web storage entries to read
+type StorageQuery struct {
+	// Type is LocalStorage or SessionStorage
+	Type string
+	// Origin defaults to the active tab's origin. Local storage of other
+	// origins the context has visited can be read too.
+	Origin string
+	// Keys keeps only these keys; empty returns every entry
+	Keys []string
+}
+
+// StorageUpdate changes the web storage of the active tab's origin. Clear
+// runs first, then Remove, then Set.
+type StorageUpdate struct {
+	// Type is LocalStorage or SessionStorage
+	Type string
+	// Origin, when set, must be the active tab's origin
+	Origin string
+	Set    map[string]string
+	Remove []string
+	Clear  bool
+}
+
+// WebStorage is the content of an origin's local or session storage
+type WebStorage struct {
+	Origin string            `json:"origin"`
+	Type   string            `json:"type"`
+	Items  map[string]string `json:"items"`
+}
+
+// readStorageScript returns the origin and entries of a storage area
+const readStorageScript = `(type) => {
+	const storage = type === 'session' ? window.sessionStorage : window.localStorage;
+	const items = {};
+	for (let i = 0; i < storage.length; i++) {
+		const key = storage.key(i);
+		items[key] = storage.getItem(key);
+	}
+	return { origin: window.location.origin, items };
+}`
+
+// updateStorageScript applies a StorageUpdate to a storage area
+const updateStorageScript = `({ type, set, remove, clear }) => {
+	const storage = type === 'session' ? window.sessionStorage : window.localStorage;
+	if (clear) storage.clear();
+	for (const key of remove) storage.removeItem(key);
+	for (const [key, value] of Object.entries(set)) storage.setItem(key, value);
+}`
+
+// GetStorage reads the local or session storage of an origin
+func (p *playwrightImpl) GetStorage(ctx context.Context, sessionID string, query StorageQuery) (*WebStorage, error) {
+	if !slices.Contains(StorageTypes, query.Type) {
+		return nil, fmt.Errorf("unknown storage type %q", query.Type)
+	}
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	page := session.ActivePage()
+
+	origin, err := storageOrigin(query.Origin)
+	if err != nil {
+		return nil, err
+	}
+	var storage *WebStorage
+	if origin == "" || origin == pageOrigin(page) {
+		if storage, err = readPageStorage(page, query.Type); err != nil {
+			return nil, err
+		}
+	} else {
+		if query.Type == SessionStorage {
+			return nil, fmt.Errorf("session storage of %s can only be read while the active tab is on it", origin)
+		}
+		if storage, err = readContextStorage(session.Context, origin); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(query.Keys) > 0 {
+		for key := range storage.Items {
+			if !slices.Contains(query.Keys, key) {
+				delete(storage.Items, key)
+			}
+		}
+	}
+	return storage, nil
+}
+
+// SetStorage changes the local or session storage of the active tab's
+// origin and returns its new content
+func (p *playwrightImpl) SetStorage(ctx context.Context, sessionID string, update StorageUpdate) (*WebStorage, error) {
+	if !slices.Contains(StorageTypes, update.Type) {
+		return nil, fmt.Errorf("unknown storage type %q", update.Type)
+	}
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	page := session.ActivePage()
+
+	origin, err := storageOrigin(update.Origin)
+	if err != nil {
+		return nil, err
+	}
+	current := pageOrigin(page)
+	if current == "" {
+		return nil, fmt.Errorf("the active tab has no web origin; navigate to the site first")
+	}
+	if origin != "" && origin != current {
+		return nil, fmt.Errorf("storage of %s can only be changed while the active tab is on it, but it is on %s", origin, current)
+	}
+
+	set := update.Set
+	if set == nil {
+		set = map[string]string{}
+	}
+	remove := update.Remove
+	if remove == nil {
+		remove = []string{}
+	}
+	if _, err := page.Evaluate(updateStorageScript, map[string]any{
+		"type":   update.Type,
+		"set":    set,
+		"remove": remove,
+		"clear":  update.Clear,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to update %s storage: %w", update.Type, err)
+	}
+	p.logger.Info("updated web storage",
+		zap.String("sessionID", sessionID),
+		zap.String("type", update.Type),
+		zap.String("origin", current))
+	return readPageStorage(page, update.Type)
+}
+
+// storageOrigin normalizes an origin given as an origin or a URL, e.g.
+// https://example.com/app to https://example.com
+func storageOrigin(raw string) (string, error) {
+	if raw == "" {
+		return "", nil
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return "", fmt.Errorf("invalid origin %q: use a URL such as https://example.com", raw)
+	}
+	return parsed.Scheme + "://" + parsed.Host, nil
+}
+
+// pageOrigin returns the origin of the page's URL, or "" for pages without
+// a web origin such as about:blank
+func pageOrigin(page playwright.Page) string {
+	origin, err := storageOrigin(page.URL())
+	if err != nil || !strings.HasPrefix(origin, "http") {
+		return ""
+	}
+	return origin
+}
+
+// readPageStorage reads a storage area of the page's origin
+func readPageStorage(page playwright.Page, storageType string) (*WebStorage, error) {
+	raw, err := page.Evaluate(readStorageScript, storageType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s storage: %w", storageType, err)
+	}
+	result, _ := raw.(map[string]any)
+	storage := &WebStorage{Type: storageType, Items: map[string]string{}}
+	storage.Origin, _ = result["origin"].(string)
+	items, _ := result["items"].(map[string]any)
+	for key, value := range items {
+		storage.Items[key], _ = value.(string)
+	}
+	return storage, nil
+}
+
+// readContextStorage reads the local storage the context keeps for origin
+func readContextStorage(browserContext playwright.BrowserContext, origin string) (*WebStorage, error) {
+	state, err := browserContext.StorageState()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read local storage: %w", err)
+	}
+	storage := &WebStorage{Origin: origin, Type: LocalStorage, Items: map[string]string{}}
+	for _, entry := range state.Origins {
+		if entry.Origin != origin {
+			continue
+		}
+		for _, item := range entry.LocalStorage {
+			storage.Items[item.Name] = item.Value
+		}
+	}
+	return storage, nil
+}
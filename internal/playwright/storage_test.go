@@ -0,0 +1,33 @@
+package playwright
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+)
+
+func TestStorageOrigin(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{raw: "", want: ""},
+		{raw: "https://example.com", want: "https://example.com"},
+		{raw: "https://app.example.com:8443/settings?tab=1", want: "https://app.example.com:8443"},
+		{raw: "example.com", wantErr: true},
+		{raw: "://", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := storageOrigin(tt.raw)
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "invalid origin")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
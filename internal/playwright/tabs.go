@@ -0,0 +1,285 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+)
+
+// Tab describes one page of a session's browser context
+type Tab struct {
+	ID       string `json:"id"`
+	URL      string `json:"url"`
+	Title    string `json:"title"`
+	Active   bool   `json:"active"`
+	OpenerID string `json:"opener_id,omitempty"`
+}
+
+// sessionTab is a page tracked by a session, under a stable ID
+type sessionTab struct {
+	id     string
+	page   playwright.Page
+	opener string
+}
+
+// ActivePage returns the tab that page operations act on
+func (s *BrowserSession) ActivePage() playwright.Page {
+	s.tabsMux.RLock()
+	defer s.tabsMux.RUnlock()
+	return s.Page
+}
+
+// tabByID returns the tab with the given ID, or nil. Callers hold tabsMux.
+func (s *BrowserSession) tabByID(id string) *sessionTab {
+	for _, tab := range s.tabs {
+		if tab.id == id {
+			return tab
+		}
+	}
+	return nil
+}
+
+// tabByPage returns the tab wrapping page, or nil. Callers hold tabsMux.
+func (s *BrowserSession) tabByPage(page playwright.Page) *sessionTab {
+	for _, tab := range s.tabs {
+		if tab.page == page {
+			return tab
+		}
+	}
+	return nil
+}
+
+// trackTabs starts tracking the pages of the session's current context,
+// beginning with its active page. Pages opened later, by window.open, a
+// target=_blank link or OpenTab, are picked up through the context's page
+// event.
+func (p *playwrightImpl) trackTabs(session *BrowserSession) {
+	session.tabsMux.Lock()
+	session.tabs = nil
+	context, page := session.Context, session.Page
+	session.tabsMux.Unlock()
+
+	if page != nil {
+		p.addTab(session, page)
+	}
+	if context != nil {
+		context.OnPage(func(page playwright.Page) {
+			p.addTab(session, page)
+		})
+	}
+}
+
+// addTab registers page with the session unless it is already tracked. A
+// popup opened from the active tab becomes the active tab, so a click that
+// opens a new window is followed the way a user would follow it.
+func (p *playwrightImpl) addTab(session *BrowserSession, page playwright.Page) *sessionTab {
+	session.tabsMux.Lock()
+	defer session.tabsMux.Unlock()
+
+	if tab := session.tabByPage(page); tab != nil {
+		return tab
+	}
+
+	session.nextTabID++
+	tab := &sessionTab{
+		id:   fmt.Sprintf("tab-%d", session.nextTabID),
+		page: page,
+	}
+
+	if opener, err := page.Opener(); err == nil && opener != nil {
+		if openerTab := session.tabByPage(opener); openerTab != nil {
+			tab.opener = openerTab.id
+			if opener == session.Page {
+				session.Page = page
+				p.logger.Info("popup opened, switching to it",
+					zap.String("sessionID", session.ID),
+					zap.String("tabID", tab.id),
+					zap.String("openerID", openerTab.id))
+			}
+		}
+	}
+	if session.Page == nil {
+		session.Page = page
+	}
+
+	session.tabs = append(session.tabs, tab)
+	page.OnClose(func(playwright.Page) {
+		p.removeTab(session, page)
+	})
+	return tab
+}
+
+// removeTab forgets a closed page. When it was the active tab its opener
+// takes over, or else the most recently opened tab. The last page is kept as
+// Page even when closed so operations fail with Playwright's error instead
+// of a nil page.
+func (p *playwrightImpl) removeTab(session *BrowserSession, page playwright.Page) {
+	session.tabsMux.Lock()
+	defer session.tabsMux.Unlock()
+
+	idx := slices.IndexFunc(session.tabs, func(tab *sessionTab) bool { return tab.page == page })
+	if idx < 0 {
+		return
+	}
+	closed := session.tabs[idx]
+	session.tabs = slices.Delete(session.tabs, idx, idx+1)
+
+	if session.Page != page || len(session.tabs) == 0 {
+		return
+	}
+	next := session.tabByID(closed.opener)
+	if next == nil {
+		next = session.tabs[len(session.tabs)-1]
+	}
+	session.Page = next.page
+	p.logger.Info("active tab closed, switching tab",
+		zap.String("sessionID", session.ID),
+		zap.String("closedTabID", closed.id),
+		zap.String("tabID", next.id))
+}
+
+// describeTab builds the Tab view of a tracked page
+func describeTab(tab *sessionTab, active bool) Tab {
+	title, err := tab.page.Title()
+	if err != nil {
+		title = ""
+	}
+	return Tab{
+		ID:       tab.id,
+		URL:      tab.page.URL(),
+		Title:    title,
+		Active:   active,
+		OpenerID: tab.opener,
+	}
+}
+
+// snapshotTabs copies the session's tabs and active page, so page calls can
+// be made without holding tabsMux.
+func snapshotTabs(session *BrowserSession) ([]*sessionTab, playwright.Page) {
+	session.tabsMux.RLock()
+	defer session.tabsMux.RUnlock()
+	return slices.Clone(session.tabs), session.Page
+}
+
+// ListTabs returns the open tabs of a session in the order they were opened
+func (p *playwrightImpl) ListTabs(ctx context.Context, sessionID string) ([]Tab, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	tabs, active := snapshotTabs(session)
+	result := make([]Tab, 0, len(tabs))
+	for _, tab := range tabs {
+		result = append(result, describeTab(tab, tab.page == active))
+	}
+	return result, nil
+}
+
+// SwitchTab makes tabID the active tab and brings it to the front
+func (p *playwrightImpl) SwitchTab(ctx context.Context, sessionID, tabID string) (*Tab, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	session.tabsMux.Lock()
+	tab := session.tabByID(tabID)
+	if tab == nil {
+		session.tabsMux.Unlock()
+		return nil, fmt.Errorf("tab not found: %s", tabID)
+	}
+	session.Page = tab.page
+	session.tabsMux.Unlock()
+
+	if err := tab.page.BringToFront(); err != nil {
+		p.logger.Warn("failed to bring tab to front",
+			zap.String("sessionID", sessionID),
+			zap.String("tabID", tabID),
+			zap.Error(err))
+	}
+
+	p.logger.Info("switched tab", zap.String("sessionID", sessionID), zap.String("tabID", tabID))
+	described := describeTab(tab, true)
+	return &described, nil
+}
+
+// OpenTab opens a new tab, makes it the active tab and, when url is set,
+// navigates it there.
+func (p *playwrightImpl) OpenTab(ctx context.Context, sessionID, url, waitUntil string, timeout time.Duration) (*Tab, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := session.Context.NewPage()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open tab: %w", err)
+	}
+	tab := p.addTab(session, page)
+
+	session.tabsMux.Lock()
+	session.Page = page
+	session.tabsMux.Unlock()
+
+	p.logger.Info("opened tab", zap.String("sessionID", sessionID), zap.String("tabID", tab.id))
+
+	if url != "" {
+		timeoutMs := float64(timeout.Milliseconds())
+		if _, err := page.Goto(url, playwright.PageGotoOptions{
+			WaitUntil: waitUntilState(waitUntil),
+			Timeout:   &timeoutMs,
+		}); err != nil {
+			return nil, fmt.Errorf("opened %s but navigation failed: %w", tab.id, err)
+		}
+	}
+
+	described := describeTab(tab, true)
+	return &described, nil
+}
+
+// CloseTab closes tabID, or the active tab when tabID is empty, and returns
+// the tab that is active afterwards. The last tab cannot be closed; closing
+// the browser session is the way to end it.
+func (p *playwrightImpl) CloseTab(ctx context.Context, sessionID, tabID string) (*Tab, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	session.tabsMux.RLock()
+	tab := session.tabByPage(session.Page)
+	if tabID != "" {
+		tab = session.tabByID(tabID)
+	}
+	remaining := len(session.tabs)
+	session.tabsMux.RUnlock()
+
+	if tab == nil {
+		return nil, fmt.Errorf("tab not found: %s", tabID)
+	}
+	if remaining <= 1 {
+		return nil, fmt.Errorf("cannot close %s: it is the last open tab", tab.id)
+	}
+
+	if err := tab.page.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close %s: %w", tab.id, err)
+	}
+	// The close event may not have been dispatched yet
+	p.removeTab(session, tab.page)
+
+	p.logger.Info("closed tab", zap.String("sessionID", sessionID), zap.String("tabID", tab.id))
+
+	tabs, active := snapshotTabs(session)
+	for _, remainingTab := range tabs {
+		if remainingTab.page == active {
+			described := describeTab(remainingTab, true)
+			return &described, nil
+		}
+	}
+	return nil, fmt.Errorf("no active tab after closing %s", tab.id)
+}
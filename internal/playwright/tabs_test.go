@@ -0,0 +1,74 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestTabsFollowPopups(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `<title>Home</title><a id="open" href="/popup" target="_blank">Open</a>`)
+	})
+	mux.HandleFunc("/popup", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `<title>Popup</title><h1 id="popup">Popup</h1>`)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium"},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	ctx := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: t.Name()})
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL, "load", 10*time.Second)
+	require.NoError(t, err)
+	require.NoError(t, service.ClickElement(ctx, session.ID, "#open", map[string]any{}))
+
+	require.Eventually(t, func() bool {
+		tabs, err := service.ListTabs(ctx, session.ID)
+		return err == nil && len(tabs) == 2 && tabs[1].Active
+	}, 10*time.Second, 100*time.Millisecond, "the popup should become the active tab")
+
+	tabs, err := service.ListTabs(ctx, session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tabs[0].ID, tabs[1].OpenerID)
+	_, err = service.WaitForCondition(ctx, session.ID, "selector", WaitOptions{Selector: "#popup", State: "visible", Timeout: 10 * time.Second})
+	require.NoError(t, err)
+
+	active, err := service.CloseTab(ctx, session.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, tabs[0].ID, active.ID, "closing a popup returns to its opener")
+
+	_, err = service.CloseTab(ctx, session.ID, "")
+	assert.ErrorContains(t, err, "last open tab")
+
+	opened, err := service.OpenTab(ctx, session.ID, srv.URL+"/popup", "load", 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "Popup", opened.Title)
+
+	switched, err := service.SwitchTab(ctx, session.ID, tabs[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Home", switched.Title)
+	assert.Equal(t, srv.URL+"/", session.ActivePage().URL())
+}
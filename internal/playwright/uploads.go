@@ -0,0 +1,54 @@
+package playwright
+
+import (
+	"fmt"
+
+	playwright "github.com/mxschmitt/playwright-go"
+)
+
+// UploadFile is a file to put on a file input, with its content in memory.
+// File fields of FillForm carry them under the "files" key.
+type UploadFile struct {
+	Name     string
+	MimeType string
+	Buffer   []byte
+}
+
+// isFileInputScript reports whether an element is an <input type=file>
+const isFileInputScript = `el => el instanceof HTMLInputElement && el.type === 'file'`
+
+// setInputFiles puts files on the element locator points at. A file input
+// gets them directly; any other element is taken for a custom upload widget
+// and clicked, and the files go to the file chooser it opens.
+func setInputFiles(page playwright.Page, locator playwright.Locator, files []UploadFile) error {
+	if len(files) == 0 {
+		return fmt.Errorf("no files to upload")
+	}
+	inputFiles := make([]playwright.InputFile, 0, len(files))
+	for _, file := range files {
+		inputFiles = append(inputFiles, playwright.InputFile{
+			Name:     file.Name,
+			MimeType: file.MimeType,
+			Buffer:   file.Buffer,
+		})
+	}
+
+	isInput, err := locator.Evaluate(isFileInputScript, nil)
+	if err != nil {
+		return err
+	}
+	if isInput == true {
+		return locator.SetInputFiles(inputFiles)
+	}
+
+	chooser, err := page.ExpectFileChooser(func() error {
+		return locator.Click()
+	})
+	if err != nil {
+		return fmt.Errorf("element is not a file input and clicking it opened no file chooser: %w", err)
+	}
+	if len(inputFiles) > 1 && !chooser.IsMultiple() {
+		return fmt.Errorf("the file chooser accepts a single file, got %d", len(inputFiles))
+	}
+	return chooser.SetFiles(inputFiles)
+}
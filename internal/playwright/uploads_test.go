@@ -0,0 +1,54 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestFillFormUploadsFiles(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `
+<input id="resume" type="file">
+<input id="hidden" type="file" multiple style="display:none">
+<button id="dropzone" onclick="document.getElementById('hidden').click()">Drop files here</button>`)
+	}))
+	defer srv.Close()
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium", DataDir: t.TempDir()},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	ctx := context.Background()
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL, "load", 10*time.Second)
+	require.NoError(t, err)
+
+	resume := UploadFile{Name: "resume.pdf", MimeType: "application/pdf", Buffer: []byte("%PDF-1.4")}
+	photo := UploadFile{Name: "photo.png", MimeType: "image/png", Buffer: []byte("png")}
+	_, err = service.FillForm(ctx, session.ID, []map[string]any{
+		{"selector": "#resume", "type": "file", "files": []UploadFile{resume}},
+		{"selector": "#dropzone", "type": "file", "files": []UploadFile{resume, photo}},
+	}, false, "")
+	require.NoError(t, err)
+
+	names, err := service.ExecuteScript(ctx, session.ID,
+		`() => ['resume', 'hidden'].map(id => [...document.getElementById(id).files].map(f => f.name).join(','))`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"resume.pdf", "resume.pdf,photo.png"}, names,
+		"the file input is set directly and the widget's chooser gets both files")
+}
@@ -0,0 +1,226 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	playwright "github.com/mxschmitt/playwright-go"
+	zap "go.uber.org/zap"
+)
+
+// Conditions of WaitForCondition
+const (
+	WaitSelector    = "selector"
+	WaitNavigation  = "navigation"
+	WaitFunction    = "function"
+	WaitTimeout     = "timeout"
+	WaitNetworkIdle = "networkidle"
+	WaitResponse    = "response"
+	WaitText        = "text"
+	WaitURL         = "url"
+)
+
+// WaitConditions are the conditions WaitForCondition accepts
+var WaitConditions = []string{
+	WaitSelector, WaitNavigation, WaitFunction, WaitTimeout, WaitNetworkIdle, WaitResponse, WaitText, WaitURL,
+}
+
+// WaitOptions configures WaitForCondition. Each condition reads the fields
+// it needs.
+type WaitOptions struct {
+	// Selector is the element the selector condition waits for
+	Selector string
+	// Frame names the frame the selector and text conditions look in; see
+	// locate
+	Frame string
+	// State is visible (the default), hidden, attached or detached, for the
+	// selector and text conditions
+	State string
+	// CustomFunction is the script the function condition waits to return
+	// a truthy value
+	CustomFunction string
+	// URLPattern is a glob, a /regex/ or a substring of the URL waited for
+	// by the navigation, url and response conditions
+	URLPattern string
+	// Status is the HTTP status the response condition waits for; any
+	// status matches when 0
+	Status int
+	// Text is the text the text condition waits for, matched as a case
+	// insensitive substring
+	Text string
+	// WaitUntil is the load state the navigation condition waits for:
+	// domcontentloaded, load (the default) or networkidle
+	WaitUntil string
+	Timeout   time.Duration
+}
+
+// WaitResult describes what a wait ended on
+type WaitResult struct {
+	// URL is the page URL, or for the response condition the URL of the
+	// matching response
+	URL string `json:"url"`
+	// Status is the status of the matching response, for the response
+	// condition
+	Status int `json:"status,omitempty"`
+}
+
+// WaitForCondition waits for a condition on the active tab:
+//   - selector: the element Selector names reaches State
+//   - navigation: the page navigates to a URL matching URLPattern, then
+//     reaches the WaitUntil load state. Without URLPattern only the load
+//     state is waited for, which covers a navigation already under way.
+//   - url: the URL matches URLPattern, including changes made with the
+//     History API that load nothing
+//   - response: a response whose URL matches URLPattern arrives, with
+//     Status when set
+//   - text: Text appears, or with State hidden disappears
+//   - function: CustomFunction returns a truthy value
+//   - networkidle: there are no network connections for 500ms
+//   - timeout: Timeout passes
+func (p *playwrightImpl) WaitForCondition(ctx context.Context, sessionID, condition string, options WaitOptions) (*WaitResult, error) {
+	session, err := p.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	page := session.ActivePage()
+	timeoutMs := float64(options.Timeout.Milliseconds())
+
+	p.logger.Info("waiting for condition", zap.String("sessionID", sessionID), zap.String("condition", condition))
+
+	switch condition {
+	case WaitSelector:
+		if err := p.waitForLocator(page, options.Frame, options.Selector, options.State, timeoutMs); err != nil {
+			return nil, err
+		}
+
+	case WaitText:
+		if options.Text == "" {
+			return nil, fmt.Errorf("text is required for the text condition")
+		}
+		// Matches like getByText, a case insensitive substring, in the
+		// first element that has it
+		selector := "internal:text=" + strconv.Quote(options.Text) + "i >> nth=0"
+		if err := p.waitForLocator(page, options.Frame, selector, options.State, timeoutMs); err != nil {
+			return nil, err
+		}
+
+	case WaitNavigation:
+		if options.URLPattern == "" {
+			if err := page.WaitForLoadState(playwright.PageWaitForLoadStateOptions{
+				State:   loadState(options.WaitUntil),
+				Timeout: &timeoutMs,
+			}); err != nil {
+				return nil, err
+			}
+			break
+		}
+		if err := waitForURL(page, options.URLPattern, waitUntilState(options.WaitUntil), timeoutMs); err != nil {
+			return nil, err
+		}
+
+	case WaitURL:
+		if options.URLPattern == "" {
+			return nil, fmt.Errorf("url pattern is required for the url condition")
+		}
+		if err := waitForURL(page, options.URLPattern, playwright.WaitUntilStateCommit, timeoutMs); err != nil {
+			return nil, err
+		}
+
+	case WaitResponse:
+		if options.URLPattern == "" {
+			return nil, fmt.Errorf("url pattern is required for the response condition")
+		}
+		match, err := urlMatcher(options.URLPattern)
+		if err != nil {
+			return nil, err
+		}
+		response, err := page.ExpectResponse(func(response playwright.Response) bool {
+			return match(response.URL()) && (options.Status == 0 || response.Status() == options.Status)
+		}, nil, playwright.PageExpectResponseOptions{Timeout: &timeoutMs})
+		if err != nil {
+			return nil, err
+		}
+		return &WaitResult{URL: response.URL(), Status: response.Status()}, nil
+
+	case WaitFunction:
+		if options.CustomFunction == "" {
+			return nil, fmt.Errorf("custom function is required for function condition")
+		}
+		if _, err := page.WaitForFunction(options.CustomFunction, playwright.PageWaitForFunctionOptions{
+			Timeout: &timeoutMs,
+		}); err != nil {
+			return nil, err
+		}
+
+	case WaitTimeout:
+		if err := sleep(ctx, options.Timeout); err != nil {
+			return nil, err
+		}
+
+	case WaitNetworkIdle:
+		if err := page.WaitForLoadState(playwright.PageWaitForLoadStateOptions{
+			State:   playwright.LoadStateNetworkidle,
+			Timeout: &timeoutMs,
+		}); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported condition type: %s", condition)
+	}
+
+	return &WaitResult{URL: page.URL()}, nil
+}
+
+// waitForLocator waits for the element selector names in frame to reach
+// state
+func (p *playwrightImpl) waitForLocator(page playwright.Page, frame, selector, state string, timeoutMs float64) error {
+	var waitState *playwright.WaitForSelectorState
+	switch state {
+	case "hidden":
+		waitState = playwright.WaitForSelectorStateHidden
+	case "attached":
+		waitState = playwright.WaitForSelectorStateAttached
+	case "detached":
+		waitState = playwright.WaitForSelectorStateDetached
+	default:
+		waitState = playwright.WaitForSelectorStateVisible
+	}
+
+	locator, err := p.locate(page, frame, selector)
+	if err != nil {
+		return err
+	}
+	return locator.WaitFor(playwright.LocatorWaitForOptions{
+		State:   waitState,
+		Timeout: &timeoutMs,
+	})
+}
+
+// waitForURL waits for the page URL to match pattern and for the page to
+// reach state
+func waitForURL(page playwright.Page, pattern string, state *playwright.WaitUntilState, timeoutMs float64) error {
+	match, err := urlMatcher(pattern)
+	if err != nil {
+		return err
+	}
+	return page.WaitForURL(match, playwright.PageWaitForURLOptions{
+		WaitUntil: state,
+		Timeout:   &timeoutMs,
+	})
+}
+
+// loadState maps a wait_until value to Playwright's load state, defaulting
+// to load
+func loadState(waitUntil string) *playwright.LoadState {
+	switch waitUntil {
+	case "domcontentloaded":
+		return playwright.LoadStateDomcontentloaded
+	case "networkidle":
+		return playwright.LoadStateNetworkidle
+	default:
+		return playwright.LoadStateLoad
+	}
+}
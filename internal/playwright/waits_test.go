@@ -0,0 +1,74 @@
+package playwright
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestWaitForCondition(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/orders":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprint(w, `{"id": 1}`)
+		case "/done":
+			_, _ = fmt.Fprint(w, `<title>Done</title>`)
+		default:
+			_, _ = fmt.Fprint(w, `
+<button id="order" onclick="setTimeout(() => fetch('/api/orders', { method: 'POST' }).then(() => {
+  document.body.insertAdjacentHTML('beforeend', '<p>Order PLACED</p>');
+  history.pushState({}, '', '/orders/1');
+}), 300)">Order</button>
+<a id="next" href="/done" onclick="event.preventDefault(); setTimeout(() => location.assign('/done'), 300)">Next</a>`)
+		}
+	}))
+	defer srv.Close()
+
+	service := newPlaywrightServiceOrSkip(t, zap.NewNop(), &config.Config{
+		Browser: config.BrowserConfig{Headless: true, Engine: "chromium", DataDir: t.TempDir()},
+	})
+	defer func() {
+		assert.NoError(t, service.Shutdown(context.Background()))
+	}()
+
+	ctx := context.Background()
+	session, err := service.GetOrCreateTaskSession(ctx)
+	require.NoError(t, err)
+	_, err = service.NavigateToURL(ctx, session.ID, srv.URL, "load", 10*time.Second)
+	require.NoError(t, err)
+
+	require.NoError(t, service.ClickElement(ctx, session.ID, "#order", map[string]any{}))
+	result, err := service.WaitForCondition(ctx, session.ID, WaitResponse, WaitOptions{
+		URLPattern: "**/api/orders", Status: http.StatusCreated, Timeout: 5 * time.Second,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL+"/api/orders", result.URL)
+	assert.Equal(t, http.StatusCreated, result.Status)
+
+	_, err = service.WaitForCondition(ctx, session.ID, WaitText, WaitOptions{Text: "order placed", Timeout: 5 * time.Second})
+	require.NoError(t, err)
+	result, err = service.WaitForCondition(ctx, session.ID, WaitURL, WaitOptions{URLPattern: "/orders/1", Timeout: 5 * time.Second})
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL+"/orders/1", result.URL)
+
+	require.NoError(t, service.ClickElement(ctx, session.ID, "#next", map[string]any{}))
+	started := time.Now()
+	result, err = service.WaitForCondition(ctx, session.ID, WaitNavigation, WaitOptions{URLPattern: "**/done", Timeout: 10 * time.Second})
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL+"/done", result.URL)
+	assert.Less(t, time.Since(started), 5*time.Second, "navigation returns once the page loads, not after the timeout")
+
+	_, err = service.WaitForCondition(ctx, session.ID, WaitText, WaitOptions{Text: "never shown", Timeout: 500 * time.Millisecond})
+	assert.Error(t, err)
+}
@@ -0,0 +1,342 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+// schemes are the proxy protocols both Playwright and net/http support
+var schemes = []string{"http", "https", "socks5"}
+
+// fetchAssignmentTTL is how long a task that only makes Fetch calls keeps
+// its proxy after its last call. Tasks with a browser session keep theirs
+// until the session is closed.
+const fetchAssignmentTTL = 10 * time.Minute
+
+// Settings is a proxy server with the hosts that bypass it and the
+// credentials it requires
+type Settings struct {
+	// Server is a proxy URL such as http://proxy.internal:3128 or
+	// socks5://127.0.0.1:1080
+	Server string `json:"server"`
+	// Bypass lists hosts reached directly: a host name, a host:port, or a
+	// domain starting with "." or "*." for all its subdomains
+	Bypass   []string `json:"bypass,omitempty"`
+	Username string   `json:"username,omitempty"`
+	Password string   `json:"-"`
+}
+
+// NewSettings validates a proxy server and normalizes it to a URL. A server
+// without a scheme is an HTTP proxy. Credentials in the URL are moved to
+// Username and Password unless those are given.
+func NewSettings(server string, bypass []string, username, password string) (*Settings, error) {
+	server = strings.TrimSpace(server)
+	if server == "" {
+		return nil, fmt.Errorf("proxy server is required")
+	}
+	if !strings.Contains(server, "://") {
+		server = "http://" + server
+	}
+	parsed, err := url.Parse(server)
+	if err != nil || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid proxy server %q: use a URL such as http://proxy.example.com:3128", server)
+	}
+	scheme := strings.ToLower(parsed.Scheme)
+	if !slices.Contains(schemes, scheme) {
+		return nil, fmt.Errorf("unsupported proxy scheme %q: use one of %v", parsed.Scheme, schemes)
+	}
+	if parsed.User != nil {
+		if username == "" {
+			username = parsed.User.Username()
+		}
+		if password == "" {
+			password, _ = parsed.User.Password()
+		}
+	}
+
+	settings := &Settings{
+		Server:   scheme + "://" + parsed.Host,
+		Username: username,
+		Password: password,
+	}
+	for _, host := range bypass {
+		if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+			settings.Bypass = append(settings.Bypass, host)
+		}
+	}
+	return settings, nil
+}
+
+// URL returns the proxy URL with its credentials, for net/http
+func (s *Settings) URL() *url.URL {
+	parsed, _ := url.Parse(s.Server)
+	if s.Username != "" {
+		parsed.User = url.UserPassword(s.Username, s.Password)
+	}
+	return parsed
+}
+
+// Bypasses reports whether requests to host, with an optional port, go
+// around the proxy
+func (s *Settings) Bypasses(host string) bool {
+	host = strings.ToLower(host)
+	hostname := host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		hostname = h
+	}
+	for _, entry := range s.Bypass {
+		switch {
+		case entry == "*":
+			return true
+		case strings.HasPrefix(entry, "*."), strings.HasPrefix(entry, "."):
+			domain := strings.TrimPrefix(strings.TrimPrefix(entry, "*"), ".")
+			if hostname == domain || strings.HasSuffix(hostname, "."+domain) {
+				return true
+			}
+		case entry == hostname, entry == host:
+			return true
+		}
+	}
+	return false
+}
+
+// Router decides which proxy each task's browser context and Fetch
+// requests go through. A task keeps the proxy it was first given until it
+// is released, so its browser and its Fetch calls share one exit address.
+// New tasks take the configured proxies in turn; a task may also switch to
+// another configured or allowed proxy, or connect directly.
+type Router struct {
+	logger *zap.Logger
+	pool   []*Settings
+	// allowed are proxies tasks may switch to besides the pool
+	allowed []*Settings
+
+	mu   sync.Mutex
+	next int
+	// tasks maps a task to its proxy
+	tasks map[string]*assignment
+}
+
+// assignment is the proxy of a task; a nil proxy connects directly
+type assignment struct {
+	settings *Settings
+	// pinned assignments belong to a browser session and are kept until
+	// the task is released; the others only serve Fetch calls and expire
+	// fetchAssignmentTTL after they were last used
+	pinned bool
+	used   time.Time
+}
+
+// NewRouter creates a router from BROWSER_PROXY_SERVER and
+// BROWSER_PROXY_ROTATION. The pool is the server followed by the rotation
+// list; BROWSER_PROXY_BYPASS applies to every proxy, and
+// BROWSER_PROXY_USERNAME and BROWSER_PROXY_PASSWORD to those without
+// credentials of their own. Without either setting tasks connect directly
+// unless they set a proxy. BROWSER_PROXY_ALLOWED lists further proxies
+// tasks may set, with the same bypass list but no shared credentials.
+func NewRouter(logger *zap.Logger, cfg *config.Config) (*Router, error) {
+	bypass := strings.Split(cfg.Browser.ProxyBypass, ",")
+	pool, err := parseServers(append([]string{cfg.Browser.ProxyServer}, strings.Split(cfg.Browser.ProxyRotation, ",")...), bypass)
+	if err != nil {
+		return nil, err
+	}
+	for _, settings := range pool {
+		if settings.Username == "" {
+			settings.Username = cfg.Browser.ProxyUsername
+			settings.Password = cfg.Browser.ProxyPassword
+		}
+	}
+	allowed, err := parseServers(strings.Split(cfg.Browser.ProxyAllowed, ","), bypass)
+	if err != nil {
+		return nil, fmt.Errorf("invalid BROWSER_PROXY_ALLOWED: %w", err)
+	}
+
+	if len(pool) > 0 || len(allowed) > 0 {
+		logger.Info("proxy settings loaded",
+			zap.Int("pool", len(pool)),
+			zap.Int("allowed", len(allowed)))
+	}
+	return &Router{
+		logger:  logger,
+		pool:    pool,
+		allowed: allowed,
+		tasks:   map[string]*assignment{},
+	}, nil
+}
+
+// parseServers validates a list of proxy servers, skipping empty entries
+func parseServers(servers []string, bypass []string) ([]*Settings, error) {
+	var parsed []*Settings
+	for _, server := range servers {
+		if strings.TrimSpace(server) == "" {
+			continue
+		}
+		settings, err := NewSettings(server, bypass, "", "")
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, settings)
+	}
+	return parsed, nil
+}
+
+// Pool returns the configured proxies
+func (r *Router) Pool() []*Settings {
+	return r.pool
+}
+
+// ForTask returns the proxy of the task's browser session, giving the task
+// the next proxy of the pool if it has none yet. nil means a direct
+// connection. The task keeps the proxy until it is released. An empty task
+// ID gets the first proxy without being remembered.
+func (r *Router) ForTask(taskID string) *Settings {
+	settings, _ := r.forTask(taskID, true)
+	return settings
+}
+
+// forTask is ForTask that also reports whether the proxy was decided for
+// the task, rather than there being no proxy configured at all. Unless pin
+// is set, a new assignment expires once the task stops using it.
+func (r *Router) forTask(taskID string, pin bool) (*Settings, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.expire(now)
+	if current, ok := r.tasks[taskID]; ok {
+		current.pinned = current.pinned || pin
+		current.used = now
+		return current.settings, true
+	}
+	if len(r.pool) == 0 {
+		return nil, false
+	}
+	if taskID == "" {
+		return r.pool[0], true
+	}
+	settings := r.takeNext()
+	r.tasks[taskID] = &assignment{settings: settings, pinned: pin, used: now}
+	return settings, true
+}
+
+// expire forgets the Fetch-only assignments unused for fetchAssignmentTTL;
+// r.mu must be held
+func (r *Router) expire(now time.Time) {
+	for taskID, current := range r.tasks {
+		if !current.pinned && now.Sub(current.used) > fetchAssignmentTTL {
+			delete(r.tasks, taskID)
+			r.logger.Debug("released idle proxy assignment", zap.String("taskID", taskID))
+		}
+	}
+}
+
+// Set makes the task use settings until it is released; nil connects
+// directly. Callers check settings with Permit first.
+func (r *Router) Set(taskID string, settings *Settings) {
+	r.mu.Lock()
+	r.tasks[taskID] = &assignment{settings: settings, pinned: true, used: time.Now()}
+	r.mu.Unlock()
+}
+
+// Permit returns the proxy a task may switch to for settings, which must
+// name a server of the pool or of BROWSER_PROXY_ALLOWED. Credentials and
+// bypass hosts missing from settings are taken from the configured entry.
+func (r *Router) Permit(settings *Settings) (*Settings, error) {
+	known := append(slices.Clone(r.pool), r.allowed...)
+	for _, entry := range known {
+		if entry.Server != settings.Server {
+			continue
+		}
+		if settings.Username == "" && len(settings.Bypass) == 0 {
+			return entry, nil
+		}
+		permitted := *settings
+		if permitted.Username == "" {
+			permitted.Username, permitted.Password = entry.Username, entry.Password
+		}
+		if len(permitted.Bypass) == 0 {
+			permitted.Bypass = entry.Bypass
+		}
+		return &permitted, nil
+	}
+
+	if len(known) == 0 {
+		return nil, fmt.Errorf("proxy %s is not allowed: no proxies are configured; set BROWSER_PROXY_SERVER, BROWSER_PROXY_ROTATION or BROWSER_PROXY_ALLOWED", settings.Server)
+	}
+	servers := make([]string, len(known))
+	for i, entry := range known {
+		servers[i] = entry.Server
+	}
+	return nil, fmt.Errorf("proxy %s is not allowed: use one of %s", settings.Server, strings.Join(servers, ", "))
+}
+
+// Rotate moves the task to the next proxy of the pool and returns it
+func (r *Router) Rotate(taskID string) (*Settings, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.pool) == 0 {
+		return nil, fmt.Errorf("no proxies configured to rotate through; set BROWSER_PROXY_SERVER or BROWSER_PROXY_ROTATION")
+	}
+
+	var current *Settings
+	if assigned, ok := r.tasks[taskID]; ok {
+		current = assigned.settings
+	}
+	settings := r.takeNext()
+	if settings == current && len(r.pool) > 1 {
+		settings = r.takeNext()
+	}
+	r.tasks[taskID] = &assignment{settings: settings, pinned: true, used: time.Now()}
+	return settings, nil
+}
+
+// takeNext returns the next proxy of the pool; r.mu must be held
+func (r *Router) takeNext() *Settings {
+	settings := r.pool[r.next%len(r.pool)]
+	r.next++
+	return settings
+}
+
+// Release forgets the task's proxy once the task is finished
+func (r *Router) Release(taskID string) {
+	r.mu.Lock()
+	delete(r.tasks, taskID)
+	r.mu.Unlock()
+}
+
+// HTTPProxy is an http.Transport Proxy function that sends a request
+// through the proxy of the task in its context. When no proxy is
+// configured and the task has not set one, it falls back to the HTTP_PROXY
+// and HTTPS_PROXY environment variables.
+func (r *Router) HTTPProxy(req *http.Request) (*url.URL, error) {
+	settings, decided := r.forTask(TaskID(req.Context()), false)
+	if !decided {
+		return http.ProxyFromEnvironment(req)
+	}
+	if settings == nil || settings.Bypasses(req.URL.Host) {
+		return nil, nil
+	}
+	return settings.URL(), nil
+}
+
+// TaskID returns the ID of the A2A task ctx belongs to, or ""
+func TaskID(ctx context.Context) string {
+	if task, ok := ctx.Value(server.TaskContextKey).(*types.Task); ok && task != nil {
+		return task.ID
+	}
+	return ""
+}
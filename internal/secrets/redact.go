@@ -0,0 +1,100 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	zap "go.uber.org/zap"
+	zapcore "go.uber.org/zap/zapcore"
+
+	server "github.com/inference-gateway/adk/server"
+)
+
+// RedactLogger returns a logger that masks resolved secrets in messages and
+// string, error and stringer fields, including those logged by the ADK.
+func RedactLogger(logger *zap.Logger, store CredentialStore) *zap.Logger {
+	return logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return &redactingCore{Core: core, store: store}
+	}))
+}
+
+type redactingCore struct {
+	zapcore.Core
+	store CredentialStore
+}
+
+func (c *redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactingCore{Core: c.Core.With(c.redactFields(fields)), store: c.store}
+}
+
+func (c *redactingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *redactingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	entry.Message = c.store.Redact(entry.Message)
+	return c.Core.Write(entry, c.redactFields(fields))
+}
+
+func (c *redactingCore) redactFields(fields []zapcore.Field) []zapcore.Field {
+	redacted := make([]zapcore.Field, len(fields))
+	for i, field := range fields {
+		redacted[i] = c.redactField(field)
+	}
+	return redacted
+}
+
+func (c *redactingCore) redactField(field zapcore.Field) zapcore.Field {
+	switch field.Type {
+	case zapcore.StringType:
+		field.String = c.store.Redact(field.String)
+	case zapcore.ErrorType:
+		if err, ok := field.Interface.(error); ok {
+			if masked := c.store.Redact(err.Error()); masked != err.Error() {
+				return zap.String(field.Key, masked)
+			}
+		}
+	case zapcore.StringerType:
+		if stringer, ok := field.Interface.(fmt.Stringer); ok {
+			if masked := c.store.Redact(stringer.String()); masked != stringer.String() {
+				return zap.String(field.Key, masked)
+			}
+		}
+	case zapcore.ReflectType:
+		if encoded, err := json.Marshal(field.Interface); err == nil {
+			if masked := c.store.Redact(string(encoded)); masked != string(encoded) {
+				return zap.Any(field.Key, json.RawMessage(masked))
+			}
+		}
+	}
+	return field
+}
+
+// RedactToolBox wraps a toolbox so tool results and errors are passed
+// through Redact before they reach the model. This covers every tool, since
+// a resolved password can come back through extract_data or execute_script
+// as easily as through the tool that filled it in.
+func RedactToolBox(toolBox server.ToolBox, store CredentialStore) server.ToolBox {
+	return &redactingToolBox{ToolBox: toolBox, store: store}
+}
+
+type redactingToolBox struct {
+	server.ToolBox
+	store CredentialStore
+}
+
+func (tb *redactingToolBox) ExecuteTool(ctx context.Context, toolName string, arguments map[string]any) (string, error) {
+	result, err := tb.ToolBox.ExecuteTool(ctx, toolName, arguments)
+	result = tb.store.Redact(result)
+	if err != nil {
+		if masked := tb.store.Redact(err.Error()); masked != err.Error() {
+			err = errors.New(masked)
+		}
+	}
+	return result, err
+}
@@ -0,0 +1,303 @@
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	zap "go.uber.org/zap"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+// RefScheme prefixes every credential reference, e.g. vault://github-bot
+const RefScheme = "vault://"
+
+// Redacted replaces resolved secret values in tool output and logs
+const Redacted = "[REDACTED]"
+
+// minRedactLength keeps very short values (e.g. a username like "bob") from
+// turning ordinary words in page content into redaction markers.
+const minRedactLength = 4
+
+var credentialNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// Credential is a named set of secret fields such as username and password
+type Credential map[string]string
+
+// Username returns the credential's username field
+func (c Credential) Username() string { return c["username"] }
+
+// Password returns the credential's password field
+func (c Credential) Password() string { return c["password"] }
+
+// CredentialStore resolves vault:// references server-side so raw secrets
+// never have to pass through the model. Every value handed out by Resolve or
+// ResolveValue is remembered and masked by Redact from then on.
+type CredentialStore interface {
+	// Resolve returns the whole credential for a reference like vault://github-bot
+	Resolve(ref string) (Credential, error)
+	// ResolveValue returns a single field for a reference like
+	// vault://github-bot#password; the field defaults to password.
+	ResolveValue(ref string) (string, error)
+	// Names lists the stored credential names, never their values
+	Names() []string
+	// Redact masks every value resolved so far
+	Redact(s string) string
+}
+
+// vault is the in-memory CredentialStore
+type vault struct {
+	credentials map[string]Credential
+
+	mu       sync.RWMutex
+	resolved map[string]struct{}
+	replacer *strings.Replacer
+}
+
+// NewCredentialStore loads the credential vault from BROWSER_CREDENTIALS_PATH.
+// The path may be a JSON file mapping names to fields:
+//
+//	{"github-bot": {"username": "bot", "password": "..."}}
+//
+// or a directory of mounted secrets with one sub-directory per credential and
+// one file per field (github-bot/username, github-bot/password), which is
+// how Kubernetes and Docker mount secrets. An unset path yields an empty
+// store, so credential references fail with a clear error.
+func NewCredentialStore(logger *zap.Logger, cfg *config.Config) (CredentialStore, error) {
+	path := strings.TrimSpace(cfg.Browser.CredentialsPath)
+	if path == "" {
+		logger.Info("no credential vault configured, credential references are disabled")
+		return NewVault(nil), nil
+	}
+
+	credentials, err := load(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load credential vault from %s: %w", path, err)
+	}
+
+	store := NewVault(credentials)
+	logger.Info("loaded credential vault",
+		zap.String("path", path),
+		zap.Strings("credentials", store.Names()))
+	return store, nil
+}
+
+// NewVault creates a CredentialStore from credentials already in memory
+func NewVault(credentials map[string]Credential) CredentialStore {
+	if credentials == nil {
+		credentials = map[string]Credential{}
+	}
+	return &vault{
+		credentials: credentials,
+		resolved:    map[string]struct{}{},
+	}
+}
+
+// Configured reports whether store holds any credentials. The store of an
+// agent started without BROWSER_CREDENTIALS_PATH is empty rather than nil.
+func Configured(store CredentialStore) bool {
+	return store != nil && len(store.Names()) > 0
+}
+
+// IsRef reports whether s is a credential reference
+func IsRef(s string) bool {
+	return strings.HasPrefix(s, RefScheme)
+}
+
+// ParseRef splits a reference into its credential name and optional field
+func ParseRef(ref string) (name, field string, err error) {
+	if !IsRef(ref) {
+		return "", "", fmt.Errorf("invalid credential reference %q: expected %s<name>", ref, RefScheme)
+	}
+	name, field, _ = strings.Cut(strings.TrimPrefix(ref, RefScheme), "#")
+	if !credentialNamePattern.MatchString(name) {
+		return "", "", fmt.Errorf("invalid credential name in reference %q", ref)
+	}
+	return name, field, nil
+}
+
+func (v *vault) Resolve(ref string) (Credential, error) {
+	name, _, err := ParseRef(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, ok := v.credentials[name]
+	if !ok {
+		return nil, fmt.Errorf("credential %q not found in vault", name)
+	}
+
+	v.markResolved(credential)
+	return credential, nil
+}
+
+func (v *vault) ResolveValue(ref string) (string, error) {
+	name, field, err := ParseRef(ref)
+	if err != nil {
+		return "", err
+	}
+	if field == "" {
+		field = "password"
+	}
+
+	credential, ok := v.credentials[name]
+	if !ok {
+		return "", fmt.Errorf("credential %q not found in vault", name)
+	}
+	value, ok := credential[field]
+	if !ok {
+		return "", fmt.Errorf("credential %q has no field %q", name, field)
+	}
+
+	v.markResolved(credential)
+	return value, nil
+}
+
+func (v *vault) Names() []string {
+	names := make([]string, 0, len(v.credentials))
+	for name := range v.credentials {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (v *vault) Redact(s string) string {
+	v.mu.RLock()
+	replacer := v.replacer
+	v.mu.RUnlock()
+
+	if replacer == nil || s == "" {
+		return s
+	}
+	return replacer.Replace(s)
+}
+
+// markResolved adds every field of a handed-out credential to the redaction
+// set. All fields are masked, not just the one asked for, since a page may
+// echo any of them back.
+func (v *vault) markResolved(credential Credential) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	changed := false
+	for _, value := range credential {
+		for _, variant := range redactionVariants(value) {
+			if _, ok := v.resolved[variant]; !ok {
+				v.resolved[variant] = struct{}{}
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		return
+	}
+
+	// Longest first so a secret that contains another is masked whole
+	values := make([]string, 0, len(v.resolved))
+	for value := range v.resolved {
+		values = append(values, value)
+	}
+	slices.SortFunc(values, func(a, b string) int { return len(b) - len(a) })
+
+	pairs := make([]string, 0, 2*len(values))
+	for _, value := range values {
+		pairs = append(pairs, value, Redacted)
+	}
+	v.replacer = strings.NewReplacer(pairs...)
+}
+
+// redactionVariants returns the forms a secret can take in output: as is,
+// and JSON-escaped as it appears inside marshalled tool responses.
+func redactionVariants(value string) []string {
+	if len(value) < minRedactLength {
+		return nil
+	}
+	variants := []string{value}
+	if encoded, err := json.Marshal(value); err == nil {
+		if escaped := string(encoded[1 : len(encoded)-1]); escaped != value {
+			variants = append(variants, escaped)
+		}
+	}
+	return variants
+}
+
+// load reads credentials from a JSON file or a directory of mounted secrets
+func load(path string) (map[string]Credential, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return loadDir(path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var credentials map[string]Credential
+	if err := json.Unmarshal(data, &credentials); err != nil {
+		return nil, fmt.Errorf("invalid credential file: %w", err)
+	}
+	for name := range credentials {
+		if !credentialNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("invalid credential name %q", name)
+		}
+	}
+	return credentials, nil
+}
+
+// loadDir reads <dir>/<name>/<field> files. Entries starting with a dot are
+// skipped, which also covers the ..data links Kubernetes adds to secret
+// volumes. Entries are resolved with os.Stat because mounted secret files
+// are usually symlinks.
+func loadDir(dir string) (map[string]Credential, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := map[string]Credential{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") {
+			continue
+		}
+		credentialDir := filepath.Join(dir, name)
+		if info, err := os.Stat(credentialDir); err != nil || !info.IsDir() {
+			continue
+		}
+		if !credentialNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("invalid credential name %q", name)
+		}
+
+		fields, err := os.ReadDir(credentialDir)
+		if err != nil {
+			return nil, err
+		}
+		credential := Credential{}
+		for _, field := range fields {
+			fieldPath := filepath.Join(credentialDir, field.Name())
+			if strings.HasPrefix(field.Name(), ".") {
+				continue
+			}
+			if info, err := os.Stat(fieldPath); err != nil || info.IsDir() {
+				continue
+			}
+			data, err := os.ReadFile(fieldPath)
+			if err != nil {
+				return nil, err
+			}
+			credential[field.Name()] = strings.TrimRight(string(data), "\r\n")
+		}
+		credentials[name] = credential
+	}
+	return credentials, nil
+}
@@ -0,0 +1,155 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+	zapcore "go.uber.org/zap/zapcore"
+	observer "go.uber.org/zap/zaptest/observer"
+
+	mocks "github.com/inference-gateway/adk/server/mocks"
+
+	config "github.com/inference-gateway/browser-agent/config"
+)
+
+func TestParseRef(t *testing.T) {
+	name, field, err := ParseRef("vault://github-bot")
+	require.NoError(t, err)
+	assert.Equal(t, "github-bot", name)
+	assert.Empty(t, field)
+
+	name, field, err = ParseRef("vault://github-bot#token")
+	require.NoError(t, err)
+	assert.Equal(t, "github-bot", name)
+	assert.Equal(t, "token", field)
+
+	_, _, err = ParseRef("github-bot")
+	assert.Error(t, err)
+
+	_, _, err = ParseRef("vault://../etc/passwd")
+	assert.Error(t, err)
+}
+
+func TestNewCredentialStoreFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"github-bot": {"username": "octo-bot", "password": "hunter2-secret"}}`), 0o600))
+
+	store, err := NewCredentialStore(zap.NewNop(), &config.Config{Browser: config.BrowserConfig{CredentialsPath: path}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"github-bot"}, store.Names())
+
+	credential, err := store.Resolve("vault://github-bot")
+	require.NoError(t, err)
+	assert.Equal(t, "octo-bot", credential.Username())
+	assert.Equal(t, "hunter2-secret", credential.Password())
+}
+
+func TestNewCredentialStoreFromMountedSecrets(t *testing.T) {
+	dir := t.TempDir()
+	// Kubernetes layout: field files are symlinks into a hidden ..data dir
+	data := filepath.Join(dir, "github-bot", "..data")
+	require.NoError(t, os.MkdirAll(data, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(data, "username"), []byte("octo-bot\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(data, "password"), []byte("hunter2-secret\n"), 0o600))
+	require.NoError(t, os.Symlink(filepath.Join("..data", "username"), filepath.Join(dir, "github-bot", "username")))
+	require.NoError(t, os.Symlink(filepath.Join("..data", "password"), filepath.Join(dir, "github-bot", "password")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("not a credential"), 0o600))
+
+	store, err := NewCredentialStore(zap.NewNop(), &config.Config{Browser: config.BrowserConfig{CredentialsPath: dir}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"github-bot"}, store.Names())
+
+	password, err := store.ResolveValue("vault://github-bot")
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2-secret", password, "trailing newline from the mounted file is trimmed")
+
+	username, err := store.ResolveValue("vault://github-bot#username")
+	require.NoError(t, err)
+	assert.Equal(t, "octo-bot", username)
+}
+
+func TestNewCredentialStoreErrors(t *testing.T) {
+	_, err := NewCredentialStore(zap.NewNop(), &config.Config{Browser: config.BrowserConfig{CredentialsPath: "/does/not/exist"}})
+	assert.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	require.NoError(t, os.WriteFile(path, []byte(`not json`), 0o600))
+	_, err = NewCredentialStore(zap.NewNop(), &config.Config{Browser: config.BrowserConfig{CredentialsPath: path}})
+	assert.ErrorContains(t, err, "invalid credential file")
+
+	store, err := NewCredentialStore(zap.NewNop(), &config.Config{})
+	require.NoError(t, err)
+	_, err = store.Resolve("vault://github-bot")
+	assert.ErrorContains(t, err, "not found")
+	assert.False(t, Configured(store), "an unset path yields an empty store")
+	assert.False(t, Configured(nil))
+	assert.True(t, Configured(NewVault(map[string]Credential{"github-bot": {"password": "hunter2-secret"}})))
+}
+
+func TestResolveValueUnknownField(t *testing.T) {
+	store := NewVault(map[string]Credential{"github-bot": {"password": "hunter2-secret"}})
+
+	_, err := store.ResolveValue("vault://github-bot#token")
+	assert.ErrorContains(t, err, `no field "token"`)
+	assert.Equal(t, "hunter2-secret", store.Redact("hunter2-secret"), "nothing is redacted before a value is handed out")
+}
+
+func TestRedact(t *testing.T) {
+	store := NewVault(map[string]Credential{
+		"github-bot": {"username": "octo-bot", "password": `pa"ss-word`},
+		"short":      {"password": "abc"},
+	})
+
+	_, err := store.Resolve("vault://github-bot")
+	require.NoError(t, err)
+	_, err = store.Resolve("vault://short")
+	require.NoError(t, err)
+
+	assert.Equal(t, "user [REDACTED] typed [REDACTED]", store.Redact(`user octo-bot typed pa"ss-word`))
+	assert.Equal(t, `{"value":"[REDACTED]"}`, store.Redact(`{"value":"pa\"ss-word"}`), "JSON-escaped secrets are masked too")
+	assert.Equal(t, "abc", store.Redact("abc"), "values below the minimum length are left alone")
+}
+
+func TestRedactLogger(t *testing.T) {
+	store := NewVault(map[string]Credential{"github-bot": {"password": "hunter2-secret"}})
+	_, err := store.Resolve("vault://github-bot")
+	require.NoError(t, err)
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger := RedactLogger(zap.New(core), store)
+
+	logger.With(zap.String("scoped", "hunter2-secret")).Info("filled hunter2-secret",
+		zap.String("value", "hunter2-secret"),
+		zap.Error(errors.New("bad password hunter2-secret")),
+		zap.Any("args", map[string]any{"password": "hunter2-secret"}))
+
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Equal(t, "filled [REDACTED]", entry.Message)
+	fields := entry.ContextMap()
+	assert.Equal(t, "[REDACTED]", fields["scoped"])
+	assert.Equal(t, "[REDACTED]", fields["value"])
+	assert.Equal(t, "bad password [REDACTED]", fields["error"])
+	assert.NotContains(t, fmt.Sprint(fields["args"]), "hunter2-secret")
+}
+
+func TestRedactToolBox(t *testing.T) {
+	store := NewVault(map[string]Credential{"github-bot": {"password": "hunter2-secret"}})
+	_, err := store.Resolve("vault://github-bot")
+	require.NoError(t, err)
+
+	inner := &mocks.FakeToolBox{}
+	inner.ExecuteToolReturns(`{"text":"hunter2-secret"}`, errors.New("hunter2-secret rejected"))
+
+	result, err := RedactToolBox(inner, store).ExecuteTool(context.Background(), "extract_data", nil)
+	assert.Equal(t, `{"text":"[REDACTED]"}`, result)
+	assert.EqualError(t, err, "[REDACTED] rejected")
+}
@@ -29,6 +29,8 @@ import (
 
 	logger "github.com/inference-gateway/browser-agent/internal/logger"
 	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+	proxy "github.com/inference-gateway/browser-agent/internal/proxy"
+	secrets "github.com/inference-gateway/browser-agent/internal/secrets"
 )
 
 // Version, AgentName and AgentDescription are injected at build time
@@ -204,7 +206,22 @@ func runStart(ctx context.Context) error {
 	}
 
 	// Initialize services
-	playwrightSvc, err := playwright.NewPlaywrightService(l, &cfg)
+	secretsSvc, err := secrets.NewCredentialStore(l, &cfg)
+	if err != nil {
+		l.Error("failed to initialize secrets service", zap.Error(err))
+		return fmt.Errorf("failed to initialize secrets service: %w", err)
+	}
+	// From here on, resolved credentials are masked in every log line
+	l = secrets.RedactLogger(l, secretsSvc)
+
+	// Browser contexts and Fetch requests of a task share its proxy
+	proxies, err := proxy.NewRouter(l, &cfg)
+	if err != nil {
+		l.Error("failed to initialize proxy settings", zap.Error(err))
+		return fmt.Errorf("failed to initialize proxy settings: %w", err)
+	}
+
+	playwrightSvc, err := playwright.NewPlaywrightService(l, &cfg, proxies)
 	if err != nil {
 		l.Error("failed to initialize playwright service", zap.Error(err))
 		return fmt.Errorf("failed to initialize playwright service: %w", err)
@@ -238,7 +255,7 @@ func runStart(ctx context.Context) error {
 	l.Info("registered built-in: Edit")
 
 	// Register Fetch built-in
-	fetchTool, err := tools.NewFetchTool(ctx, l)
+	fetchTool, err := tools.NewFetchTool(ctx, l, proxies)
 	if err != nil {
 		return fmt.Errorf("failed to construct Fetch tool: %w", err)
 	}
@@ -248,7 +265,7 @@ func runStart(ctx context.Context) error {
 	// Register navigate_to_url tool
 	navigateToURLTool := tools.NewNavigateToURLTool(l, playwrightSvc)
 	toolBox.AddTool(navigateToURLTool)
-	l.Info("registered tool: navigate_to_url (Navigate to a specific URL and wait for the page to fully load)")
+	l.Info("registered tool: navigate_to_url (Navigate to a specific URL and wait for the page to fully load. Returns the HTTP status, final URL after redirects, redirect chain, title, response headers and load timing)")
 
 	// Register click_element tool
 	clickElementTool := tools.NewClickElementTool(l, playwrightSvc)
@@ -256,9 +273,9 @@ func runStart(ctx context.Context) error {
 	l.Info("registered tool: click_element (Click on an element identified by selector, text, or other locator strategies)")
 
 	// Register fill_form tool
-	fillFormTool := tools.NewFillFormTool(l, playwrightSvc)
+	fillFormTool := tools.NewFillFormTool(l, playwrightSvc, secretsSvc)
 	toolBox.AddTool(fillFormTool)
-	l.Info("registered tool: fill_form (Fill form fields with provided data, handling various input types)")
+	l.Info("registered tool: fill_form (Fill form fields with provided data, handling various input types. File fields upload local files or artifacts of the task, to a file input or to a custom upload widget that opens a file chooser)")
 
 	// Register extract_data tool
 	extractDataTool := tools.NewExtractDataTool(l, playwrightSvc)
@@ -276,14 +293,134 @@ func runStart(ctx context.Context) error {
 	l.Info("registered tool: execute_script (Execute custom JavaScript inside the current page via Playwright's page.evaluate(). The script runs in the browser context, NOT in Node.js: globals like window, document, navigator, fetch and localStorage are available; Node.js built-ins (require, process, __dirname, __filename, fs, path, os, http, https, child_process, etc.) are NOT available and calls to them will be rejected. Use browser/DOM APIs only. The script body is automatically wrapped in an IIFE, so a top-level `return` is valid. Set async=true if the body uses `await`.)")
 
 	// Register handle_authentication tool
-	handleAuthenticationTool := tools.NewHandleAuthenticationTool(l, playwrightSvc)
+	handleAuthenticationTool := tools.NewHandleAuthenticationTool(l, playwrightSvc, secretsSvc)
 	toolBox.AddTool(handleAuthenticationTool)
-	l.Info("registered tool: handle_authentication (Handle various authentication scenarios including basic auth, OAuth, and custom login forms)")
+	l.Info("registered tool: handle_authentication (Sign in within the current browser session. basic: sets HTTP credentials on a fresh browser context (cookies are reset, nothing is navigated; login_url only scopes the credentials to its origin). form: opens login_url, fills the username/password selectors and submits. oauth: runs an authorization-code redirect flow, either built from oauth_authorize_url/oauth_client_id or started by the app at login_url, until the provider redirects to oauth_redirect_uri with a code. Pass success_selector and/or success_url_pattern to verify the login. Prefer credential_ref over username/password so the secret never appears in the conversation)")
 
 	// Register wait_for_condition tool
 	waitForConditionTool := tools.NewWaitForConditionTool(l, playwrightSvc)
 	toolBox.AddTool(waitForConditionTool)
-	l.Info("registered tool: wait_for_condition (Wait for specific conditions before proceeding with automation)")
+	l.Info("registered tool: wait_for_condition (Wait for specific conditions before proceeding with automation: an element, a navigation, a URL, a network response, text on the page, a script, network idle or a fixed time)")
+
+	// Register list_tabs tool
+	listTabsTool := tools.NewListTabsTool(l, playwrightSvc)
+	toolBox.AddTool(listTabsTool)
+	l.Info("registered tool: list_tabs (List the open tabs and popups of the browser session, marking the active tab that other browser tools act on)")
+
+	// Register switch_tab tool
+	switchTabTool := tools.NewSwitchTabTool(l, playwrightSvc)
+	toolBox.AddTool(switchTabTool)
+	l.Info("registered tool: switch_tab (Make another tab the active tab, so subsequent browser tools act on it)")
+
+	// Register open_tab tool
+	openTabTool := tools.NewOpenTabTool(l, playwrightSvc)
+	toolBox.AddTool(openTabTool)
+	l.Info("registered tool: open_tab (Open a new tab in the browser session, optionally navigating it to a URL, and make it the active tab)")
+
+	// Register close_tab tool
+	closeTabTool := tools.NewCloseTabTool(l, playwrightSvc)
+	toolBox.AddTool(closeTabTool)
+	l.Info("registered tool: close_tab (Close a tab or popup; if it was the active tab, its opener (or the most recent tab) becomes active)")
+
+	// Register get_page_snapshot tool
+	getPageSnapshotTool := tools.NewGetPageSnapshotTool(l, playwrightSvc)
+	toolBox.AddTool(getPageSnapshotTool)
+	l.Info("registered tool: get_page_snapshot (Get the accessibility tree of the current page (role, name, value and state of each node). Interactive nodes carry a ref such as [ref=e12] that click_element and fill_form accept as selector ref=e12)")
+
+	// Register get_page_content tool
+	getPageContentTool := tools.NewGetPageContentTool(l, playwrightSvc)
+	toolBox.AddTool(getPageContentTool)
+	l.Info("registered tool: get_page_content (Get the readable content of the current page as Markdown, after scripts have rendered it. Navigation, headers, footers, sidebars and scripts are dropped; links stay Markdown links and tables stay Markdown tables. Long pages are returned in parts: pass next_offset as offset to read on)")
+
+	// Register get_network_log tool
+	getNetworkLogTool := tools.NewGetNetworkLogTool(l, playwrightSvc)
+	toolBox.AddTool(getNetworkLogTool)
+	l.Info("registered tool: get_network_log (List the requests the browser session's pages made (URL, method, status, resource type, size, timing), most recent last. Filter by URL pattern, method, status or resource type; use resource_types [xhr, fetch] to find the backend API a page calls, and include_bodies to see its JSON responses)")
+
+	// Register route_requests tool
+	routeRequestsTool := tools.NewRouteRequestsTool(l, playwrightSvc)
+	toolBox.AddTool(routeRequestsTool)
+	l.Info("registered tool: route_requests (Intercept the requests of the browser session's pages. abort blocks matching requests (e.g. resource_types [image, font] or trackers to speed up scraping), fulfill answers them with a canned status and body (e.g. to test error states), and rewrite_headers changes their request headers. Rules match by url_pattern, resource_types and trackers, apply to every tab, and stay until removed; list and remove manage them)")
+
+	// Register get_console_logs tool
+	getConsoleLogsTool := tools.NewGetConsoleLogsTool(l, playwrightSvc)
+	toolBox.AddTool(getConsoleLogsTool)
+	l.Info("registered tool: get_console_logs (Read the browser console of the session's pages: console messages, uncaught JavaScript exceptions (source pageerror) and failed requests, oldest first. By default only what was logged since the previous call is returned, so call it after each step of a test; page_errors above zero means the page threw)")
+
+	// Register wait_for_download tool
+	waitForDownloadTool := tools.NewWaitForDownloadTool(l, playwrightSvc)
+	toolBox.AddTool(waitForDownloadTool)
+	l.Info("registered tool: wait_for_download (Click an element that starts a file download (an export button, a download link) and wait for the file. The file is saved for the task and returned as a downloadable artifact, with its size, MIME type and sha256. Without a selector, waits for a download the page starts by itself)")
+
+	// Register press_keys tool
+	pressKeysTool := tools.NewPressKeysTool(l, playwrightSvc)
+	toolBox.AddTool(pressKeysTool)
+	l.Info("registered tool: press_keys (Press keys and shortcuts in order, such as Enter to submit, Tab to move focus, ArrowDown to walk an autocomplete list, or Control+A then Backspace to clear a field. Keys go to the element matching selector, which is focused first, or to the focused element)")
+
+	// Register type_text tool
+	typeTextTool := tools.NewTypeTextTool(l, playwrightSvc)
+	toolBox.AddTool(typeTextTool)
+	l.Info("registered tool: type_text (Type text one character at a time, with real key events, into the element matching selector or into the focused element. Use it for autocomplete inputs, search-as-you-type boxes and rich text editors that ignore fill_form; follow with press_keys to pick a suggestion or submit)")
+
+	// Register mouse_action tool
+	mouseActionTool := tools.NewMouseActionTool(l, playwrightSvc)
+	toolBox.AddTool(mouseActionTool)
+	l.Info("registered tool: mouse_action (Pointer actions besides clicking an element: hover to open menus and tooltips, scroll_into_view, scroll by a delta, scroll_to_bottom to load an infinite feed, drag_and_drop between two elements, and click_at viewport coordinates taken from a screenshot)")
+
+	// Register navigate_history tool
+	navigateHistoryTool := tools.NewNavigateHistoryTool(l, playwrightSvc)
+	toolBox.AddTool(navigateHistoryTool)
+	l.Info("registered tool: navigate_history (Go back or forward in the active tab's history, or reload the page, and wait for it to load. Returns the resulting URL, title and HTTP status)")
+
+	// Register save_session_state tool
+	saveSessionStateTool := tools.NewSaveSessionStateTool(l, playwrightSvc)
+	toolBox.AddTool(saveSessionStateTool)
+	l.Info("registered tool: save_session_state (Save the cookies, local storage and IndexedDB of the browser session as a named profile, replacing a profile of the same name. A later task can start logged in by passing the profile to navigate_to_url or configure_browser)")
+
+	// Register list_profiles tool
+	listProfilesTool := tools.NewListProfilesTool(l, playwrightSvc)
+	toolBox.AddTool(listProfilesTool)
+	l.Info("registered tool: list_profiles (List the browser profiles saved with save_session_state, with when each was saved)")
+
+	// Register delete_profile tool
+	deleteProfileTool := tools.NewDeleteProfileTool(l, playwrightSvc)
+	toolBox.AddTool(deleteProfileTool)
+	l.Info("registered tool: delete_profile (Delete a browser profile saved with save_session_state. Sessions already started from it are not affected)")
+
+	// Register get_cookies tool
+	getCookiesTool := tools.NewGetCookiesTool(l, playwrightSvc)
+	toolBox.AddTool(getCookiesTool)
+	l.Info("registered tool: get_cookies (List the cookies of the browser session, including httpOnly cookies that page scripts cannot see, with their domain, path, expiry and flags)")
+
+	// Register set_cookies tool
+	setCookiesTool := tools.NewSetCookiesTool(l, playwrightSvc)
+	toolBox.AddTool(setCookiesTool)
+	l.Info("registered tool: set_cookies (Add cookies to the browser session, replacing cookies with the same name, domain and path, e.g. to turn on feature flags before loading a page. They apply to the next request, so reload a page that is already open)")
+
+	// Register clear_cookies tool
+	clearCookiesTool := tools.NewClearCookiesTool(l, playwrightSvc)
+	toolBox.AddTool(clearCookiesTool)
+	l.Info("registered tool: clear_cookies (Delete cookies of the browser session, all of them or those matching the filters. Clearing a site's session cookie signs the session out of it)")
+
+	// Register get_storage tool
+	getStorageTool := tools.NewGetStorageTool(l, playwrightSvc)
+	toolBox.AddTool(getStorageTool)
+	l.Info("registered tool: get_storage (Read the localStorage or sessionStorage entries of the active tab's origin, or the localStorage of another origin the session has visited)")
+
+	// Register set_storage tool
+	setStorageTool := tools.NewSetStorageTool(l, playwrightSvc)
+	toolBox.AddTool(setStorageTool)
+	l.Info("registered tool: set_storage (Change the localStorage or sessionStorage of the active tab's origin: clear it, remove keys and set items, in that order. Returns the entries afterwards. The page only notices on its next read, so reload it when it caches settings at startup)")
+
+	// Register configure_browser tool
+	configureBrowserTool := tools.NewConfigureBrowserTool(l, playwrightSvc)
+	toolBox.AddTool(configureBrowserTool)
+	l.Info("registered tool: configure_browser (Emulate a device, locale and user preferences by recreating the browser context: a Playwright device such as \"iPhone 13\" or \"Pixel 7\", or a viewport, scale factor, mobile and touch support, plus locale, timezone, geolocation with permissions, color scheme and reduced motion. Settings add up across calls. Best called before the first navigation; later, cookies and localStorage are kept and the current page is loaded again, but other tabs and sessionStorage are lost. A profile starts the session from saved cookies and storage, alone or with the settings)")
+
+	// Register set_proxy tool
+	setProxyTool := tools.NewSetProxyTool(l, playwrightSvc, secretsSvc)
+	toolBox.AddTool(setProxyTool)
+	l.Info("registered tool: set_proxy (Change the proxy the current task's browser and Fetch requests go through by recreating the browser context. set: use the given proxy server, which must be one of the configured proxies or listed in BROWSER_PROXY_ALLOWED; rotate: move to the next proxy configured with BROWSER_PROXY_SERVER and BROWSER_PROXY_ROTATION, e.g. after being rate limited or blocked; direct: stop using a proxy. Cookies and localStorage are kept and the current page is loaded again, but other tabs and sessionStorage are lost. Prefer credential_ref over username/password so the secret never appears in the conversation)")
 
 	llmClient, err := server.NewOpenAICompatibleLLMClient(&cfg.A2A.AgentConfig, l)
 	if err != nil {
@@ -342,8 +479,42 @@ Reach for navigate_to_url (and the Playwright tools) when:
 
 When in doubt: try fetch first. If the response body looks like an empty shell that gets filled in by JS, fall back to navigate_to_url.
 
+**Tabs and popups**
+
+Browser tools act on the active tab. When a click opens a popup or a target=_blank link, the new tab becomes active automatically; use list_tabs to see every tab and switch_tab to go back. Close popups you are done with using close_tab.
+
+**Understanding a page**
+
+Call get_page_snapshot to read a page's structure before guessing selectors or taking screenshots. Interactive nodes carry refs such as [ref=e12]; pass ref=e12 as the selector to click_element or fill_form. Take a new snapshot after the page changes, since refs go stale.
+
+To read what a page says, call get_page_content rather than extract_data on body. It returns the main content as Markdown with links and tables kept; when has_more is true, call it again with next_offset as offset.
+
+To find the API behind a page, call get_network_log with resource_types [xhr, fetch] after it loads; fetching that endpoint directly is often faster than scraping the rendered page.
+
+To speed up scraping, call route_requests with action abort and resource_types [image, font, media] or trackers true before navigating. To test how a page handles failures, fulfill its API URL with an error status, or abort it, then reload.
+
+During tests, call get_console_logs with level error after each step; it returns only what was logged since the previous call. Treat any page_errors (uncaught JavaScript exceptions) as a failure of that step.
+
+Autocomplete inputs, search-as-you-type boxes and rich text editors often ignore fill_form. For those, type with type_text and then pick a suggestion or submit with press_keys, e.g. ArrowDown then Enter.
+
+Use mouse_action to hover over menus that open on hover, to scroll long pages and infinite feeds (scroll_to_bottom), and for drag and drop. When an element has no usable selector but is visible in a viewport screenshot, click_at its coordinates.
+
+Use navigate_history to go back, forward or reload the page instead of scripting window.history or window.location, so the page is waited for and you learn the URL and status it ended on.
+
+navigate_to_url reports the HTTP status, the final URL and any redirects. Check them before extracting data: an error page or a redirect to a login form loads just like the page you asked for. Pass fail_on_status (e.g. 400) when an error status should stop the task.
+
+After a click that navigates, submits or loads data, wait_for_condition for its outcome instead of a fixed timeout: navigation with the next page's url_pattern, url for single-page app routes, response for the API call and its status, or text for the message that should appear.
+
+To reuse a login across tasks, save it with save_session_state under a profile name once signed in. In a later task, pass that profile to navigate_to_url or configure_browser to start with the saved cookies and storage instead of signing in again; list_profiles shows what is saved. Pass it on the task's first browser tool call: loading a profile into an open session replaces its cookies and storage and closes its other tabs.
+
+To read or change cookies, use get_cookies, set_cookies and clear_cookies rather than document.cookie in execute_script: they see httpOnly cookies and can set cookies for any domain, such as feature flags before the first page load. Use get_storage and set_storage for localStorage and sessionStorage; reload the page afterwards if it only reads its settings at startup.
+
+For mobile and responsive testing, call configure_browser before navigating: pass a device such as "iPhone 13" or "Pixel 7", or a viewport size, and add locale, timezone, geolocation, color_scheme or reduced_motion when the behaviour depends on them. Settings add up across calls. Calling it mid-task keeps cookies and localStorage and reloads the current page, but closes other tabs.
+
+When a site rate limits or blocks you (HTTP 429 or 403, or a captcha page) and proxies are configured, call set_proxy with action rotate and retry once. Use action set only with a proxy the user gave you that is allowed, preferring a credential_ref for its credentials. The browser and fetch share the task's proxy.
+
 **IMPORTANT - Artifact Creation**:
-When users request screenshots, the take_screenshot tool automatically creates downloadable artifacts. The screenshot will be available via a download URL returned in the response.
+When users request screenshots, the take_screenshot tool automatically creates downloadable artifacts. The screenshot will be available via a download URL returned in the response. Files the page itself offers (export buttons, download links that need the session's login) are saved with wait_for_download, which also returns them as artifacts with their sha256.
 
 For data extraction, you can use the create_artifact tool to save extracted data as downloadable files (JSON/CSV/TXT).
 
@@ -361,7 +532,7 @@ Your automation solutions should be maintainable, efficient, and production-read
 	agent, err := server.NewAgentBuilder(l).
 		WithConfig(&cfg.A2A.AgentConfig).
 		WithLLMClient(llmClient).
-		WithToolBox(toolBox).
+		WithToolBox(secrets.RedactToolBox(toolBox, secretsSvc)).
 		WithMaxChatCompletion(cfg.A2A.AgentConfig.MaxChatCompletionIterations).
 		WithSystemPrompt(systemPrompt).
 		Build()
@@ -402,6 +573,15 @@ Your automation solutions should be maintainable, efficient, and production-read
 		return fmt.Errorf("failed to create A2A server: %w", err)
 	}
 
+	// End each task's browser session as soon as the task finishes, instead
+	// of leaving it to the idle timeout
+	sessionLifecycle, err := playwright.NewSessionLifecycle(l, playwrightSvc, &cfg)
+	if err != nil {
+		return fmt.Errorf("failed to configure task session lifecycle: %w", err)
+	}
+	a2aServer.SetBackgroundTaskHandler(sessionLifecycle.BackgroundTaskHandler(a2aServer.GetBackgroundTaskHandler()))
+	a2aServer.SetStreamingTaskHandler(sessionLifecycle.StreamingTaskHandler(a2aServer.GetStreamingTaskHandler()))
+
 	go func() {
 		l.Info("starting A2A server", zap.String("port", cfg.A2A.ServerConfig.Port))
 		if err := a2aServer.Start(ctx); err != nil {
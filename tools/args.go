@@ -1,9 +1,12 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"slices"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
 )
 
 // Shared defaults and bounds used across the manually-implemented tools.
@@ -23,6 +26,27 @@ const (
 	screenshotSelectorMaxRunes = 20
 )
 
+// frameDescription documents the optional frame argument shared by the
+// element tools.
+const frameDescription = "Frame to look in: a frame name, a URL pattern (glob such as **/checkout/*, or /regex/), or an iframe selector chain such as 'iframe#pay >> iframe.card'. When omitted, nested frames are searched if the main frame has no match"
+
+// profileDescription documents the optional profile argument of the tools
+// that can open the task's browser session.
+const profileDescription = "Saved profile (see list_profiles) to start the task's browser session from, restoring its cookies and storage. Once the session is open, it is restarted from the profile; its cookies and storage are replaced, the current page is loaded again and other tabs are closed"
+
+// profileContext returns ctx carrying the profile named by args["profile"],
+// or ctx itself when the argument is absent.
+func profileContext(ctx context.Context, args map[string]any) (context.Context, error) {
+	profile, err := stringArg(args, "profile", "")
+	if err != nil || profile == "" {
+		return ctx, err
+	}
+	if err := playwright.ValidateProfileName(profile); err != nil {
+		return ctx, err
+	}
+	return playwright.WithProfile(ctx, profile), nil
+}
+
 // requiredString returns args[key] as a non-empty string. Returns an error
 // if the key is absent, the value is not a string, or the string is empty.
 func requiredString(args map[string]any, key string) (string, error) {
@@ -71,6 +95,19 @@ func boolArg(args map[string]any, key string, defaultValue bool) (bool, error) {
 	return b, nil
 }
 
+// optionalBoolArg returns args[key] as a bool pointer, or nil if absent, for
+// arguments whose absence means "leave as is"
+func optionalBoolArg(args map[string]any, key string) (*bool, error) {
+	if _, ok := args[key]; !ok {
+		return nil, nil
+	}
+	b, err := boolArg(args, key, false)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
 // intArg returns args[key] as an int, or defaultValue if absent. Accepts
 // int, int64, and float64 (since JSON unmarshaling produces float64).
 // Returns an error if the value cannot be converted to a whole int.
@@ -94,6 +131,25 @@ func intArg(args map[string]any, key string, defaultValue int) (int, error) {
 	}
 }
 
+// floatArg returns args[key] as a float64, or defaultValue if absent.
+// Accepts float64, int and int64. Returns an error for any other type.
+func floatArg(args map[string]any, key string, defaultValue float64) (float64, error) {
+	raw, ok := args[key]
+	if !ok {
+		return defaultValue, nil
+	}
+	switch v := raw.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	default:
+		return 0, fmt.Errorf("%s must be a number, got %T", key, raw)
+	}
+}
+
 // boundedIntArg returns args[key] as an int validated to be in
 // [minInclusive, maxInclusive]. Returns defaultValue if absent.
 func boundedIntArg(args map[string]any, key string, defaultValue, minInclusive, maxInclusive int) (int, error) {
@@ -123,6 +179,46 @@ func sliceArg(args map[string]any, key string) ([]any, bool, error) {
 	return s, true, nil
 }
 
+// stringSliceArg returns args[key] as []string, or nil if absent. Returns
+// an error if the value is not an array of strings.
+func stringSliceArg(args map[string]any, key string) ([]string, error) {
+	raw, present, err := sliceArg(args, key)
+	if err != nil || !present {
+		return nil, err
+	}
+	values := make([]string, 0, len(raw))
+	for _, item := range raw {
+		s, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s must contain only strings, got %T", key, item)
+		}
+		values = append(values, s)
+	}
+	return values, nil
+}
+
+// stringMapArg returns args[key] as map[string]string, or nil if absent.
+// Returns an error if the value is not an object of string values.
+func stringMapArg(args map[string]any, key string) (map[string]string, error) {
+	raw, ok := args[key]
+	if !ok {
+		return nil, nil
+	}
+	m, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object, got %T", key, raw)
+	}
+	values := make(map[string]string, len(m))
+	for k, v := range m {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s.%s must be a string, got %T", key, k, v)
+		}
+		values[k] = s
+	}
+	return values, nil
+}
+
 // marshalResponse encodes a tool response as JSON. Centralized so we get
 // consistent error messages and avoid scattering identical
 // json.Marshal+error-wrap boilerplate across every tool.
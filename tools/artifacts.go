@@ -0,0 +1,52 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+)
+
+// createFileArtifact stores a file with the artifact service of the task in
+// ctx and attaches it to the task. It returns the artifact's download URL,
+// when the service provides one, and its ID.
+func createFileArtifact(ctx context.Context, filePath, filename, name, description, mimeType string) (url string, artifactID string, err error) {
+	task, ok := ctx.Value(server.TaskContextKey).(*types.Task)
+	if !ok {
+		return "", "", fmt.Errorf("task not found in context")
+	}
+
+	artifactService, ok := ctx.Value(server.ArtifactServiceContextKey).(server.ArtifactService)
+	if !ok || artifactService == nil {
+		return "", "", fmt.Errorf("artifact service not available")
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read %s: %w", filePath, err)
+	}
+
+	artifact, err := artifactService.CreateFileArtifact(
+		task.ContextID,
+		name,
+		description,
+		filename,
+		data,
+		&mimeType,
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create artifact: %w", err)
+	}
+
+	artifactService.AddArtifactToTask(task, artifact)
+
+	if len(artifact.Parts) > 0 {
+		if artifact.Parts[0].File != nil && artifact.Parts[0].File.FileWithURI != nil {
+			return *artifact.Parts[0].File.FileWithURI, artifact.ArtifactID, nil
+		}
+	}
+
+	return "", artifact.ArtifactID, nil
+}
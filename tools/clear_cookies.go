@@ -0,0 +1,79 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// ClearCookiesTool struct holds the tool with dependencies
+type ClearCookiesTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewClearCookiesTool creates a new clear_cookies tool
+func NewClearCookiesTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &ClearCookiesTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"clear_cookies",
+		"Delete cookies of the browser session, all of them or those matching the filters. Clearing a site's session cookie signs the session out of it",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"domain": map[string]any{
+					"description": "Only cookies of this domain and its subdomains, e.g. example.com",
+					"type":        "string",
+				},
+				"http_only": map[string]any{
+					"description": "true for only httpOnly cookies, false for only cookies visible to page scripts. By default both",
+					"type":        "boolean",
+				},
+				"name": map[string]any{
+					"description": "Only cookies with this name",
+					"type":        "string",
+				},
+				"path": map[string]any{
+					"description": "Only cookies with this path",
+					"type":        "string",
+				},
+			},
+		},
+		tool.ClearCookiesHandler,
+	)
+}
+
+// ClearCookiesHandler handles the clear_cookies tool execution
+func (s *ClearCookiesTool) ClearCookiesHandler(ctx context.Context, args map[string]any) (string, error) {
+	filter, err := cookieFilterArgs(args)
+	if err != nil {
+		return "", err
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	cleared, err := s.playwright.ClearCookies(ctx, session.ID, filter)
+	if err != nil {
+		s.logger.Error("failed to clear cookies", zap.String("sessionID", session.ID), zap.Error(err))
+		return "", fmt.Errorf("clear cookies failed: %w", err)
+	}
+
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"cleared":    cleared,
+		"session_id": session.ID,
+		"message":    fmt.Sprintf("Cleared %d cookie(s)", cleared),
+	})
+}
@@ -0,0 +1,91 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+)
+
+func TestClearCookiesTool_ClearCookiesHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]any
+		setupMock     func(*mocks.FakeBrowserAutomation)
+		expectedError bool
+		errorContains string
+		verify        func(*testing.T, *mocks.FakeBrowserAutomation, map[string]any)
+	}{
+		{
+			name: "clears the matching cookies",
+			args: map[string]any{"domain": "example.com", "http_only": false, "path": "/app"},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+				m.ClearCookiesReturns(3, nil)
+			},
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, response map[string]any) {
+				assert.Equal(t, float64(3), response["cleared"])
+				assert.Equal(t, "Cleared 3 cookie(s)", response["message"])
+
+				_, _, filter := m.ClearCookiesArgsForCall(0)
+				assert.Equal(t, "example.com", filter.Domain)
+				assert.Equal(t, "/app", filter.Path)
+				require.NotNil(t, filter.HTTPOnly)
+				assert.False(t, *filter.HTTPOnly)
+			},
+		},
+		{
+			name:          "name is not a string",
+			args:          map[string]any{"name": 42},
+			expectedError: true,
+			errorContains: "name",
+		},
+		{
+			name: "clearing fails",
+			args: map[string]any{},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+				m.ClearCookiesReturns(1, errors.New("context closed"))
+			},
+			expectedError: true,
+			errorContains: "clear cookies failed: context closed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			if tt.setupMock != nil {
+				tt.setupMock(mockPlaywright)
+			}
+			tool := &ClearCookiesTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			result, err := tool.ClearCookiesHandler(context.Background(), tt.args)
+
+			if tt.expectedError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.Empty(t, result)
+				if tt.setupMock == nil {
+					assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			var response map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(result), &response), "response should be valid JSON")
+			assert.Equal(t, true, response["success"])
+			if tt.verify != nil {
+				tt.verify(t, mockPlaywright, response)
+			}
+		})
+	}
+}
@@ -53,13 +53,17 @@ func NewClickElementTool(logger *zap.Logger, playwright playwright.BrowserAutoma
 					"description": "Number of times to click",
 					"type":        "integer",
 				},
+				"frame": map[string]any{
+					"description": frameDescription,
+					"type":        "string",
+				},
 				"force": map[string]any{
 					"default":     false,
 					"description": "Force click even if element is not visible or actionable (skips the pre-click visibility wait)",
 					"type":        "boolean",
 				},
 				"selector": map[string]any{
-					"description": "CSS selector, XPath, or text to identify the element",
+					"description": "CSS selector, XPath, or text to identify the element, or a ref from get_page_snapshot such as ref=e12",
 					"type":        "string",
 				},
 				"timeout": map[string]any{
@@ -99,6 +103,11 @@ func (s *ClickElementTool) ClickElementHandler(ctx context.Context, args map[str
 		return "", err
 	}
 
+	frame, err := stringArg(args, "frame", "")
+	if err != nil {
+		return "", err
+	}
+
 	timeout, err := boundedIntArg(args, "timeout", defaultTimeoutMs, minTimeoutMs, maxTimeoutMs)
 	if err != nil {
 		return "", err
@@ -106,6 +115,7 @@ func (s *ClickElementTool) ClickElementHandler(ctx context.Context, args map[str
 
 	s.logger.Info("clicking element",
 		zap.String("selector", selector),
+		zap.String("frame", frame),
 		zap.String("button", button),
 		zap.Int("click_count", clickCount),
 		zap.Bool("force", force),
@@ -129,13 +139,14 @@ func (s *ClickElementTool) ClickElementHandler(ctx context.Context, args map[str
 		"force":       force,
 		"click_count": clickCount,
 		"button":      button,
+		"frame":       frame,
 	}
 
 	// When force=true, skip the actionability wait — the caller explicitly
 	// asked to click without waiting for visibility (e.g. covered elements,
 	// pointer-events:none). The previous behaviour ignored the flag.
 	if !force {
-		if err := s.waitForElementActionable(ctx, session, normalizedSelector, timeout); err != nil {
+		if err := s.waitForElementActionable(ctx, session, normalizedSelector, frame, timeout); err != nil {
 			s.logger.Error("element not actionable",
 				zap.String("selector", normalizedSelector),
 				zap.String("sessionID", session.ID),
@@ -149,6 +160,9 @@ func (s *ClickElementTool) ClickElementHandler(ctx context.Context, args map[str
 			zap.String("selector", normalizedSelector),
 			zap.String("sessionID", session.ID),
 			zap.Error(err))
+		if selectorType == "ref" {
+			return "", fmt.Errorf("click failed: %w; refs go stale when the page changes, call get_page_snapshot for fresh ones", err)
+		}
 		return "", fmt.Errorf("click failed: %w", err)
 	}
 
@@ -162,6 +176,7 @@ func (s *ClickElementTool) ClickElementHandler(ctx context.Context, args map[str
 		"button":        button,
 		"click_count":   clickCount,
 		"force":         force,
+		"frame":         frame,
 		"timeout_ms":    timeout,
 		"session_id":    session.ID,
 		"selector_type": selectorType,
@@ -175,6 +190,10 @@ func (s *ClickElementTool) ClickElementHandler(ctx context.Context, args map[str
 func (s *ClickElementTool) normalizeSelector(selector string) (string, string) {
 	selector = strings.TrimSpace(selector)
 
+	if playwright.IsRefSelector(selector) {
+		return selector, "ref"
+	}
+
 	if strings.HasPrefix(selector, "xpath=") {
 		return selector[6:], "xpath"
 	}
@@ -202,42 +221,16 @@ func (s *ClickElementTool) normalizeSelector(selector string) (string, string) {
 }
 
 // waitForElementActionable waits for the element to be actionable before clicking.
-// Called only when force=false; force=true skips this entirely.
-func (s *ClickElementTool) waitForElementActionable(ctx context.Context, session *playwright.BrowserSession, selector string, timeoutMs int) error {
+// Called only when force=false; force=true skips this entirely. Without a
+// frame the service also searches nested frames, so elements inside iframes
+// are found the same way as in the main frame.
+func (s *ClickElementTool) waitForElementActionable(ctx context.Context, session *playwright.BrowserSession, selector, frame string, timeoutMs int) error {
 	timeoutDuration := time.Duration(timeoutMs) * time.Millisecond
-
-	err := s.playwright.WaitForCondition(ctx, session.ID, "selector", selector, "visible", timeoutDuration, "")
-	if err != nil {
-		s.logger.Warn("element not visible in main frame, checking iframes",
-			zap.String("selector", selector),
-			zap.Error(err))
-		return s.checkElementInIframes(session, selector)
-	}
-
-	return nil
-}
-
-// checkElementInIframes reports whether the missing element might live in a
-// nested iframe (cross-frame click is not yet supported). It only inspects
-// the iframe count; the returned error tells the caller why the click cannot
-// proceed.
-func (s *ClickElementTool) checkElementInIframes(session *playwright.BrowserSession, selector string) error {
-	if session.Page == nil {
-		return fmt.Errorf("element not found: %s (page not available)", selector)
-	}
-
-	iframeCount, err := session.Page.Locator("iframe").Count()
-	if err != nil {
-		return fmt.Errorf("element not found and iframe check failed: %w", err)
-	}
-
-	if iframeCount == 0 {
-		return fmt.Errorf("element not found: %s", selector)
-	}
-
-	s.logger.Info("element absent from main frame, iframes detected",
-		zap.String("selector", selector),
-		zap.Int("iframe_count", iframeCount))
-
-	return fmt.Errorf("element not found in main frame, %d iframes detected but cross-frame clicking not yet implemented; pass force=true to click without the visibility wait", iframeCount)
+	_, err := s.playwright.WaitForCondition(ctx, session.ID, "selector", playwright.WaitOptions{
+		Selector: selector,
+		Frame:    frame,
+		State:    "visible",
+		Timeout:  timeoutDuration,
+	})
+	return err
 }
@@ -34,7 +34,7 @@ func TestClickElementTool_ClickElementHandler(t *testing.T) {
 				session := &playwright.BrowserSession{ID: "test-session"}
 				m.GetOrCreateTaskSessionReturns(session, nil)
 				m.GetSessionReturns(session, nil)
-				m.WaitForConditionReturns(nil)
+				m.WaitForConditionReturns(nil, nil)
 				m.ClickElementReturns(nil)
 			},
 			expectedError: false,
@@ -52,7 +52,7 @@ func TestClickElementTool_ClickElementHandler(t *testing.T) {
 				session := &playwright.BrowserSession{ID: "test-session"}
 				m.GetOrCreateTaskSessionReturns(session, nil)
 				m.GetSessionReturns(session, nil)
-				m.WaitForConditionReturns(nil)
+				m.WaitForConditionReturns(nil, nil)
 				m.ClickElementReturns(nil)
 			},
 			expectedError: false,
@@ -66,7 +66,7 @@ func TestClickElementTool_ClickElementHandler(t *testing.T) {
 				session := &playwright.BrowserSession{ID: "test-session"}
 				m.GetOrCreateTaskSessionReturns(session, nil)
 				m.GetSessionReturns(session, nil)
-				m.WaitForConditionReturns(nil)
+				m.WaitForConditionReturns(nil, nil)
 				m.ClickElementReturns(nil)
 			},
 			expectedError: false,
@@ -80,7 +80,7 @@ func TestClickElementTool_ClickElementHandler(t *testing.T) {
 				session := &playwright.BrowserSession{ID: "test-session"}
 				m.GetOrCreateTaskSessionReturns(session, nil)
 				m.GetSessionReturns(session, nil)
-				m.WaitForConditionReturns(nil)
+				m.WaitForConditionReturns(nil, nil)
 				m.ClickElementReturns(nil)
 			},
 			expectedError: false,
@@ -152,7 +152,7 @@ func TestClickElementTool_ClickElementHandler(t *testing.T) {
 				session := &playwright.BrowserSession{ID: "test-session"}
 				m.GetOrCreateTaskSessionReturns(session, nil)
 				m.GetSessionReturns(session, nil)
-				m.WaitForConditionReturns(errors.New("element not found"))
+				m.WaitForConditionReturns(nil, errors.New("element not found"))
 			},
 			expectedError: true,
 		},
@@ -165,7 +165,7 @@ func TestClickElementTool_ClickElementHandler(t *testing.T) {
 				session := &playwright.BrowserSession{ID: "test-session"}
 				m.GetOrCreateTaskSessionReturns(session, nil)
 				m.GetSessionReturns(session, nil)
-				m.WaitForConditionReturns(nil)
+				m.WaitForConditionReturns(nil, nil)
 				m.ClickElementReturns(errors.New("click failed"))
 			},
 			expectedError: true,
@@ -225,6 +225,24 @@ func TestClickElementTool_ForceSkipsActionabilityWait(t *testing.T) {
 		"ClickElement should still be invoked")
 }
 
+func TestClickElementTool_PassesFrame(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	session := &playwright.BrowserSession{ID: "test-session"}
+	mockPlaywright.GetOrCreateTaskSessionReturns(session, nil)
+
+	tool := &ClickElementTool{logger: zap.NewNop(), playwright: mockPlaywright}
+	_, err := tool.ClickElementHandler(context.Background(), map[string]any{
+		"selector": "#pay",
+		"frame":    "iframe#checkout >> iframe.card",
+	})
+	assert.NoError(t, err)
+
+	_, _, _, waitOptions := mockPlaywright.WaitForConditionArgsForCall(0)
+	assert.Equal(t, "iframe#checkout >> iframe.card", waitOptions.Frame)
+	_, _, _, clickOptions := mockPlaywright.ClickElementArgsForCall(0)
+	assert.Equal(t, "iframe#checkout >> iframe.card", clickOptions["frame"])
+}
+
 func TestClickElementTool_normalizeSelector(t *testing.T) {
 	tool := &ClickElementTool{}
 
@@ -240,6 +258,12 @@ func TestClickElementTool_normalizeSelector(t *testing.T) {
 			expectedSelector: "#submit-button",
 			expectedType:     "css",
 		},
+		{
+			name:             "snapshot ref",
+			selector:         "ref=e12",
+			expectedSelector: "ref=e12",
+			expectedType:     "ref",
+		},
 		{
 			name:             "XPath with //",
 			selector:         "//button[@id='submit']",
@@ -0,0 +1,70 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// CloseTabTool struct holds the tool with dependencies
+type CloseTabTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewCloseTabTool creates a new close_tab tool
+func NewCloseTabTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &CloseTabTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"close_tab",
+		"Close a tab or popup; if it was the active tab, its opener (or the most recent tab) becomes active",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"tab_id": map[string]any{
+					"description": "ID of the tab to close, as returned by list_tabs; defaults to the active tab",
+					"type":        "string",
+				},
+			},
+		},
+		tool.CloseTabHandler,
+	)
+}
+
+// CloseTabHandler handles the close_tab tool execution
+func (s *CloseTabTool) CloseTabHandler(ctx context.Context, args map[string]any) (string, error) {
+	tabID, err := stringArg(args, "tab_id", "")
+	if err != nil {
+		return "", err
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	active, err := s.playwright.CloseTab(ctx, session.ID, tabID)
+	if err != nil {
+		s.logger.Error("failed to close tab",
+			zap.String("sessionID", session.ID),
+			zap.String("tabID", tabID),
+			zap.Error(err))
+		return "", fmt.Errorf("failed to close tab: %w", err)
+	}
+
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"active_tab": active,
+		"session_id": session.ID,
+		"message":    fmt.Sprintf("Tab closed; %s is now the active tab", active.ID),
+	})
+}
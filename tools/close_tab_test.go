@@ -0,0 +1,80 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+
+	zap "go.uber.org/zap"
+
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+func TestCloseTabTool_CloseTabHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]any
+		setupMock     func(*mocks.FakeBrowserAutomation)
+		expectedError bool
+		errorContains string
+		verify        func(*testing.T, *mocks.FakeBrowserAutomation, map[string]any)
+	}{
+		{
+			name: "closes the active tab",
+			args: map[string]any{},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.CloseTabReturns(&playwright.Tab{ID: "tab-1", Active: true}, nil)
+			},
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, response map[string]any) {
+				assert.Equal(t, "tab-1", response["active_tab"].(map[string]any)["id"])
+				_, _, tabID := m.CloseTabArgsForCall(0)
+				assert.Empty(t, tabID, "no tab_id closes the active tab")
+			},
+		},
+		{
+			name: "last open tab",
+			args: map[string]any{"tab_id": "tab-1"},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.CloseTabReturns(nil, errors.New("cannot close tab-1: it is the last open tab"))
+			},
+			expectedError: true,
+			errorContains: "last open tab",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			if tt.setupMock != nil {
+				tt.setupMock(mockPlaywright)
+			}
+			tool := &CloseTabTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			result, err := tool.CloseTabHandler(context.Background(), tt.args)
+
+			if tt.expectedError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.Empty(t, result)
+				if tt.setupMock == nil {
+					assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			var response map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(result), &response), "response should be valid JSON")
+			assert.Equal(t, true, response["success"])
+			if tt.verify != nil {
+				tt.verify(t, mockPlaywright, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// colorSchemes are the values of the color_scheme argument
+var colorSchemes = playwright.ColorSchemes
+
+// reducedMotions are the values of the reduced_motion argument
+var reducedMotions = playwright.ReducedMotions
+
+// ConfigureBrowserTool struct holds the tool with dependencies
+type ConfigureBrowserTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewConfigureBrowserTool creates a new configure_browser tool
+func NewConfigureBrowserTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &ConfigureBrowserTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"configure_browser",
+		"Emulate a device, locale and user preferences by recreating the browser context: a Playwright device such as \"iPhone 13\" or \"Pixel 7\", or a viewport, scale factor, mobile and touch support, plus locale, timezone, geolocation with permissions, color scheme and reduced motion. Settings add up across calls. Best called before the first navigation; later, cookies and localStorage are kept and the current page is loaded again, but other tabs and sessionStorage are lost. A profile starts the session from saved cookies and storage, alone or with the settings",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"color_scheme": map[string]any{
+					"description": "prefers-color-scheme media feature",
+					"enum":        colorSchemes,
+					"type":        "string",
+				},
+				"device": map[string]any{
+					"description": "Playwright device descriptor name, e.g. \"iPhone 13\", \"iPad Mini\", \"Pixel 7\", \"Galaxy S9+\" or \"Desktop Chrome\". Sets viewport, screen, user agent, scale factor, mobile and touch; the other arguments override it",
+					"type":        "string",
+				},
+				"device_scale_factor": map[string]any{
+					"description": "Device pixel ratio, e.g. 2 or 3 for high-density screens",
+					"type":        "number",
+				},
+				"geolocation": map[string]any{
+					"description": "Position reported to the page; the geolocation permission is granted with it",
+					"properties": map[string]any{
+						"accuracy": map[string]any{
+							"description": "Accuracy in meters",
+							"type":        "number",
+						},
+						"latitude": map[string]any{
+							"description": "Latitude between -90 and 90",
+							"type":        "number",
+						},
+						"longitude": map[string]any{
+							"description": "Longitude between -180 and 180",
+							"type":        "number",
+						},
+					},
+					"required": []string{"latitude", "longitude"},
+					"type":     "object",
+				},
+				"has_touch": map[string]any{
+					"description": "Support touch events",
+					"type":        "boolean",
+				},
+				"is_mobile": map[string]any{
+					"description": "Honour the meta viewport tag and enable mobile layout (not supported by Firefox)",
+					"type":        "boolean",
+				},
+				"locale": map[string]any{
+					"description": "Locale such as en-GB or de-DE; sets navigator.language, the Accept-Language header and number and date formatting",
+					"type":        "string",
+				},
+				"permissions": map[string]any{
+					"description": "Permissions to grant to every origin, e.g. geolocation, notifications, clipboard-read. Replaces the permissions granted before",
+					"items":       map[string]any{"type": "string"},
+					"type":        "array",
+				},
+				"profile": map[string]any{
+					"description": profileDescription,
+					"type":        "string",
+				},
+				"reduced_motion": map[string]any{
+					"description": "prefers-reduced-motion media feature",
+					"enum":        reducedMotions,
+					"type":        "string",
+				},
+				"timezone": map[string]any{
+					"description": "IANA timezone such as Europe/Berlin or America/New_York",
+					"type":        "string",
+				},
+				"user_agent": map[string]any{
+					"description": "User agent string, overriding the device's",
+					"type":        "string",
+				},
+				"viewport_height": map[string]any{
+					"description": "Viewport height in CSS pixels",
+					"type":        "integer",
+				},
+				"viewport_width": map[string]any{
+					"description": "Viewport width in CSS pixels",
+					"type":        "integer",
+				},
+			},
+		},
+		tool.ConfigureBrowserHandler,
+	)
+}
+
+// ConfigureBrowserHandler handles the configure_browser tool execution
+func (s *ConfigureBrowserTool) ConfigureBrowserHandler(ctx context.Context, args map[string]any) (string, error) {
+	emulation, err := browserEmulationArgs(args)
+	if err != nil {
+		return "", err
+	}
+	if ctx, err = profileContext(ctx, args); err != nil {
+		return "", err
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+	if _, ok := args["profile"]; ok && len(args) == 1 {
+		return marshalResponse(map[string]any{
+			"success":    true,
+			"session_id": session.ID,
+			"profile":    session.Profile,
+			"message":    fmt.Sprintf("Browser session started from profile %s", session.Profile),
+		})
+	}
+
+	settings, err := s.playwright.ConfigureBrowser(ctx, session.ID, emulation)
+	if err != nil {
+		s.logger.Error("failed to configure browser", zap.String("sessionID", session.ID), zap.Error(err))
+		return "", fmt.Errorf("configure browser failed: %w", err)
+	}
+
+	message := fmt.Sprintf("Browser context recreated with a %dx%d viewport", settings.ViewportWidth, settings.ViewportHeight)
+	if settings.Device != "" {
+		message = fmt.Sprintf("Browser context recreated emulating %s (%dx%d)", settings.Device, settings.ViewportWidth, settings.ViewportHeight)
+	}
+	if settings.Reopened != "" {
+		message += "; reloaded " + settings.Reopened
+	}
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"settings":   settings,
+		"session_id": session.ID,
+		"message":    message,
+	})
+}
+
+// browserEmulationArgs reads and validates the configure_browser arguments
+func browserEmulationArgs(args map[string]any) (playwright.BrowserEmulation, error) {
+	var emulation playwright.BrowserEmulation
+	var err error
+	if len(args) == 0 {
+		return emulation, fmt.Errorf("nothing to configure: give a device or at least one setting")
+	}
+
+	if emulation.Device, err = stringArg(args, "device", ""); err != nil {
+		return emulation, err
+	}
+	if emulation.UserAgent, err = stringArg(args, "user_agent", ""); err != nil {
+		return emulation, err
+	}
+	if emulation.Locale, err = stringArg(args, "locale", ""); err != nil {
+		return emulation, err
+	}
+	if emulation.TimezoneID, err = stringArg(args, "timezone", ""); err != nil {
+		return emulation, err
+	}
+	if emulation.ColorScheme, err = stringArg(args, "color_scheme", ""); err != nil {
+		return emulation, err
+	}
+	if emulation.ColorScheme != "" && !oneOf(emulation.ColorScheme, colorSchemes...) {
+		return emulation, fmt.Errorf("invalid color_scheme value: %s. Must be one of: %v", emulation.ColorScheme, colorSchemes)
+	}
+	if emulation.ReducedMotion, err = stringArg(args, "reduced_motion", ""); err != nil {
+		return emulation, err
+	}
+	if emulation.ReducedMotion != "" && !oneOf(emulation.ReducedMotion, reducedMotions...) {
+		return emulation, fmt.Errorf("invalid reduced_motion value: %s. Must be one of: %v", emulation.ReducedMotion, reducedMotions)
+	}
+
+	if emulation.ViewportWidth, err = boundedIntArg(args, "viewport_width", 0, 0, 10000); err != nil {
+		return emulation, err
+	}
+	if emulation.ViewportHeight, err = boundedIntArg(args, "viewport_height", 0, 0, 10000); err != nil {
+		return emulation, err
+	}
+	if emulation.DeviceScaleFactor, err = floatArg(args, "device_scale_factor", 0); err != nil {
+		return emulation, err
+	}
+	if emulation.DeviceScaleFactor < 0 || emulation.DeviceScaleFactor > 10 {
+		return emulation, fmt.Errorf("device_scale_factor must be between 0 and 10, got %g", emulation.DeviceScaleFactor)
+	}
+	if emulation.IsMobile, err = optionalBoolArg(args, "is_mobile"); err != nil {
+		return emulation, err
+	}
+	if emulation.HasTouch, err = optionalBoolArg(args, "has_touch"); err != nil {
+		return emulation, err
+	}
+
+	if _, ok := args["permissions"]; ok {
+		if emulation.Permissions, err = stringSliceArg(args, "permissions"); err != nil {
+			return emulation, err
+		}
+		if emulation.Permissions == nil {
+			emulation.Permissions = []string{}
+		}
+	}
+	if raw, ok := args["geolocation"]; ok {
+		if emulation.Geolocation, err = geolocationArg(raw); err != nil {
+			return emulation, err
+		}
+	}
+	return emulation, nil
+}
+
+// geolocationArg reads the geolocation argument
+func geolocationArg(raw any) (*playwright.Geolocation, error) {
+	args, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("geolocation must be an object, got %T", raw)
+	}
+	for _, key := range []string{"latitude", "longitude"} {
+		if _, ok := args[key]; !ok {
+			return nil, fmt.Errorf("geolocation needs a %s", key)
+		}
+	}
+
+	var geolocation playwright.Geolocation
+	var err error
+	if geolocation.Latitude, err = floatArg(args, "latitude", 0); err != nil {
+		return nil, err
+	}
+	if geolocation.Longitude, err = floatArg(args, "longitude", 0); err != nil {
+		return nil, err
+	}
+	if geolocation.Accuracy, err = floatArg(args, "accuracy", 0); err != nil {
+		return nil, err
+	}
+	if geolocation.Latitude < -90 || geolocation.Latitude > 90 {
+		return nil, fmt.Errorf("latitude must be between -90 and 90, got %g", geolocation.Latitude)
+	}
+	if geolocation.Longitude < -180 || geolocation.Longitude > 180 {
+		return nil, fmt.Errorf("longitude must be between -180 and 180, got %g", geolocation.Longitude)
+	}
+	if geolocation.Accuracy < 0 {
+		return nil, fmt.Errorf("accuracy must not be negative, got %g", geolocation.Accuracy)
+	}
+	return &geolocation, nil
+}
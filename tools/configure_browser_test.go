@@ -0,0 +1,152 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+)
+
+func TestConfigureBrowserTool_ConfigureBrowserHandler(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+	mockPlaywright.ConfigureBrowserReturns(&playwright.EmulationSettings{
+		Device:         "iPhone 13",
+		ViewportWidth:  390,
+		ViewportHeight: 664,
+		IsMobile:       true,
+		Reopened:       "https://shop.example.com/",
+	}, nil)
+	tool := &ConfigureBrowserTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+	result, err := tool.ConfigureBrowserHandler(context.Background(), map[string]any{
+		"color_scheme":   "dark",
+		"device":         "iPhone 13",
+		"geolocation":    map[string]any{"latitude": 52.52, "longitude": 13.405},
+		"is_mobile":      true,
+		"locale":         "de-DE",
+		"permissions":    []any{"notifications"},
+		"reduced_motion": "reduce",
+		"timezone":       "Europe/Berlin",
+		"viewport_width": float64(400),
+	})
+	require.NoError(t, err)
+
+	var response map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result), &response))
+	assert.Equal(t, "Browser context recreated emulating iPhone 13 (390x664); reloaded https://shop.example.com/", response["message"])
+	assert.Equal(t, true, response["settings"].(map[string]any)["is_mobile"])
+
+	_, sessionID, emulation := mockPlaywright.ConfigureBrowserArgsForCall(0)
+	assert.Equal(t, "task-1", sessionID)
+	isMobile := true
+	assert.Equal(t, playwright.BrowserEmulation{
+		Device:        "iPhone 13",
+		ViewportWidth: 400,
+		IsMobile:      &isMobile,
+		Locale:        "de-DE",
+		TimezoneID:    "Europe/Berlin",
+		Geolocation:   &playwright.Geolocation{Latitude: 52.52, Longitude: 13.405},
+		Permissions:   []string{"notifications"},
+		ColorScheme:   "dark",
+		ReducedMotion: "reduce",
+	}, emulation)
+}
+
+func TestConfigureBrowserTool_EmptyPermissionsRevokeAll(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+	mockPlaywright.ConfigureBrowserReturns(&playwright.EmulationSettings{}, nil)
+	tool := &ConfigureBrowserTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+	_, err := tool.ConfigureBrowserHandler(context.Background(), map[string]any{"permissions": []any{}})
+	require.NoError(t, err)
+	_, _, emulation := mockPlaywright.ConfigureBrowserArgsForCall(0)
+	assert.NotNil(t, emulation.Permissions)
+	assert.Empty(t, emulation.Permissions)
+	assert.Nil(t, emulation.IsMobile, "unset flags keep the current setting")
+}
+
+func TestConfigureBrowserTool_Profile(t *testing.T) {
+	t.Run("profile alone starts the session from it", func(t *testing.T) {
+		mockPlaywright := &mocks.FakeBrowserAutomation{}
+		mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1", Profile: "shop-admin"}, nil)
+		tool := &ConfigureBrowserTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+		result, err := tool.ConfigureBrowserHandler(context.Background(), map[string]any{"profile": "shop-admin"})
+		require.NoError(t, err)
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(result), &response))
+		assert.Equal(t, "shop-admin", response["profile"])
+		ctx := mockPlaywright.GetOrCreateTaskSessionArgsForCall(0)
+		assert.Equal(t, "shop-admin", playwright.ProfileFromContext(ctx))
+		assert.Zero(t, mockPlaywright.ConfigureBrowserCallCount(), "a profile alone does not recreate the context again")
+	})
+
+	t.Run("profile with settings", func(t *testing.T) {
+		mockPlaywright := &mocks.FakeBrowserAutomation{}
+		mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1", Profile: "shop-admin"}, nil)
+		mockPlaywright.ConfigureBrowserReturns(&playwright.EmulationSettings{Device: "Pixel 7"}, nil)
+		tool := &ConfigureBrowserTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+		_, err := tool.ConfigureBrowserHandler(context.Background(), map[string]any{"profile": "shop-admin", "device": "Pixel 7"})
+		require.NoError(t, err)
+		assert.Equal(t, "shop-admin", playwright.ProfileFromContext(mockPlaywright.GetOrCreateTaskSessionArgsForCall(0)))
+		_, _, emulation := mockPlaywright.ConfigureBrowserArgsForCall(0)
+		assert.Equal(t, "Pixel 7", emulation.Device)
+	})
+
+	t.Run("invalid profile name", func(t *testing.T) {
+		mockPlaywright := &mocks.FakeBrowserAutomation{}
+		tool := &ConfigureBrowserTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+		_, err := tool.ConfigureBrowserHandler(context.Background(), map[string]any{"profile": "../secrets"})
+		assert.ErrorContains(t, err, "invalid profile name")
+		assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount())
+	})
+}
+
+func TestConfigureBrowserTool_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    map[string]any
+		wantErr string
+	}{
+		{name: "nothing to configure", args: map[string]any{}, wantErr: "nothing to configure"},
+		{name: "invalid color scheme", args: map[string]any{"color_scheme": "sepia"}, wantErr: "invalid color_scheme value: sepia"},
+		{name: "invalid reduced motion", args: map[string]any{"reduced_motion": "none"}, wantErr: "invalid reduced_motion value: none"},
+		{name: "negative viewport", args: map[string]any{"viewport_width": float64(-1)}, wantErr: "viewport_width must be between"},
+		{name: "scale factor", args: map[string]any{"device_scale_factor": float64(50)}, wantErr: "device_scale_factor must be between"},
+		{name: "is_mobile not a bool", args: map[string]any{"is_mobile": "yes"}, wantErr: "is_mobile must be a boolean"},
+		{name: "geolocation not an object", args: map[string]any{"geolocation": "Berlin"}, wantErr: "geolocation must be an object"},
+		{name: "geolocation missing longitude", args: map[string]any{"geolocation": map[string]any{"latitude": 1.0}}, wantErr: "geolocation needs a longitude"},
+		{name: "latitude out of range", args: map[string]any{"geolocation": map[string]any{"latitude": 91.0, "longitude": 0.0}}, wantErr: "latitude must be between -90 and 90"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			tool := &ConfigureBrowserTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			_, err := tool.ConfigureBrowserHandler(context.Background(), tt.args)
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+		})
+	}
+
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+	mockPlaywright.ConfigureBrowserReturns(nil, errors.New(`unknown device "iPhone 99"; similar devices: iPhone 13`))
+	tool := &ConfigureBrowserTool{logger: zap.NewNop(), playwright: mockPlaywright}
+	_, err := tool.ConfigureBrowserHandler(context.Background(), map[string]any{"device": "iPhone 99"})
+	assert.EqualError(t, err, `configure browser failed: unknown device "iPhone 99"; similar devices: iPhone 13`)
+}
@@ -0,0 +1,63 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// DeleteProfileTool struct holds the tool with dependencies
+type DeleteProfileTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewDeleteProfileTool creates a new delete_profile tool
+func NewDeleteProfileTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &DeleteProfileTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"delete_profile",
+		"Delete a browser profile saved with save_session_state. Sessions already started from it are not affected",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"name": map[string]any{
+					"description": "Name of the profile to delete",
+					"type":        "string",
+				},
+			},
+			"required": []string{"name"},
+		},
+		tool.DeleteProfileHandler,
+	)
+}
+
+// DeleteProfileHandler handles the delete_profile tool execution
+func (s *DeleteProfileTool) DeleteProfileHandler(ctx context.Context, args map[string]any) (string, error) {
+	name, err := requiredString(args, "name")
+	if err != nil {
+		return "", err
+	}
+	if err := playwright.ValidateProfileName(name); err != nil {
+		return "", err
+	}
+
+	if err := s.playwright.DeleteProfile(ctx, name); err != nil {
+		s.logger.Error("failed to delete profile", zap.String("profile", name), zap.Error(err))
+		return "", fmt.Errorf("delete profile failed: %w", err)
+	}
+
+	return marshalResponse(map[string]any{
+		"success": true,
+		"name":    name,
+		"message": fmt.Sprintf("Deleted profile %s", name),
+	})
+}
@@ -0,0 +1,80 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+
+	zap "go.uber.org/zap"
+
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+)
+
+func TestDeleteProfileTool_DeleteProfileHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]any
+		setupMock     func(*mocks.FakeBrowserAutomation)
+		expectedError bool
+		errorContains string
+		verify        func(*testing.T, *mocks.FakeBrowserAutomation, map[string]any)
+	}{
+		{
+			name:      "deletes the profile",
+			args:      map[string]any{"name": "shop-admin"},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {},
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, response map[string]any) {
+				assert.Equal(t, "Deleted profile shop-admin", response["message"])
+				_, name := m.DeleteProfileArgsForCall(0)
+				assert.Equal(t, "shop-admin", name)
+			},
+		},
+		{
+			name:          "invalid name",
+			args:          map[string]any{"name": "a/b"},
+			expectedError: true,
+			errorContains: "invalid profile name",
+		},
+		{
+			name: "unknown profile",
+			args: map[string]any{"name": "gone"},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.DeleteProfileReturns(errors.New(`profile "gone" not found`))
+			},
+			expectedError: true,
+			errorContains: `delete profile failed: profile "gone" not found`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			if tt.setupMock != nil {
+				tt.setupMock(mockPlaywright)
+			}
+			tool := &DeleteProfileTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			result, err := tool.DeleteProfileHandler(context.Background(), tt.args)
+
+			if tt.expectedError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.Empty(t, result)
+				if tt.setupMock == nil {
+					assert.Zero(t, mockPlaywright.DeleteProfileCallCount(), "invalid arguments are rejected before the service is called")
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			var response map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(result), &response), "response should be valid JSON")
+			assert.Equal(t, true, response["success"])
+			if tt.verify != nil {
+				tt.verify(t, mockPlaywright, response)
+			}
+		})
+	}
+}
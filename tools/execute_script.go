@@ -306,15 +306,15 @@ var dangerousScriptPatterns = []*dangerousScriptPattern{
 	},
 	{
 		pattern: `(?:^|[^.\w])localstorage\.clear`,
-		reason:  "calls `localStorage.clear()`; wiping the page's localStorage is blocked because it destroys session state. Remove individual keys with `localStorage.removeItem(key)` if needed",
+		reason:  "calls `localStorage.clear()`; wiping the page's localStorage is blocked because it destroys session state. Remove individual keys with `localStorage.removeItem(key)`, or use `set_storage` with `remove` or `clear` when wiping it is intended",
 	},
 	{
 		pattern: `(?:^|[^.\w])sessionstorage\.clear`,
-		reason:  "calls `sessionStorage.clear()`; wiping sessionStorage is blocked because it destroys session state. Remove individual keys with `sessionStorage.removeItem(key)` if needed",
+		reason:  "calls `sessionStorage.clear()`; wiping sessionStorage is blocked because it destroys session state. Remove individual keys with `sessionStorage.removeItem(key)`, or use `set_storage` with `type: session` when wiping it is intended",
 	},
 	{
 		pattern: `document\.cookie\s*=`,
-		reason:  "writes to `document.cookie`; cookie mutation from a script is blocked to protect the session. Use Playwright-level auth helpers / `handle_authentication` instead",
+		reason:  "writes to `document.cookie`; cookie mutation from a script is blocked to protect the session. Use `set_cookies` or `clear_cookies` to change cookies, and `get_cookies` to read them, including httpOnly ones",
 	},
 	{
 		pattern: `window\.location\s*=`,
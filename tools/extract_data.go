@@ -53,9 +53,14 @@ func NewExtractDataTool(logger *zap.Logger, playwright playwright.BrowserAutomat
 							"selector":  map[string]any{"type": "string", "description": "CSS selector or XPath to extract data from"},
 							"attribute": map[string]any{"type": "string", "description": "Attribute to extract (text, href, src, etc.)", "default": "text"},
 							"multiple":  map[string]any{"type": "boolean", "description": "Extract all matching elements or just the first", "default": false},
+							"frame":     map[string]any{"type": "string", "description": "Frame to extract from; overrides the top-level frame"},
 						},
 					},
 				},
+				"frame": map[string]any{
+					"description": frameDescription + ". Applies to every extractor without its own frame",
+					"type":        "string",
+				},
 				"format": map[string]any{
 					"default":     "json",
 					"description": "Output format (json, csv, text)",
@@ -101,7 +106,12 @@ func (s *ExtractDataTool) ExtractDataHandler(ctx context.Context, args map[strin
 		return "", fmt.Errorf("failed to get browser session: %w", err)
 	}
 
-	playwrightExtractors, err := s.convertExtractors(rawExtractors)
+	frame, err := stringArg(args, "frame", "")
+	if err != nil {
+		return "", err
+	}
+
+	playwrightExtractors, err := s.convertExtractors(rawExtractors, frame)
 	if err != nil {
 		s.logger.Error("failed to convert extractors", zap.Error(err))
 		return "", fmt.Errorf("failed to convert extractors: %w", err)
@@ -149,8 +159,9 @@ func (s *ExtractDataTool) parseRawResult(rawResult string) (map[string]any, erro
 	return parsed, nil
 }
 
-// convertExtractors converts extractors from any to the format expected by Playwright service
-func (s *ExtractDataTool) convertExtractors(extractors []any) ([]map[string]any, error) {
+// convertExtractors converts extractors from any to the format expected by
+// Playwright service, defaulting each extractor's frame to frame
+func (s *ExtractDataTool) convertExtractors(extractors []any, frame string) ([]map[string]any, error) {
 	converted := make([]map[string]any, len(extractors))
 
 	for i, extractor := range extractors {
@@ -185,6 +196,11 @@ func (s *ExtractDataTool) convertExtractors(extractors []any) ([]map[string]any,
 			"attribute": attribute,
 			"multiple":  multiple,
 		}
+		if extractorFrame, ok := extractorMap["frame"].(string); ok && extractorFrame != "" {
+			converted[i]["frame"] = extractorFrame
+		} else if frame != "" {
+			converted[i]["frame"] = frame
+		}
 	}
 
 	return converted, nil
@@ -271,7 +271,7 @@ func TestConvertExtractors(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := tool.convertExtractors(tt.extractors)
+			result, err := tool.convertExtractors(tt.extractors, "")
 
 			if tt.expectedErr {
 				assert.Error(t, err)
@@ -283,6 +283,18 @@ func TestConvertExtractors(t *testing.T) {
 	}
 }
 
+func TestExtractDataTool_ConvertExtractorsFrame(t *testing.T) {
+	tool := &ExtractDataTool{}
+
+	result, err := tool.convertExtractors([]any{
+		map[string]any{"name": "total", "selector": ".total"},
+		map[string]any{"name": "card", "selector": ".last4", "frame": "iframe#pay >> iframe.card"},
+	}, "checkout")
+	assert.NoError(t, err)
+	assert.Equal(t, "checkout", result[0]["frame"])
+	assert.Equal(t, "iframe#pay >> iframe.card", result[1]["frame"], "an extractor's own frame wins")
+}
+
 func TestCleanString(t *testing.T) {
 	tests := []struct {
 		name     string
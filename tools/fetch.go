@@ -21,6 +21,8 @@ import (
 	zap "go.uber.org/zap"
 
 	server "github.com/inference-gateway/adk/server"
+
+	proxy "github.com/inference-gateway/browser-agent/internal/proxy"
 )
 
 // FetchConfig is the runtime config for the Fetch built-in. Defaults are
@@ -65,7 +67,9 @@ type FetchTool struct {
 
 // NewFetchTool builds a Fetch tool, resolving config from TOOLS_FETCH_* env
 // vars (or the spec.config.tools.fetch defaults baked in at generation).
-func NewFetchTool(ctx context.Context, logger *zap.Logger) (server.Tool, error) {
+// Requests go through the proxy proxies picks for the calling task, the
+// same one as the task's browser; nil uses the HTTP_PROXY environment.
+func NewFetchTool(ctx context.Context, logger *zap.Logger, proxies *proxy.Router) (server.Tool, error) {
 	var cfg FetchConfig
 	if err := envconfig.Process(ctx, &cfg); err != nil {
 		return nil, fmt.Errorf("load Fetch config: %w", err)
@@ -79,10 +83,17 @@ func NewFetchTool(ctx context.Context, logger *zap.Logger) (server.Tool, error)
 	if strings.TrimSpace(cfg.DownloadDir) == "" {
 		cfg.DownloadDir = "/tmp"
 	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if proxies != nil {
+		transport.Proxy = proxies.HTTPProxy
+	}
 	t := &FetchTool{
 		logger: logger,
 		cfg:    cfg,
-		client: &http.Client{Timeout: time.Duration(cfg.TimeoutSeconds) * time.Second},
+		client: &http.Client{
+			Timeout:   time.Duration(cfg.TimeoutSeconds) * time.Second,
+			Transport: transport,
+		},
 	}
 	return server.NewBasicTool(
 		"Fetch",
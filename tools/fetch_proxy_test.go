@@ -0,0 +1,99 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	config "github.com/inference-gateway/browser-agent/config"
+	proxy "github.com/inference-gateway/browser-agent/internal/proxy"
+)
+
+// proxyStandIn is a forward HTTP proxy stand-in that answers every request
+// itself and records what it was asked for
+type proxyStandIn struct {
+	*httptest.Server
+	name string
+
+	mu       sync.Mutex
+	requests []string
+	auth     []string
+}
+
+func newProxyStandIn(t *testing.T, name string) *proxyStandIn {
+	p := &proxyStandIn{name: name}
+	p.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p.mu.Lock()
+		p.requests = append(p.requests, r.RequestURI)
+		p.auth = append(p.auth, r.Header.Get("Proxy-Authorization"))
+		p.mu.Unlock()
+		_, _ = fmt.Fprintf(w, "via %s", p.name)
+	}))
+	t.Cleanup(p.Close)
+	return p
+}
+
+func (p *proxyStandIn) Requests() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.requests...)
+}
+
+func (p *proxyStandIn) Auth() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.auth...)
+}
+
+func fetchBody(t *testing.T, tool server.Tool, ctx context.Context, url string) string {
+	t.Helper()
+	result, err := tool.Execute(ctx, map[string]any{"url": url})
+	require.NoError(t, err)
+	var response map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result), &response))
+	return response["body"].(string)
+}
+
+func TestFetchTool_GoesThroughTaskProxy(t *testing.T) {
+	first := newProxyStandIn(t, "first")
+	second := newProxyStandIn(t, "second")
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("direct"))
+	}))
+	defer target.Close()
+
+	proxies, err := proxy.NewRouter(zap.NewNop(), &config.Config{Browser: config.BrowserConfig{
+		ProxyServer:   first.URL,
+		ProxyRotation: second.URL,
+		ProxyUsername: "bot",
+		ProxyPassword: "s3cret",
+	}})
+	require.NoError(t, err)
+	tool, err := NewFetchTool(context.Background(), zap.NewNop(), proxies)
+	require.NoError(t, err)
+
+	taskOne := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: "task-1"})
+	taskTwo := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: "task-2"})
+
+	assert.Equal(t, "via first", fetchBody(t, tool, taskOne, "http://shop.test/api/items"))
+	assert.Equal(t, "via second", fetchBody(t, tool, taskTwo, "http://shop.test/api/items"), "new tasks take the next proxy")
+	assert.Equal(t, "via first", fetchBody(t, tool, taskOne, "http://shop.test/cart"), "a task keeps its proxy")
+	assert.Equal(t, []string{"http://shop.test/api/items", "http://shop.test/cart"}, first.Requests())
+	assert.Equal(t, "Basic Ym90OnMzY3JldA==", first.Auth()[0], "credentials are sent to the proxy")
+
+	proxies.Set("task-1", nil)
+	assert.Equal(t, "direct", fetchBody(t, tool, taskOne, target.URL), "a task set to direct skips the proxy")
+	assert.Len(t, first.Requests(), 2)
+}
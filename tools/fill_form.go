@@ -3,47 +3,72 @@ package tools
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	zap "go.uber.org/zap"
 
 	server "github.com/inference-gateway/adk/server"
 
 	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+	secrets "github.com/inference-gateway/browser-agent/internal/secrets"
 )
 
 var validFieldTypes = []string{"text", "textarea", "password", "select", "checkbox", "radio", "file"}
 
+// optionMatchers are the values of a select field's option_by
+var optionMatchers = playwright.OptionMatchers
+
 // FillFormTool struct holds the tool with dependencies
 type FillFormTool struct {
 	logger     *zap.Logger
 	playwright playwright.BrowserAutomation
+	secrets    secrets.CredentialStore
+	uploads    uploadSources
 }
 
 // NewFillFormTool creates a new fill_form tool
-func NewFillFormTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+func NewFillFormTool(logger *zap.Logger, playwright playwright.BrowserAutomation, secrets secrets.CredentialStore) server.Tool {
 	tool := &FillFormTool{
 		logger:     logger,
 		playwright: playwright,
+		secrets:    secrets,
+		uploads:    newUploadSources(logger, playwright),
 	}
 	return server.NewBasicTool(
 		"fill_form",
-		"Fill form fields with provided data, handling various input types",
+		"Fill form fields with provided data, handling various input types. File fields upload local files or artifacts of the task, to a file input or to a custom upload widget that opens a file chooser",
 		map[string]any{
 			"type": "object",
 			"properties": map[string]any{
 				"fields": map[string]any{
 					"description": "List of form fields to fill",
 					"items": map[string]any{
-						"required": []string{"selector", "value"},
+						"required": []string{"selector"},
 						"type":     "object",
 						"properties": map[string]any{
 							"selector": map[string]any{
 								"type":        "string",
-								"description": "Selector for the form field",
+								"description": "Selector for the form field, or a ref from get_page_snapshot such as ref=e12",
 							},
 							"value": map[string]any{
 								"type":        "string",
-								"description": "Value to fill in the field. For select with multiple=true, use comma-separated values",
+								"description": "Value to fill in the field. For select with multiple=true, use comma-separated values or values. For file, a single local path or artifact ID. Required unless credential_ref or files is set",
+							},
+							"credential_ref": map[string]any{
+								"type":        "string",
+								"description": "Fill the field from a stored credential instead of value, e.g. vault://github-bot#password (the field defaults to password)",
+							},
+							"files": map[string]any{
+								"type":        "array",
+								"description": "For file fields only: files to upload, each a local path within the Read tool's allowed roots or the ID of an artifact of this task",
+								"items": map[string]any{
+									"type": "string",
+								},
+							},
+							"frame": map[string]any{
+								"type":        "string",
+								"description": "Frame holding this field; overrides the form-level frame",
 							},
 							"type": map[string]any{
 								"type":        "string",
@@ -52,13 +77,30 @@ func NewFillFormTool(logger *zap.Logger, playwright playwright.BrowserAutomation
 							},
 							"multiple": map[string]any{
 								"type":        "boolean",
-								"description": "For select fields only: whether this is a multi-select dropdown",
+								"description": "For select fields only: whether this is a multi-select dropdown; the given options replace the current selection",
 								"default":     false,
 							},
+							"option_by": map[string]any{
+								"type":        "string",
+								"description": "For select fields only: match options by value, label or zero-based index; auto tries the value, then the label. Custom dropdowns (combobox role) match value and label against the option text",
+								"enum":        optionMatchers,
+								"default":     optionMatchers[0],
+							},
+							"values": map[string]any{
+								"type":        "array",
+								"description": "For select fields only: the options to select, instead of value; several need multiple=true",
+								"items": map[string]any{
+									"type": "string",
+								},
+							},
 						},
 					},
 					"type": "array",
 				},
+				"frame": map[string]any{
+					"description": frameDescription + ". Applies to every field without its own frame, and to the submit button",
+					"type":        "string",
+				},
 				"submit": map[string]any{
 					"default":     false,
 					"description": "Whether to submit the form after filling",
@@ -98,6 +140,23 @@ func (s *FillFormTool) FillFormHandler(ctx context.Context, args map[string]any)
 		return "", err
 	}
 
+	uploads, err := s.resolveUploads(ctx, fields)
+	if err != nil {
+		return "", err
+	}
+
+	frame, err := stringArg(args, "frame", "")
+	if err != nil {
+		return "", err
+	}
+	if frame != "" {
+		for _, field := range fields {
+			if fieldFrame, _ := field["frame"].(string); fieldFrame == "" {
+				field["frame"] = frame
+			}
+		}
+	}
+
 	submit, err := boolArg(args, "submit", false)
 	if err != nil {
 		return "", err
@@ -122,7 +181,8 @@ func (s *FillFormTool) FillFormHandler(ctx context.Context, args map[string]any)
 		return "", fmt.Errorf("failed to get browser session: %w", err)
 	}
 
-	if err := s.playwright.FillForm(ctx, session.ID, fields, submit, submitSelector); err != nil {
+	results, err := s.playwright.FillForm(ctx, session.ID, fields, submit, submitSelector)
+	if err != nil {
 		s.logger.Error("fill_form failed",
 			zap.String("sessionID", session.ID),
 			zap.Error(err))
@@ -133,17 +193,58 @@ func (s *FillFormTool) FillFormHandler(ctx context.Context, args map[string]any)
 	if submit {
 		message = fmt.Sprintf("Successfully filled %d fields and submitted form", len(fields))
 	}
+	if len(uploads) > 0 {
+		message += fmt.Sprintf(", uploading %s", strings.Join(uploads, ", "))
+	}
+	for _, result := range results {
+		if result.Type != "select" {
+			continue
+		}
+		labels := make([]string, 0, len(result.Selected))
+		for _, option := range result.Selected {
+			labels = append(labels, option.Label)
+		}
+		message += fmt.Sprintf("; %s has %s selected", result.Selector, strings.Join(labels, ", "))
+	}
 
 	s.logger.Info("fill_form completed", zap.String("sessionID", session.ID))
 
-	return marshalResponse(map[string]any{
+	response := map[string]any{
 		"success":         true,
 		"session_id":      session.ID,
 		"fields_count":    len(fields),
 		"submit":          submit,
 		"submit_selector": submitSelector,
 		"message":         message,
-	})
+	}
+	if len(results) > 0 {
+		response["fields"] = results
+	}
+	if len(uploads) > 0 {
+		response["uploaded_files"] = uploads
+	}
+	return marshalResponse(response)
+}
+
+// resolveUploads loads the files of every file field, replacing the entries
+// under "files" with their content. It returns the uploaded file names.
+func (s *FillFormTool) resolveUploads(ctx context.Context, fields []map[string]any) ([]string, error) {
+	var names []string
+	for i, field := range fields {
+		if field["type"] != "file" {
+			continue
+		}
+		entries, _ := field["files"].([]string)
+		files, err := s.uploads.resolve(ctx, entries)
+		if err != nil {
+			return nil, fmt.Errorf("field %d: %w", i, err)
+		}
+		field["files"] = files
+		for _, file := range files {
+			names = append(names, file.Name)
+		}
+	}
+	return names, nil
 }
 
 // validateAndNormalizeFields converts the raw []any into validated
@@ -161,8 +262,19 @@ func (s *FillFormTool) validateAndNormalizeFields(rawFields []any) ([]map[string
 			return nil, fmt.Errorf("field %d: selector is required and must be a non-empty string", i)
 		}
 
-		if _, hasValue := field["value"].(string); !hasValue {
-			return nil, fmt.Errorf("field %d: value is required and must be a string", i)
+		if ref, hasRef := field["credential_ref"].(string); hasRef && ref != "" {
+			if _, hasValue := field["value"]; hasValue {
+				return nil, fmt.Errorf("field %d: value and credential_ref are mutually exclusive", i)
+			}
+			if !secrets.Configured(s.secrets) {
+				return nil, fmt.Errorf("field %d: credential_ref requires a credential vault; set BROWSER_CREDENTIALS_PATH", i)
+			}
+			value, err := s.secrets.ResolveValue(ref)
+			if err != nil {
+				return nil, fmt.Errorf("field %d: %w", i, err)
+			}
+			field["value"] = value
+			delete(field, "credential_ref")
 		}
 
 		fieldType, _ := field["type"].(string)
@@ -174,7 +286,84 @@ func (s *FillFormTool) validateAndNormalizeFields(rawFields []any) ([]map[string
 			return nil, fmt.Errorf("field %d: invalid type '%s'. Must be one of: %v", i, fieldType, validFieldTypes)
 		}
 
+		if fieldType == "file" {
+			files, err := stringSliceArg(field, "files")
+			if err != nil {
+				return nil, fmt.Errorf("field %d: %w", i, err)
+			}
+			if value, _ := field["value"].(string); value != "" {
+				files = append(files, value)
+			}
+			if len(files) == 0 {
+				return nil, fmt.Errorf("field %d: file fields need files or a value", i)
+			}
+			field["files"] = files
+			delete(field, "value")
+		} else if fieldType == "select" {
+			if err := normalizeSelectField(field); err != nil {
+				return nil, fmt.Errorf("field %d: %w", i, err)
+			}
+		} else if _, hasValue := field["value"].(string); !hasValue {
+			return nil, fmt.Errorf("field %d: value is required and must be a string", i)
+		}
+
 		fields = append(fields, field)
 	}
 	return fields, nil
 }
+
+// normalizeSelectField puts the options of a select field under "values",
+// splitting a comma-separated value of a multi-select
+func normalizeSelectField(field map[string]any) error {
+	multiple, err := boolArg(field, "multiple", false)
+	if err != nil {
+		return err
+	}
+	field["multiple"] = multiple
+
+	optionBy, err := stringArg(field, "option_by", playwright.OptionByAuto)
+	if err != nil {
+		return err
+	}
+	if !oneOf(optionBy, optionMatchers...) {
+		return fmt.Errorf("invalid option_by %q: use %s", optionBy, strings.Join(optionMatchers, ", "))
+	}
+	field["option_by"] = optionBy
+
+	values, err := stringSliceArg(field, "values")
+	if err != nil {
+		return err
+	}
+	value, hasValue := field["value"].(string)
+	switch {
+	case values != nil && hasValue:
+		return fmt.Errorf("value and values are mutually exclusive")
+	case values == nil && !hasValue:
+		return fmt.Errorf("value or values is required for a select")
+	case values == nil && multiple:
+		for _, option := range strings.Split(value, ",") {
+			if option = strings.TrimSpace(option); option != "" {
+				values = append(values, option)
+			}
+		}
+	case values == nil:
+		values = []string{value}
+	}
+
+	if len(values) == 0 {
+		return fmt.Errorf("at least one option is required for a select")
+	}
+	if len(values) > 1 && !multiple {
+		return fmt.Errorf("%d options given; set multiple to true to select several", len(values))
+	}
+	if optionBy == playwright.OptionByIndex {
+		for _, option := range values {
+			if index, err := strconv.Atoi(option); err != nil || index < 0 {
+				return fmt.Errorf("option_by index needs zero-based indexes, got %q", option)
+			}
+		}
+	}
+	field["values"] = values
+	delete(field, "value")
+	return nil
+}
@@ -2,15 +2,26 @@ package tools
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
 	zap "go.uber.org/zap"
 
+	server "github.com/inference-gateway/adk/server"
+	adkmocks "github.com/inference-gateway/adk/server/mocks"
+	types "github.com/inference-gateway/adk/types"
+
+	config "github.com/inference-gateway/browser-agent/config"
 	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
 
 	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+	secrets "github.com/inference-gateway/browser-agent/internal/secrets"
 )
 
 func TestFillFormTool_FillFormHandler_ValidationTests(t *testing.T) {
@@ -184,7 +195,7 @@ func TestFillFormTool_FillFormHandler_SuccessTests(t *testing.T) {
 			session := &playwright.BrowserSession{ID: "test-session"}
 			mockPlaywright.GetOrCreateTaskSessionReturns(session, nil)
 			mockPlaywright.GetSessionReturns(session, nil)
-			mockPlaywright.FillFormReturns(nil)
+			mockPlaywright.FillFormReturns(nil, nil)
 
 			tool := &FillFormTool{logger: logger, playwright: mockPlaywright}
 			result, err := tool.FillFormHandler(context.Background(), tt.args)
@@ -209,7 +220,7 @@ func TestFillFormTool_FillFormHandler_SingleBatchCall(t *testing.T) {
 	session := &playwright.BrowserSession{ID: "test-session"}
 	mockPlaywright.GetOrCreateTaskSessionReturns(session, nil)
 	mockPlaywright.GetSessionReturns(session, nil)
-	mockPlaywright.FillFormReturns(nil)
+	mockPlaywright.FillFormReturns(nil, nil)
 
 	tool := &FillFormTool{logger: logger, playwright: mockPlaywright}
 
@@ -246,7 +257,7 @@ func TestFillFormTool_ValidateFieldTypes(t *testing.T) {
 			session := &playwright.BrowserSession{ID: "test-session"}
 			mockPlaywright.GetOrCreateTaskSessionReturns(session, nil)
 			mockPlaywright.GetSessionReturns(session, nil)
-			mockPlaywright.FillFormReturns(nil)
+			mockPlaywright.FillFormReturns(nil, nil)
 
 			tool := &FillFormTool{logger: logger, playwright: mockPlaywright}
 			_, err := tool.FillFormHandler(context.Background(), map[string]any{
@@ -273,7 +284,7 @@ func TestFillFormTool_DefaultFieldType(t *testing.T) {
 	session := &playwright.BrowserSession{ID: "test-session"}
 	mockPlaywright.GetOrCreateTaskSessionReturns(session, nil)
 	mockPlaywright.GetSessionReturns(session, nil)
-	mockPlaywright.FillFormReturns(nil)
+	mockPlaywright.FillFormReturns(nil, nil)
 
 	tool := &FillFormTool{logger: logger, playwright: mockPlaywright}
 	_, err := tool.FillFormHandler(context.Background(), map[string]any{
@@ -290,3 +301,338 @@ func TestFillFormTool_DefaultFieldType(t *testing.T) {
 	_, _, gotFields, _, _ := mockPlaywright.FillFormArgsForCall(0)
 	assert.Equal(t, "text", gotFields[0]["type"], "type should default to text")
 }
+
+func TestFillFormTool_FrameDefaultsPerField(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "test-session"}, nil)
+
+	tool := &FillFormTool{logger: zap.NewNop(), playwright: mockPlaywright}
+	_, err := tool.FillFormHandler(context.Background(), map[string]any{
+		"frame": "payment",
+		"fields": []any{
+			map[string]any{"selector": "#card", "value": "4242"},
+			map[string]any{"selector": "#email", "value": "a@example.com", "frame": "url=**/profile"},
+		},
+	})
+	assert.NoError(t, err)
+
+	_, _, gotFields, _, _ := mockPlaywright.FillFormArgsForCall(0)
+	assert.Equal(t, "payment", gotFields[0]["frame"])
+	assert.Equal(t, "url=**/profile", gotFields[1]["frame"], "a field's own frame wins")
+}
+
+func TestFillFormTool_CredentialRef(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "test-session"}, nil)
+
+	store := secrets.NewVault(map[string]secrets.Credential{
+		"github-bot": {"username": "octo-bot", "password": "hunter2-secret"},
+	})
+	tool := &FillFormTool{logger: zap.NewNop(), playwright: mockPlaywright, secrets: store}
+
+	result, err := tool.FillFormHandler(context.Background(), map[string]any{
+		"fields": []any{
+			map[string]any{"selector": "#user", "credential_ref": "vault://github-bot#username"},
+			map[string]any{"selector": "#pass", "credential_ref": "vault://github-bot", "type": "password"},
+		},
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, result, "hunter2-secret")
+
+	_, _, gotFields, _, _ := mockPlaywright.FillFormArgsForCall(0)
+	assert.Equal(t, "octo-bot", gotFields[0]["value"])
+	assert.Equal(t, "hunter2-secret", gotFields[1]["value"], "a reference without a field resolves the password")
+	assert.NotContains(t, gotFields[1], "credential_ref")
+
+	_, err = tool.FillFormHandler(context.Background(), map[string]any{
+		"fields": []any{
+			map[string]any{"selector": "#pass", "value": "x", "credential_ref": "vault://github-bot"},
+		},
+	})
+	assert.ErrorContains(t, err, "mutually exclusive")
+
+	_, err = tool.FillFormHandler(context.Background(), map[string]any{
+		"fields": []any{
+			map[string]any{"selector": "#pass", "credential_ref": "vault://unknown"},
+		},
+	})
+	assert.ErrorContains(t, err, "not found")
+
+	for _, store := range []secrets.CredentialStore{nil, secrets.NewVault(nil)} {
+		noVault := &FillFormTool{logger: zap.NewNop(), playwright: mockPlaywright, secrets: store}
+		_, err = noVault.FillFormHandler(context.Background(), map[string]any{
+			"fields": []any{
+				map[string]any{"selector": "#pass", "credential_ref": "vault://github-bot"},
+			},
+		})
+		assert.ErrorContains(t, err, "requires a credential vault", "an empty vault points to BROWSER_CREDENTIALS_PATH")
+	}
+}
+
+func TestNewUploadSources(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetConfigReturns(&config.Config{Browser: config.BrowserConfig{
+		DataDir:         "/data",
+		CredentialsPath: "/etc/agent/vault.json",
+	}})
+	uploads := newUploadSources(zap.NewNop(), mockPlaywright)
+	require.NoError(t, uploads.err)
+	assert.Contains(t, uploads.denied, "/etc/agent/vault.json", "the denied paths come from the browser service's config")
+
+	uploads = newUploadSources(zap.NewNop(), &mocks.FakeBrowserAutomation{})
+	assert.ErrorContains(t, uploads.err, "browser config is unavailable")
+}
+
+func TestFillFormTool_FileUploads(t *testing.T) {
+	root := t.TempDir()
+	resume := filepath.Join(root, "resume.pdf")
+	require.NoError(t, os.WriteFile(resume, []byte("%PDF-1.4"), 0o644))
+	notes := filepath.Join(root, "notes.txt")
+	require.NoError(t, os.WriteFile(notes, []byte("hello"), 0o644))
+	outside := filepath.Join(t.TempDir(), "secret.txt")
+	require.NoError(t, os.WriteFile(outside, []byte("secret"), 0o644))
+	vault := filepath.Join(root, "vault.json")
+	require.NoError(t, os.WriteFile(vault, []byte(`{"github-bot":{}}`), 0o600))
+	profiles := filepath.Join(root, "profiles")
+	require.NoError(t, os.Mkdir(profiles, 0o700))
+	profile := filepath.Join(profiles, "shop.json")
+	require.NoError(t, os.WriteFile(profile, []byte(`{"cookies":[]}`), 0o600))
+	link := filepath.Join(root, "vault-link.json")
+	require.NoError(t, os.Symlink(vault, link))
+
+	newTool := func() (*FillFormTool, *mocks.FakeBrowserAutomation) {
+		mockPlaywright := &mocks.FakeBrowserAutomation{}
+		mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "test-session"}, nil)
+		tool := &FillFormTool{logger: zap.NewNop(), playwright: mockPlaywright}
+		tool.uploads.read = &ReadTool{cfg: ReadConfig{Enabled: true, AllowedRoots: []string{root}}}
+		tool.uploads.denied = []string{vault, profiles}
+		return tool, mockPlaywright
+	}
+
+	t.Run("paths within the allowed roots are uploaded", func(t *testing.T) {
+		tool, mockPlaywright := newTool()
+		result, err := tool.FillFormHandler(context.Background(), map[string]any{
+			"fields": []any{
+				map[string]any{"selector": "#attachments", "type": "file", "files": []any{resume, notes}},
+			},
+		})
+		require.NoError(t, err)
+		assert.Contains(t, result, "resume.pdf")
+
+		_, _, gotFields, _, _ := mockPlaywright.FillFormArgsForCall(0)
+		files, ok := gotFields[0]["files"].([]playwright.UploadFile)
+		require.True(t, ok)
+		require.Len(t, files, 2)
+		assert.Equal(t, "resume.pdf", files[0].Name)
+		assert.Equal(t, "application/pdf", files[0].MimeType)
+		assert.Equal(t, []byte("%PDF-1.4"), files[0].Buffer)
+		assert.Equal(t, []byte("hello"), files[1].Buffer)
+	})
+
+	t.Run("value is a single file", func(t *testing.T) {
+		tool, mockPlaywright := newTool()
+		_, err := tool.FillFormHandler(context.Background(), map[string]any{
+			"fields": []any{
+				map[string]any{"selector": "#avatar", "type": "file", "value": notes},
+			},
+		})
+		require.NoError(t, err)
+
+		_, _, gotFields, _, _ := mockPlaywright.FillFormArgsForCall(0)
+		assert.Len(t, gotFields[0]["files"], 1)
+		assert.NotContains(t, gotFields[0], "value")
+	})
+
+	t.Run("paths outside the allowed roots are rejected", func(t *testing.T) {
+		tool, mockPlaywright := newTool()
+		_, err := tool.FillFormHandler(context.Background(), map[string]any{
+			"fields": []any{
+				map[string]any{"selector": "#avatar", "type": "file", "files": []any{outside}},
+			},
+		})
+		assert.Error(t, err)
+		assert.Equal(t, 0, mockPlaywright.FillFormCallCount())
+	})
+
+	t.Run("credentials and saved state are never uploaded", func(t *testing.T) {
+		for _, path := range []string{vault, profile, link} {
+			tool, mockPlaywright := newTool()
+			_, err := tool.FillFormHandler(context.Background(), map[string]any{
+				"fields": []any{
+					map[string]any{"selector": "#avatar", "type": "file", "files": []any{path}},
+				},
+			})
+			assert.ErrorContains(t, err, "cannot be uploaded", path)
+			assert.Equal(t, 0, mockPlaywright.FillFormCallCount())
+		}
+	})
+
+	t.Run("local paths are refused unless the Read tool is enabled and rooted", func(t *testing.T) {
+		for name, cfg := range map[string]ReadConfig{
+			"disabled": {Enabled: false, AllowedRoots: []string{root}},
+			"unrooted": {Enabled: true},
+		} {
+			tool, mockPlaywright := newTool()
+			tool.uploads.read = &ReadTool{cfg: cfg}
+			_, err := tool.FillFormHandler(context.Background(), map[string]any{
+				"fields": []any{
+					map[string]any{"selector": "#avatar", "type": "file", "files": []any{notes}},
+				},
+			})
+			assert.ErrorContains(t, err, "local file uploads are disabled", name)
+			assert.Equal(t, 0, mockPlaywright.FillFormCallCount(), name)
+		}
+	})
+
+	t.Run("local paths are refused when their restrictions failed to load", func(t *testing.T) {
+		tool, mockPlaywright := newTool()
+		tool.uploads.err = errors.New("failed to load Read config: invalid TOOLS_READ_MAX_LINES")
+		_, err := tool.FillFormHandler(context.Background(), map[string]any{
+			"fields": []any{
+				map[string]any{"selector": "#avatar", "type": "file", "files": []any{notes}},
+			},
+		})
+		assert.ErrorContains(t, err, "local file uploads are disabled")
+		assert.Equal(t, 0, mockPlaywright.FillFormCallCount())
+	})
+
+	t.Run("artifacts of the task are uploaded", func(t *testing.T) {
+		tool, mockPlaywright := newTool()
+		content := base64.StdEncoding.EncodeToString([]byte("a,b\n1,2\n"))
+		artifact := &types.Artifact{
+			ArtifactID: "artifact-1",
+			Parts: []types.Part{{File: &types.FilePart{
+				Name:          "report.csv",
+				MediaType:     "text/csv",
+				FileWithBytes: &content,
+			}}},
+		}
+		artifactService := &adkmocks.FakeArtifactService{}
+		artifactService.GetArtifactByIDStub = func(_ *types.Task, id string) (*types.Artifact, bool) {
+			return artifact, id == artifact.ArtifactID
+		}
+		ctx := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: "task-1", ContextID: "ctx-1"})
+		ctx = context.WithValue(ctx, server.ArtifactServiceContextKey, server.ArtifactService(artifactService))
+
+		_, err := tool.FillFormHandler(ctx, map[string]any{
+			"fields": []any{
+				map[string]any{"selector": "#import", "type": "file", "files": []any{"artifact-1", notes}},
+			},
+		})
+		require.NoError(t, err)
+
+		_, _, gotFields, _, _ := mockPlaywright.FillFormArgsForCall(0)
+		files := gotFields[0]["files"].([]playwright.UploadFile)
+		require.Len(t, files, 2)
+		assert.Equal(t, "report.csv", files[0].Name)
+		assert.Equal(t, "text/csv", files[0].MimeType)
+		assert.Equal(t, []byte("a,b\n1,2\n"), files[0].Buffer)
+		assert.Equal(t, "notes.txt", files[1].Name)
+	})
+
+	t.Run("file fields need files", func(t *testing.T) {
+		tool, mockPlaywright := newTool()
+		_, err := tool.FillFormHandler(context.Background(), map[string]any{
+			"fields": []any{
+				map[string]any{"selector": "#avatar", "type": "file"},
+			},
+		})
+		assert.ErrorContains(t, err, "file fields need files or a value")
+		assert.Equal(t, 0, mockPlaywright.FillFormCallCount())
+	})
+}
+
+func TestFillFormTool_SelectOptions(t *testing.T) {
+	t.Run("multiple splits a comma-separated value", func(t *testing.T) {
+		mockPlaywright := &mocks.FakeBrowserAutomation{}
+		mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "test-session"}, nil)
+		mockPlaywright.FillFormReturns([]playwright.FieldResult{{
+			Selector: "#toppings",
+			Type:     "select",
+			Selected: []playwright.SelectedOption{
+				{Value: "ham", Label: "Ham", Index: 0},
+				{Value: "olives", Label: "Olives", Index: 2},
+			},
+		}}, nil)
+		tool := &FillFormTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+		result, err := tool.FillFormHandler(context.Background(), map[string]any{
+			"fields": []any{
+				map[string]any{"selector": "#toppings", "type": "select", "multiple": true, "value": "ham, olives"},
+			},
+		})
+		require.NoError(t, err)
+
+		_, _, gotFields, _, _ := mockPlaywright.FillFormArgsForCall(0)
+		assert.Equal(t, []string{"ham", "olives"}, gotFields[0]["values"])
+		assert.Equal(t, "auto", gotFields[0]["option_by"])
+		assert.NotContains(t, gotFields[0], "value")
+
+		var parsed map[string]any
+		require.NoError(t, json.Unmarshal([]byte(result), &parsed))
+		assert.Contains(t, parsed["message"], "#toppings has Ham, Olives selected")
+		fields := parsed["fields"].([]any)
+		selected := fields[0].(map[string]any)["selected"].([]any)
+		assert.Equal(t, map[string]any{"value": "olives", "label": "Olives", "index": float64(2)}, selected[1])
+	})
+
+	t.Run("values by label and index", func(t *testing.T) {
+		mockPlaywright := &mocks.FakeBrowserAutomation{}
+		mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "test-session"}, nil)
+		tool := &FillFormTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+		_, err := tool.FillFormHandler(context.Background(), map[string]any{
+			"fields": []any{
+				map[string]any{"selector": "#country", "type": "select", "option_by": "label", "values": []any{"Korea, Republic of"}},
+				map[string]any{"selector": "#size", "type": "select", "option_by": "index", "value": "2"},
+			},
+		})
+		require.NoError(t, err)
+
+		_, _, gotFields, _, _ := mockPlaywright.FillFormArgsForCall(0)
+		assert.Equal(t, []string{"Korea, Republic of"}, gotFields[0]["values"], "values are not split on commas")
+		assert.Equal(t, []string{"2"}, gotFields[1]["values"])
+	})
+
+	invalid := []struct {
+		name          string
+		field         map[string]any
+		errorContains string
+	}{
+		{
+			name:          "several options without multiple",
+			field:         map[string]any{"selector": "#s", "type": "select", "values": []any{"a", "b"}},
+			errorContains: "set multiple to true",
+		},
+		{
+			name:          "value and values",
+			field:         map[string]any{"selector": "#s", "type": "select", "value": "a", "values": []any{"b"}},
+			errorContains: "mutually exclusive",
+		},
+		{
+			name:          "no options",
+			field:         map[string]any{"selector": "#s", "type": "select"},
+			errorContains: "value or values is required",
+		},
+		{
+			name:          "unknown option_by",
+			field:         map[string]any{"selector": "#s", "type": "select", "value": "a", "option_by": "text"},
+			errorContains: "invalid option_by",
+		},
+		{
+			name:          "non-numeric index",
+			field:         map[string]any{"selector": "#s", "type": "select", "value": "first", "option_by": "index"},
+			errorContains: "zero-based indexes",
+		},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			tool := &FillFormTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			_, err := tool.FillFormHandler(context.Background(), map[string]any{"fields": []any{tt.field}})
+			assert.ErrorContains(t, err, tt.errorContains)
+			assert.Equal(t, 0, mockPlaywright.FillFormCallCount(), "invalid arguments are rejected before the service is called")
+		})
+	}
+}
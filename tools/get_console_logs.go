@@ -0,0 +1,163 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+const (
+	defaultConsoleLogLimit = 100
+	maxConsoleLogLimit     = 1000
+)
+
+// consoleLevels are the values of the level argument, least severe first
+var consoleLevels = []string{
+	playwright.ConsoleLevelDebug, playwright.ConsoleLevelInfo, playwright.ConsoleLevelWarning, playwright.ConsoleLevelError,
+}
+
+// consoleSources are the values of the sources argument
+var consoleSources = []string{
+	playwright.ConsoleSourceConsole, playwright.ConsoleSourcePageError, playwright.ConsoleSourceNetwork,
+}
+
+// GetConsoleLogsTool struct holds the tool with dependencies
+type GetConsoleLogsTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewGetConsoleLogsTool creates a new get_console_logs tool
+func NewGetConsoleLogsTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &GetConsoleLogsTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"get_console_logs",
+		"Read the browser console of the session's pages: console messages, uncaught JavaScript exceptions (source pageerror) and failed requests, oldest first. By default only what was logged since the previous call is returned, so call it after each step of a test; page_errors above zero means the page threw",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"cursor": map[string]any{
+					"description": "Return entries newer than this cursor, from an earlier result, instead of since the last call; 0 returns the whole log",
+					"type":        "integer",
+				},
+				"level": map[string]any{
+					"default":     consoleLevels[0],
+					"description": "Minimum level to return",
+					"enum":        consoleLevels,
+					"type":        "string",
+				},
+				"limit": map[string]any{
+					"default":     defaultConsoleLogLimit,
+					"description": "Maximum number of entries to return; the most recent matches are kept",
+					"type":        "integer",
+				},
+				"sources": map[string]any{
+					"description": "Sources to return: console messages, pageerror for uncaught exceptions, network for failed requests",
+					"items": map[string]any{
+						"enum": consoleSources,
+						"type": "string",
+					},
+					"type": "array",
+				},
+			},
+		},
+		tool.GetConsoleLogsHandler,
+	)
+}
+
+// GetConsoleLogsHandler handles the get_console_logs tool execution
+func (s *GetConsoleLogsTool) GetConsoleLogsHandler(ctx context.Context, args map[string]any) (string, error) {
+	level, err := stringArg(args, "level", playwright.ConsoleLevelDebug)
+	if err != nil {
+		return "", err
+	}
+	level = strings.ToLower(level)
+	if !oneOf(level, consoleLevels...) {
+		return "", fmt.Errorf("invalid level %q: use %s", level, strings.Join(consoleLevels, ", "))
+	}
+
+	sources, err := stringSliceArg(args, "sources")
+	if err != nil {
+		return "", err
+	}
+	for _, source := range sources {
+		if !oneOf(source, consoleSources...) {
+			return "", fmt.Errorf("sources must contain only %s, got %q", strings.Join(consoleSources, ", "), source)
+		}
+	}
+
+	limit, err := boundedIntArg(args, "limit", defaultConsoleLogLimit, 1, maxConsoleLogLimit)
+	if err != nil {
+		return "", err
+	}
+
+	hasCursor := args["cursor"] != nil
+	cursor, err := intArg(args, "cursor", 0)
+	if err != nil {
+		return "", err
+	}
+	if cursor < 0 {
+		return "", fmt.Errorf("cursor must not be negative, got %d", cursor)
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	logs, err := s.playwright.GetConsoleLogs(ctx, session.ID, playwright.ConsoleFilter{
+		MinLevel:      level,
+		Sources:       sources,
+		SinceID:       cursor,
+		SinceLastCall: !hasCursor,
+		Limit:         limit,
+	})
+	if err != nil {
+		s.logger.Error("failed to read console logs",
+			zap.String("sessionID", session.ID),
+			zap.Error(err))
+		return "", fmt.Errorf("failed to read console logs: %w", err)
+	}
+
+	s.logger.Info("console logs read",
+		zap.String("sessionID", session.ID),
+		zap.Int("matched", logs.Matched),
+		zap.Int("pageErrors", logs.PageErrors))
+
+	since := "since the last call"
+	if hasCursor {
+		since = fmt.Sprintf("after cursor %d", cursor)
+	}
+	message := fmt.Sprintf("%d entries %s, %d errors", logs.Matched, since, logs.Errors)
+	if logs.PageErrors > 0 {
+		message = fmt.Sprintf("%d uncaught JavaScript exceptions; %s", logs.PageErrors, message)
+	}
+	if logs.Matched > len(logs.Entries) {
+		message += fmt.Sprintf("; showing the %d most recent", len(logs.Entries))
+	}
+	if logs.Dropped > 0 {
+		message += fmt.Sprintf("; %d older entries were dropped from the log", logs.Dropped)
+	}
+
+	return marshalResponse(map[string]any{
+		"success":     true,
+		"entries":     logs.Entries,
+		"matched":     logs.Matched,
+		"errors":      logs.Errors,
+		"page_errors": logs.PageErrors,
+		"cursor":      logs.Cursor,
+		"dropped":     logs.Dropped,
+		"session_id":  session.ID,
+		"message":     message,
+	})
+}
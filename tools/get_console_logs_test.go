@@ -0,0 +1,132 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+func TestGetConsoleLogsTool_GetConsoleLogsHandler(t *testing.T) {
+	setupLogs := func(m *mocks.FakeBrowserAutomation) {
+		m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+		m.GetConsoleLogsReturns(&playwright.ConsoleLogs{
+			Entries: []playwright.ConsoleEntry{{
+				ID:     4,
+				Source: playwright.ConsoleSourcePageError,
+				Level:  playwright.ConsoleLevelError,
+				Type:   "TypeError",
+				Text:   "Cannot read properties of undefined (reading 'total')",
+			}},
+			Matched:    1,
+			Errors:     1,
+			PageErrors: 1,
+			Cursor:     4,
+		}, nil)
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		setupMock     func(*mocks.FakeBrowserAutomation)
+		expectedError bool
+		errorContains string
+		verify        func(*testing.T, *mocks.FakeBrowserAutomation, map[string]any)
+	}{
+		{
+			name:      "entries since the last call at a minimum level",
+			args:      map[string]any{"level": "Warning"},
+			setupMock: setupLogs,
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, response map[string]any) {
+				assert.Equal(t, float64(1), response["page_errors"])
+				assert.Equal(t, float64(4), response["cursor"])
+				assert.Contains(t, response["message"], "1 uncaught JavaScript exceptions")
+				require.Len(t, response["entries"], 1)
+
+				_, sessionID, filter := m.GetConsoleLogsArgsForCall(0)
+				assert.Equal(t, "session-1", sessionID)
+				assert.Equal(t, playwright.ConsoleFilter{
+					MinLevel:      playwright.ConsoleLevelWarning,
+					SinceLastCall: true,
+					Limit:         defaultConsoleLogLimit,
+				}, filter)
+			},
+		},
+		{
+			name:      "explicit cursor and sources",
+			args:      map[string]any{"cursor": 0, "sources": []any{"pageerror"}},
+			setupMock: setupLogs,
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, _ map[string]any) {
+				_, _, filter := m.GetConsoleLogsArgsForCall(0)
+				assert.False(t, filter.SinceLastCall, "an explicit cursor replaces the since-last-call cursor")
+				assert.Equal(t, []string{"pageerror"}, filter.Sources)
+			},
+		},
+		{
+			name:          "invalid level",
+			args:          map[string]any{"level": "fatal"},
+			expectedError: true,
+			errorContains: "invalid level",
+		},
+		{
+			name:          "invalid source",
+			args:          map[string]any{"sources": []any{"stdout"}},
+			expectedError: true,
+			errorContains: "sources",
+		},
+		{
+			name:          "negative cursor",
+			args:          map[string]any{"cursor": -1},
+			expectedError: true,
+			errorContains: "cursor",
+		},
+		{
+			name: "capture disabled",
+			args: map[string]any{},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.GetConsoleLogsReturns(nil, errors.New("console capture is disabled"))
+			},
+			expectedError: true,
+			errorContains: "console capture is disabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			if tt.setupMock != nil {
+				tt.setupMock(mockPlaywright)
+			}
+			tool := &GetConsoleLogsTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			result, err := tool.GetConsoleLogsHandler(context.Background(), tt.args)
+
+			if tt.expectedError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.Empty(t, result)
+				if tt.setupMock == nil {
+					assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			var response map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(result), &response), "response should be valid JSON")
+			assert.Equal(t, true, response["success"])
+			if tt.verify != nil {
+				tt.verify(t, mockPlaywright, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// GetCookiesTool struct holds the tool with dependencies
+type GetCookiesTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewGetCookiesTool creates a new get_cookies tool
+func NewGetCookiesTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &GetCookiesTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"get_cookies",
+		"List the cookies of the browser session, including httpOnly cookies that page scripts cannot see, with their domain, path, expiry and flags",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"domain": map[string]any{
+					"description": "Only cookies of this domain and its subdomains, e.g. example.com",
+					"type":        "string",
+				},
+				"http_only": map[string]any{
+					"description": "true for only httpOnly cookies, false for only cookies visible to page scripts. By default both",
+					"type":        "boolean",
+				},
+				"name": map[string]any{
+					"description": "Only cookies with this name",
+					"type":        "string",
+				},
+				"path": map[string]any{
+					"description": "Only cookies with this path",
+					"type":        "string",
+				},
+			},
+		},
+		tool.GetCookiesHandler,
+	)
+}
+
+// GetCookiesHandler handles the get_cookies tool execution
+func (s *GetCookiesTool) GetCookiesHandler(ctx context.Context, args map[string]any) (string, error) {
+	filter, err := cookieFilterArgs(args)
+	if err != nil {
+		return "", err
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	cookies, err := s.playwright.GetCookies(ctx, session.ID, filter)
+	if err != nil {
+		s.logger.Error("failed to get cookies", zap.String("sessionID", session.ID), zap.Error(err))
+		return "", fmt.Errorf("get cookies failed: %w", err)
+	}
+
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"cookies":    cookies,
+		"count":      len(cookies),
+		"session_id": session.ID,
+	})
+}
+
+// cookieFilterArgs reads the domain, name, path and http_only filter
+// arguments shared by the cookie tools
+func cookieFilterArgs(args map[string]any) (playwright.CookieFilter, error) {
+	var filter playwright.CookieFilter
+	var err error
+	if filter.Domain, err = stringArg(args, "domain", ""); err != nil {
+		return filter, err
+	}
+	if filter.Name, err = stringArg(args, "name", ""); err != nil {
+		return filter, err
+	}
+	if filter.Path, err = stringArg(args, "path", ""); err != nil {
+		return filter, err
+	}
+	filter.HTTPOnly, err = optionalBoolArg(args, "http_only")
+	return filter, err
+}
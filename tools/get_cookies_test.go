@@ -0,0 +1,105 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+)
+
+func TestGetCookiesTool_GetCookiesHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]any
+		setupMock     func(*mocks.FakeBrowserAutomation)
+		expectedError bool
+		errorContains string
+		verify        func(*testing.T, *mocks.FakeBrowserAutomation, map[string]any)
+	}{
+		{
+			name: "filters cookies by domain and httpOnly",
+			args: map[string]any{"domain": "example.com", "http_only": true},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+				m.GetCookiesReturns([]playwright.Cookie{
+					{Name: "session", Value: "abc", Domain: "app.example.com", Path: "/", HTTPOnly: true, Secure: true, SameSite: "Lax"},
+				}, nil)
+			},
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, response map[string]any) {
+				assert.Equal(t, float64(1), response["count"])
+				cookies := response["cookies"].([]any)
+				assert.Equal(t, true, cookies[0].(map[string]any)["http_only"], "httpOnly cookies are visible")
+
+				_, sessionID, filter := m.GetCookiesArgsForCall(0)
+				assert.Equal(t, "task-1", sessionID)
+				assert.Equal(t, "example.com", filter.Domain)
+				require.NotNil(t, filter.HTTPOnly)
+				assert.True(t, *filter.HTTPOnly)
+			},
+		},
+		{
+			name: "http_only unset matches both",
+			args: map[string]any{},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+			},
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, _ map[string]any) {
+				_, _, filter := m.GetCookiesArgsForCall(0)
+				assert.Nil(t, filter.HTTPOnly)
+			},
+		},
+		{
+			name:          "http_only is not a boolean",
+			args:          map[string]any{"http_only": "yes"},
+			expectedError: true,
+			errorContains: "http_only",
+		},
+		{
+			name: "reading cookies fails",
+			args: map[string]any{},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+				m.GetCookiesReturns(nil, errors.New("session closed"))
+			},
+			expectedError: true,
+			errorContains: "get cookies failed: session closed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			if tt.setupMock != nil {
+				tt.setupMock(mockPlaywright)
+			}
+			tool := &GetCookiesTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			result, err := tool.GetCookiesHandler(context.Background(), tt.args)
+
+			if tt.expectedError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.Empty(t, result)
+				if tt.setupMock == nil {
+					assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			var response map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(result), &response), "response should be valid JSON")
+			assert.Equal(t, true, response["success"])
+			if tt.verify != nil {
+				tt.verify(t, mockPlaywright, response)
+			}
+		})
+	}
+}
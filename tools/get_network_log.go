@@ -0,0 +1,224 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+const (
+	defaultNetworkLogLimit = 50
+	maxNetworkLogLimit     = 500
+	defaultBodyChars       = 10000
+	minBodyChars           = 100
+	maxBodyChars           = 200000
+)
+
+// resourceTypes are the request types Playwright reports
+var resourceTypes = []string{
+	"document", "stylesheet", "image", "media", "font", "script", "texttrack",
+	"xhr", "fetch", "eventsource", "websocket", "manifest", "other",
+}
+
+// GetNetworkLogTool struct holds the tool with dependencies
+type GetNetworkLogTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewGetNetworkLogTool creates a new get_network_log tool
+func NewGetNetworkLogTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &GetNetworkLogTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"get_network_log",
+		"List the requests the browser session's pages made (URL, method, status, resource type, size, timing), most recent last. Filter by URL pattern, method, status or resource type; use resource_types [xhr, fetch] to find the backend API a page calls, and include_bodies to see its JSON responses",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"include_bodies": map[string]any{
+					"default":     false,
+					"description": "Include the bodies of JSON responses",
+					"type":        "boolean",
+				},
+				"limit": map[string]any{
+					"default":     defaultNetworkLogLimit,
+					"description": "Maximum number of requests to return; the most recent matches are kept",
+					"type":        "integer",
+				},
+				"max_body_chars": map[string]any{
+					"default":     defaultBodyChars,
+					"description": "Maximum size of each included body; longer bodies are cut off",
+					"type":        "integer",
+				},
+				"method": map[string]any{
+					"description": "HTTP method to match, e.g. POST",
+					"type":        "string",
+				},
+				"resource_types": map[string]any{
+					"description": "Resource types to match",
+					"items": map[string]any{
+						"enum": resourceTypes,
+						"type": "string",
+					},
+					"type": "array",
+				},
+				"status": map[string]any{
+					"description": "Status to match: a code (404), a class (4xx), a range (400-499), or 'failed' for requests that got no response",
+					"type":        "string",
+				},
+				"url_pattern": map[string]any{
+					"description": "URL to match: a glob (**/api/**), a /regex/, or a substring",
+					"type":        "string",
+				},
+			},
+		},
+		tool.GetNetworkLogHandler,
+	)
+}
+
+// GetNetworkLogHandler handles the get_network_log tool execution
+func (s *GetNetworkLogTool) GetNetworkLogHandler(ctx context.Context, args map[string]any) (string, error) {
+	urlPattern, err := stringArg(args, "url_pattern", "")
+	if err != nil {
+		return "", err
+	}
+
+	method, err := stringArg(args, "method", "")
+	if err != nil {
+		return "", err
+	}
+
+	status, err := stringArg(args, "status", "")
+	if err != nil {
+		return "", err
+	}
+	statusMin, statusMax, failedOnly, err := parseStatusFilter(status)
+	if err != nil {
+		return "", err
+	}
+
+	types, err := resourceTypesArg(args)
+	if err != nil {
+		return "", err
+	}
+
+	limit, err := boundedIntArg(args, "limit", defaultNetworkLogLimit, 1, maxNetworkLogLimit)
+	if err != nil {
+		return "", err
+	}
+
+	includeBodies, err := boolArg(args, "include_bodies", false)
+	if err != nil {
+		return "", err
+	}
+
+	maxBody, err := boundedIntArg(args, "max_body_chars", defaultBodyChars, minBodyChars, maxBodyChars)
+	if err != nil {
+		return "", err
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	log, err := s.playwright.GetNetworkLog(ctx, session.ID, playwright.NetworkFilter{
+		URLPattern:    urlPattern,
+		Method:        method,
+		ResourceTypes: types,
+		StatusMin:     statusMin,
+		StatusMax:     statusMax,
+		FailedOnly:    failedOnly,
+		Limit:         limit,
+		IncludeBodies: includeBodies,
+		MaxBodyBytes:  maxBody,
+	})
+	if err != nil {
+		s.logger.Error("failed to read network log",
+			zap.String("sessionID", session.ID),
+			zap.Error(err))
+		return "", fmt.Errorf("failed to read network log: %w", err)
+	}
+
+	s.logger.Info("network log read",
+		zap.String("sessionID", session.ID),
+		zap.Int("matched", log.Matched),
+		zap.Int("returned", len(log.Entries)))
+
+	message := fmt.Sprintf("%d matching requests", log.Matched)
+	if log.Matched > len(log.Entries) {
+		message = fmt.Sprintf("Showing the %d most recent of %d matching requests; narrow the filter or raise limit to see more", len(log.Entries), log.Matched)
+	}
+	if log.Dropped > 0 {
+		message += fmt.Sprintf("; %d older requests were dropped from the log", log.Dropped)
+	}
+
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"requests":   log.Entries,
+		"matched":    log.Matched,
+		"recorded":   log.Recorded,
+		"dropped":    log.Dropped,
+		"session_id": session.ID,
+		"message":    message,
+	})
+}
+
+// resourceTypesArg returns the resource_types argument, checked against
+// the types Playwright reports
+func resourceTypesArg(args map[string]any) ([]string, error) {
+	types, err := stringSliceArg(args, "resource_types")
+	if err != nil {
+		return nil, err
+	}
+	for _, resourceType := range types {
+		if !oneOf(resourceType, resourceTypes...) {
+			return nil, fmt.Errorf("resource_types must contain only %s, got %q", strings.Join(resourceTypes, ", "), resourceType)
+		}
+	}
+	return types, nil
+}
+
+// parseStatusFilter turns a status argument into an inclusive range, or
+// failedOnly for "failed". An empty status matches everything.
+func parseStatusFilter(status string) (statusMin, statusMax int, failedOnly bool, err error) {
+	status = strings.ToLower(strings.TrimSpace(status))
+	switch {
+	case status == "":
+		return 0, 0, false, nil
+	case status == "failed":
+		return 0, 0, true, nil
+	case len(status) == 3 && strings.HasSuffix(status, "xx"):
+		class, convErr := strconv.Atoi(status[:1])
+		if convErr != nil || class < 1 || class > 5 {
+			break
+		}
+		return class * 100, class*100 + 99, false, nil
+	case strings.Contains(status, "-"):
+		low, high, _ := strings.Cut(status, "-")
+		lowCode, lowErr := strconv.Atoi(strings.TrimSpace(low))
+		highCode, highErr := strconv.Atoi(strings.TrimSpace(high))
+		if lowErr != nil || highErr != nil || lowCode < 100 || highCode < lowCode || highCode > 599 {
+			break
+		}
+		return lowCode, highCode, false, nil
+	default:
+		code, convErr := strconv.Atoi(status)
+		if convErr != nil || code < 100 || code > 599 {
+			break
+		}
+		return code, code, false, nil
+	}
+	return 0, 0, false, fmt.Errorf("invalid status %q: use a code (404), a class (4xx), a range (400-499) or 'failed'", status)
+}
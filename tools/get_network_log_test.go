@@ -0,0 +1,114 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+func TestGetNetworkLogTool_GetNetworkLogHandler(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+	mockPlaywright.GetNetworkLogReturns(&playwright.NetworkLog{
+		Entries: []playwright.NetworkEntry{{
+			ID:           7,
+			URL:          "https://shop.example.com/api/products?page=2",
+			Method:       "GET",
+			ResourceType: "fetch",
+			Status:       200,
+			MimeType:     "application/json",
+			Finished:     true,
+			Body:         `{"items":[]}`,
+		}},
+		Matched:  3,
+		Recorded: 40,
+	}, nil)
+	tool := &GetNetworkLogTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+	result, err := tool.GetNetworkLogHandler(context.Background(), map[string]any{
+		"url_pattern":    "**/api/**",
+		"resource_types": []any{"xhr", "fetch"},
+		"status":         "2xx",
+		"limit":          1,
+		"include_bodies": true,
+	})
+	require.NoError(t, err)
+
+	var response map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result), &response))
+	requests := response["requests"].([]any)
+	require.Len(t, requests, 1)
+	assert.Equal(t, `{"items":[]}`, requests[0].(map[string]any)["body"])
+	assert.Contains(t, response["message"], "Showing the 1 most recent of 3")
+
+	_, sessionID, filter := mockPlaywright.GetNetworkLogArgsForCall(0)
+	assert.Equal(t, "session-1", sessionID)
+	assert.Equal(t, playwright.NetworkFilter{
+		URLPattern:    "**/api/**",
+		ResourceTypes: []string{"xhr", "fetch"},
+		StatusMin:     200,
+		StatusMax:     299,
+		Limit:         1,
+		IncludeBodies: true,
+		MaxBodyBytes:  defaultBodyChars,
+	}, filter)
+}
+
+func TestGetNetworkLogTool_GetNetworkLogHandler_Errors(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+	mockPlaywright.GetNetworkLogReturns(nil, errors.New("network capture is disabled"))
+	tool := &GetNetworkLogTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+	_, err := tool.GetNetworkLogHandler(context.Background(), map[string]any{"status": "6xx"})
+	assert.ErrorContains(t, err, "invalid status")
+
+	_, err = tool.GetNetworkLogHandler(context.Background(), map[string]any{"resource_types": []any{"ajax"}})
+	assert.ErrorContains(t, err, "resource_types")
+
+	_, err = tool.GetNetworkLogHandler(context.Background(), map[string]any{})
+	assert.ErrorContains(t, err, "network capture is disabled")
+	assert.Equal(t, 1, mockPlaywright.GetNetworkLogCallCount(), "invalid arguments are rejected before the service is called")
+}
+
+func TestParseStatusFilter(t *testing.T) {
+	tests := []struct {
+		status     string
+		min, max   int
+		failedOnly bool
+		wantErr    bool
+	}{
+		{status: ""},
+		{status: "404", min: 404, max: 404},
+		{status: "5XX", min: 500, max: 599},
+		{status: "300 - 399", min: 300, max: 399},
+		{status: "failed", failedOnly: true},
+		{status: "0xx", wantErr: true},
+		{status: "499-400", wantErr: true},
+		{status: "teapot", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			statusMin, statusMax, failedOnly, err := parseStatusFilter(tt.status)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.min, statusMin)
+			assert.Equal(t, tt.max, statusMax)
+			assert.Equal(t, tt.failedOnly, failedOnly)
+		})
+	}
+}
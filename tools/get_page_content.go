@@ -0,0 +1,178 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	markdown "github.com/inference-gateway/browser-agent/internal/markdown"
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+const (
+	defaultContentChars = 20000
+	minContentChars     = 500
+	maxContentChars     = 100000
+)
+
+// GetPageContentTool struct holds the tool with dependencies
+type GetPageContentTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewGetPageContentTool creates a new get_page_content tool
+func NewGetPageContentTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &GetPageContentTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"get_page_content",
+		"Get the readable content of the current page as Markdown, after scripts have rendered it. Navigation, headers, footers, sidebars and scripts are dropped; links stay Markdown links and tables stay Markdown tables. Long pages are returned in parts: pass next_offset as offset to read on",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"frame": map[string]any{
+					"description": frameDescription,
+					"type":        "string",
+				},
+				"include_images": map[string]any{
+					"default":     false,
+					"description": "Render images as ![alt](src)",
+					"type":        "boolean",
+				},
+				"max_chars": map[string]any{
+					"default":     defaultContentChars,
+					"description": "Maximum number of characters to return",
+					"type":        "integer",
+				},
+				"offset": map[string]any{
+					"default":     0,
+					"description": "Character offset to start from, e.g. the next_offset of the previous call",
+					"type":        "integer",
+				},
+				"readability": map[string]any{
+					"default":     true,
+					"description": "Keep only the main content (the article or main region). Set to false to convert the whole page, e.g. for listings and search results",
+					"type":        "boolean",
+				},
+				"selector": map[string]any{
+					"description": "Convert only this element, e.g. 'article' or a ref from get_page_snapshot",
+					"type":        "string",
+				},
+				"timeout": map[string]any{
+					"default":     defaultTimeoutMs,
+					"description": "Maximum time to wait for the selector in milliseconds",
+					"type":        "integer",
+				},
+			},
+		},
+		tool.GetPageContentHandler,
+	)
+}
+
+// GetPageContentHandler handles the get_page_content tool execution
+func (s *GetPageContentTool) GetPageContentHandler(ctx context.Context, args map[string]any) (string, error) {
+	selector, err := stringArg(args, "selector", "")
+	if err != nil {
+		return "", err
+	}
+
+	frame, err := stringArg(args, "frame", "")
+	if err != nil {
+		return "", err
+	}
+
+	readability, err := boolArg(args, "readability", true)
+	if err != nil {
+		return "", err
+	}
+
+	includeImages, err := boolArg(args, "include_images", false)
+	if err != nil {
+		return "", err
+	}
+
+	offset, err := boundedIntArg(args, "offset", 0, 0, math.MaxInt32)
+	if err != nil {
+		return "", err
+	}
+
+	maxChars, err := boundedIntArg(args, "max_chars", defaultContentChars, minContentChars, maxContentChars)
+	if err != nil {
+		return "", err
+	}
+
+	timeout, err := boundedIntArg(args, "timeout", defaultTimeoutMs, minTimeoutMs, maxTimeoutMs)
+	if err != nil {
+		return "", err
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	content, err := s.playwright.GetPageContent(ctx, session.ID, selector, frame, time.Duration(timeout)*time.Millisecond)
+	if err != nil {
+		s.logger.Error("failed to read page content",
+			zap.String("sessionID", session.ID),
+			zap.String("selector", selector),
+			zap.Error(err))
+		return "", fmt.Errorf("failed to read page content: %w", err)
+	}
+
+	// A chosen element is converted as a whole; reader-mode selection only
+	// applies to full pages
+	doc, err := markdown.Convert(content.HTML, markdown.Options{
+		BaseURL:     content.URL,
+		Readability: readability && selector == "",
+		Images:      includeImages,
+		Fragment:    selector != "",
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to convert page content: %w", err)
+	}
+
+	title := content.Title
+	if title == "" {
+		title = doc.Title
+	}
+
+	totalChars := len([]rune(doc.Markdown))
+	if offset > totalChars {
+		return "", fmt.Errorf("offset %d is past the end of the content (%d characters)", offset, totalChars)
+	}
+	part, nextOffset := markdown.Page(doc.Markdown, offset, maxChars)
+
+	s.logger.Info("page content converted",
+		zap.String("sessionID", session.ID),
+		zap.String("url", content.URL),
+		zap.Int("totalChars", totalChars),
+		zap.Int("offset", offset),
+		zap.Int("nextOffset", nextOffset))
+
+	response := map[string]any{
+		"success":     true,
+		"url":         content.URL,
+		"title":       title,
+		"content":     part,
+		"offset":      offset,
+		"total_chars": totalChars,
+		"has_more":    nextOffset >= 0,
+		"session_id":  session.ID,
+		"message":     "End of page content",
+	}
+	if nextOffset >= 0 {
+		response["next_offset"] = nextOffset
+		response["message"] = fmt.Sprintf("Content continues; call get_page_content with offset %d for the next part", nextOffset)
+	}
+	return marshalResponse(response)
+}
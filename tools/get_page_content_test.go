@@ -0,0 +1,146 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+func TestGetPageContentTool_GetPageContentHandler(t *testing.T) {
+	paragraph := "<p>" + strings.Repeat("word ", 150) + "</p>"
+	article := &playwright.PageContent{
+		URL:   "https://example.com/post/",
+		Title: "Post",
+		HTML: `<html><body><nav><a href="/">Home</a></nav><main><h1>Post</h1>` +
+			`<p>See <a href="notes">the notes</a>.</p>` + paragraph + `</main></body></html>`,
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		setupMock     func(*mocks.FakeBrowserAutomation)
+		expectedError bool
+		errorContains string
+		verify        func(*testing.T, *mocks.FakeBrowserAutomation, map[string]any)
+	}{
+		{
+			name: "returns the first part of a long page",
+			args: map[string]any{"max_chars": 500},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.GetPageContentReturns(article, nil)
+			},
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, response map[string]any) {
+				content := response["content"].(string)
+				assert.True(t, strings.HasPrefix(content, "# Post\n\nSee [the notes](https://example.com/post/notes)."), content)
+				assert.NotContains(t, content, "Home")
+				assert.Equal(t, "Post", response["title"])
+				assert.Equal(t, true, response["has_more"])
+				assert.Equal(t, float64(len(content)), response["next_offset"])
+
+				_, sessionID, selector, frame, timeout := m.GetPageContentArgsForCall(0)
+				assert.Equal(t, "session-1", sessionID)
+				assert.Empty(t, selector)
+				assert.Empty(t, frame)
+				assert.Equal(t, 30*time.Second, timeout)
+
+				// next_offset reads on to the end of the page
+				tool := &GetPageContentTool{logger: zap.NewNop(), playwright: m}
+				result, err := tool.GetPageContentHandler(context.Background(), map[string]any{
+					"max_chars": 500,
+					"offset":    int(response["next_offset"].(float64)),
+				})
+				require.NoError(t, err)
+				var last map[string]any
+				require.NoError(t, json.Unmarshal([]byte(result), &last))
+				assert.Equal(t, false, last["has_more"])
+				assert.NotContains(t, last, "next_offset")
+				assert.Equal(t, response["total_chars"], float64(len(content))+float64(len(last["content"].(string))))
+			},
+		},
+		{
+			name: "selector and frame",
+			args: map[string]any{"selector": "#prices", "frame": "shop"},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.GetPageContentReturns(&playwright.PageContent{
+					URL:  "https://example.com/",
+					HTML: `<table><tr><th>Name</th><th>Price</th></tr><tr><td>Tea</td><td>3</td></tr></table>`,
+				}, nil)
+			},
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, response map[string]any) {
+				assert.Equal(t, "| Name | Price |\n| --- | --- |\n| Tea | 3 |", response["content"])
+				_, _, selector, frame, _ := m.GetPageContentArgsForCall(0)
+				assert.Equal(t, "#prices", selector)
+				assert.Equal(t, "shop", frame)
+			},
+		},
+		{
+			name:          "max_chars too small",
+			args:          map[string]any{"max_chars": 10},
+			expectedError: true,
+			errorContains: "max_chars",
+		},
+		{
+			name: "offset past the end",
+			args: map[string]any{"offset": 100},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.GetPageContentReturns(&playwright.PageContent{HTML: "<p>short</p>"}, nil)
+			},
+			expectedError: true,
+			errorContains: "past the end",
+		},
+		{
+			name: "page content fails",
+			args: map[string]any{},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.GetPageContentReturns(nil, errors.New("page crashed"))
+			},
+			expectedError: true,
+			errorContains: "page crashed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			if tt.setupMock != nil {
+				tt.setupMock(mockPlaywright)
+			}
+			tool := &GetPageContentTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			result, err := tool.GetPageContentHandler(context.Background(), tt.args)
+
+			if tt.expectedError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.Empty(t, result)
+				if tt.setupMock == nil {
+					assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			var response map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(result), &response), "response should be valid JSON")
+			assert.Equal(t, true, response["success"])
+			if tt.verify != nil {
+				tt.verify(t, mockPlaywright, response)
+			}
+		})
+	}
+}
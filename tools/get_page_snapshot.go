@@ -0,0 +1,114 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+const maxSnapshotDepth = 100
+
+// GetPageSnapshotTool struct holds the tool with dependencies
+type GetPageSnapshotTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewGetPageSnapshotTool creates a new get_page_snapshot tool
+func NewGetPageSnapshotTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &GetPageSnapshotTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"get_page_snapshot",
+		"Get the accessibility tree of the current page (role, name, value and state of each node). Interactive nodes carry a ref such as [ref=e12] that click_element and fill_form accept as selector ref=e12",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"interactive_only": map[string]any{
+					"default":     false,
+					"description": "Only list interactive nodes and headings, as a flat list",
+					"type":        "boolean",
+				},
+				"max_depth": map[string]any{
+					"description": "Maximum depth of the tree; omit for the full tree",
+					"type":        "integer",
+				},
+				"selector": map[string]any{
+					"description": "Limit the snapshot to the subtree of this element",
+					"type":        "string",
+				},
+				"timeout": map[string]any{
+					"default":     defaultTimeoutMs,
+					"description": "Maximum time to wait for the snapshot in milliseconds",
+					"type":        "integer",
+				},
+			},
+		},
+		tool.GetPageSnapshotHandler,
+	)
+}
+
+// GetPageSnapshotHandler handles the get_page_snapshot tool execution
+func (s *GetPageSnapshotTool) GetPageSnapshotHandler(ctx context.Context, args map[string]any) (string, error) {
+	selector, err := stringArg(args, "selector", "")
+	if err != nil {
+		return "", err
+	}
+
+	interactiveOnly, err := boolArg(args, "interactive_only", false)
+	if err != nil {
+		return "", err
+	}
+
+	maxDepth, err := boundedIntArg(args, "max_depth", 0, 0, maxSnapshotDepth)
+	if err != nil {
+		return "", err
+	}
+
+	timeout, err := boundedIntArg(args, "timeout", defaultTimeoutMs, minTimeoutMs, maxTimeoutMs)
+	if err != nil {
+		return "", err
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	snapshot, err := s.playwright.GetPageSnapshot(ctx, session.ID, playwright.SnapshotOptions{
+		Selector:        selector,
+		InteractiveOnly: interactiveOnly,
+		MaxDepth:        maxDepth,
+		Timeout:         time.Duration(timeout) * time.Millisecond,
+	})
+	if err != nil {
+		s.logger.Error("failed to capture page snapshot",
+			zap.String("sessionID", session.ID),
+			zap.Error(err))
+		return "", fmt.Errorf("failed to capture page snapshot: %w", err)
+	}
+
+	s.logger.Info("page snapshot captured",
+		zap.String("sessionID", session.ID),
+		zap.Int("refs", snapshot.RefCount),
+		zap.Int("bytes", len(snapshot.Tree)))
+
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"url":        snapshot.URL,
+		"title":      snapshot.Title,
+		"snapshot":   snapshot.Tree,
+		"ref_count":  snapshot.RefCount,
+		"session_id": session.ID,
+		"message":    "Use ref=<id> as the selector in click_element or fill_form to act on a node; refs go stale when the page changes",
+	})
+}
@@ -0,0 +1,103 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+
+	zap "go.uber.org/zap"
+
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+func TestGetPageSnapshotTool_GetPageSnapshotHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]any
+		setupMock     func(*mocks.FakeBrowserAutomation)
+		expectedError bool
+		errorContains string
+		verify        func(*testing.T, *mocks.FakeBrowserAutomation, map[string]any)
+	}{
+		{
+			name: "snapshot with all options",
+			args: map[string]any{
+				"interactive_only": true,
+				"max_depth":        5,
+				"selector":         "main",
+			},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.GetPageSnapshotReturns(&playwright.PageSnapshot{
+					URL:      "https://example.com",
+					Title:    "Example",
+					Tree:     `- button "Pay" [ref=e3]`,
+					RefCount: 1,
+				}, nil)
+			},
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, response map[string]any) {
+				assert.Equal(t, `- button "Pay" [ref=e3]`, response["snapshot"])
+				assert.Equal(t, float64(1), response["ref_count"])
+				_, sessionID, options := m.GetPageSnapshotArgsForCall(0)
+				assert.Equal(t, "session-1", sessionID)
+				assert.Equal(t, playwright.SnapshotOptions{
+					Selector:        "main",
+					InteractiveOnly: true,
+					MaxDepth:        5,
+					Timeout:         30 * time.Second,
+				}, options)
+			},
+		},
+		{
+			name:          "max_depth out of range",
+			args:          map[string]any{"max_depth": 1000},
+			expectedError: true,
+			errorContains: "max_depth",
+		},
+		{
+			name: "snapshot failure",
+			args: map[string]any{},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.GetPageSnapshotReturns(nil, errors.New("page crashed"))
+			},
+			expectedError: true,
+			errorContains: "page crashed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			if tt.setupMock != nil {
+				tt.setupMock(mockPlaywright)
+			}
+			tool := &GetPageSnapshotTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			result, err := tool.GetPageSnapshotHandler(context.Background(), tt.args)
+
+			if tt.expectedError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.Empty(t, result)
+				if tt.setupMock == nil {
+					assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			var response map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(result), &response), "response should be valid JSON")
+			assert.Equal(t, true, response["success"])
+			if tt.verify != nil {
+				tt.verify(t, mockPlaywright, response)
+			}
+		})
+	}
+}
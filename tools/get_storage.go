@@ -0,0 +1,116 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// defaultStorageType is the storage the storage tools use without a type
+const defaultStorageType = playwright.LocalStorage
+
+// storageTypes are the values of the type argument of the storage tools
+var storageTypes = playwright.StorageTypes
+
+// GetStorageTool struct holds the tool with dependencies
+type GetStorageTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewGetStorageTool creates a new get_storage tool
+func NewGetStorageTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &GetStorageTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"get_storage",
+		"Read the localStorage or sessionStorage entries of the active tab's origin, or the localStorage of another origin the session has visited",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"keys": map[string]any{
+					"description": "Only these keys. By default every entry",
+					"items":       map[string]any{"type": "string"},
+					"type":        "array",
+				},
+				"origin": map[string]any{
+					"description": "Origin to read, e.g. https://app.example.com. By default the active tab's origin; sessionStorage can only be read for that one",
+					"type":        "string",
+				},
+				"type": map[string]any{
+					"default":     defaultStorageType,
+					"description": "local for localStorage, session for sessionStorage",
+					"enum":        storageTypes,
+					"type":        "string",
+				},
+			},
+		},
+		tool.GetStorageHandler,
+	)
+}
+
+// GetStorageHandler handles the get_storage tool execution
+func (s *GetStorageTool) GetStorageHandler(ctx context.Context, args map[string]any) (string, error) {
+	storageType, origin, err := storageArgs(args)
+	if err != nil {
+		return "", err
+	}
+	keys, err := stringSliceArg(args, "keys")
+	if err != nil {
+		return "", err
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	storage, err := s.playwright.GetStorage(ctx, session.ID, playwright.StorageQuery{
+		Type:   storageType,
+		Origin: origin,
+		Keys:   keys,
+	})
+	if err != nil {
+		s.logger.Error("failed to get storage", zap.String("sessionID", session.ID), zap.Error(err))
+		return "", fmt.Errorf("get storage failed: %w", err)
+	}
+
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"origin":     storage.Origin,
+		"type":       storage.Type,
+		"items":      storage.Items,
+		"count":      len(storage.Items),
+		"session_id": session.ID,
+	})
+}
+
+// storageArgs reads the type and origin arguments shared by the storage
+// tools
+func storageArgs(args map[string]any) (string, string, error) {
+	storageType, err := stringArg(args, "type", defaultStorageType)
+	if err != nil {
+		return "", "", err
+	}
+	if !oneOf(storageType, storageTypes...) {
+		return "", "", fmt.Errorf("invalid type value: %s. Must be one of: %v", storageType, storageTypes)
+	}
+	origin, err := stringArg(args, "origin", "")
+	if err != nil {
+		return "", "", err
+	}
+	if origin != "" {
+		if origin, err = normalizeBrowserURL(origin); err != nil {
+			return "", "", fmt.Errorf("invalid origin: %w", err)
+		}
+	}
+	return storageType, origin, nil
+}
@@ -0,0 +1,96 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+
+	zap "go.uber.org/zap"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+)
+
+func TestGetStorageTool_GetStorageHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]any
+		setupMock     func(*mocks.FakeBrowserAutomation)
+		expectedError bool
+		errorContains string
+		verify        func(*testing.T, *mocks.FakeBrowserAutomation, map[string]any)
+	}{
+		{
+			name: "reads the given keys of an origin",
+			args: map[string]any{"keys": []any{"theme"}, "origin": "app.example.com"},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+				m.GetStorageReturns(&playwright.WebStorage{
+					Origin: "https://app.example.com",
+					Type:   playwright.LocalStorage,
+					Items:  map[string]string{"theme": "dark"},
+				}, nil)
+			},
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, response map[string]any) {
+				assert.Equal(t, map[string]any{"theme": "dark"}, response["items"])
+				assert.Equal(t, float64(1), response["count"])
+
+				_, sessionID, query := m.GetStorageArgsForCall(0)
+				assert.Equal(t, "task-1", sessionID)
+				assert.Equal(t, playwright.StorageQuery{
+					Type:   playwright.LocalStorage,
+					Origin: "https://app.example.com",
+					Keys:   []string{"theme"},
+				}, query)
+			},
+		},
+		{
+			name:          "invalid type",
+			args:          map[string]any{"type": "cookie"},
+			expectedError: true,
+			errorContains: "invalid type value: cookie",
+		},
+		{
+			name: "session storage of another origin",
+			args: map[string]any{"type": "session"},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+				m.GetStorageReturns(nil, errors.New("session storage of https://other.example can only be read while the active tab is on it"))
+			},
+			expectedError: true,
+			errorContains: "get storage failed: session storage of",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			if tt.setupMock != nil {
+				tt.setupMock(mockPlaywright)
+			}
+			tool := &GetStorageTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			result, err := tool.GetStorageHandler(context.Background(), tt.args)
+
+			if tt.expectedError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.Empty(t, result)
+				if tt.setupMock == nil {
+					assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			var response map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(result), &response), "response should be valid JSON")
+			assert.Equal(t, true, response["success"])
+			if tt.verify != nil {
+				tt.verify(t, mockPlaywright, response)
+			}
+		})
+	}
+}
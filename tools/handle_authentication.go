@@ -3,36 +3,72 @@ package tools
 import (
 	"context"
 	"fmt"
+	"time"
 
 	zap "go.uber.org/zap"
 
 	server "github.com/inference-gateway/adk/server"
 
 	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+	secrets "github.com/inference-gateway/browser-agent/internal/secrets"
 )
 
 var validAuthTypes = []string{"basic", "form", "oauth"}
 
+// authStringArgs are the optional string arguments of handle_authentication
+var authStringArgs = []string{
+	"credential_ref", "username", "password", "login_url",
+	"username_selector", "password_selector", "submit_selector",
+	"success_selector", "success_url_pattern",
+	"oauth_authorize_url", "oauth_client_id", "oauth_redirect_uri", "oauth_scope", "oauth_consent_selector",
+}
+
 // HandleAuthenticationTool struct holds the tool with dependencies
 type HandleAuthenticationTool struct {
 	logger     *zap.Logger
 	playwright playwright.BrowserAutomation
+	secrets    secrets.CredentialStore
 }
 
 // NewHandleAuthenticationTool creates a new handle_authentication tool
-func NewHandleAuthenticationTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+func NewHandleAuthenticationTool(logger *zap.Logger, playwright playwright.BrowserAutomation, secrets secrets.CredentialStore) server.Tool {
 	tool := &HandleAuthenticationTool{
 		logger:     logger,
 		playwright: playwright,
+		secrets:    secrets,
 	}
 	return server.NewBasicTool(
 		"handle_authentication",
-		"NOT YET IMPLEMENTED: This tool currently returns an error explaining that authentication is not wired through. For basic auth, configure HTTP credentials at the browser context level. For form login, compose navigate_to_url + fill_form + click_element. For OAuth, run the flow manually with the above primitives.",
+		"Sign in within the current browser session. basic: sets HTTP credentials on a fresh browser context (cookies are reset, nothing is navigated; login_url only scopes the credentials to its origin). form: opens login_url, fills the username/password selectors and submits. oauth: runs an authorization-code redirect flow, either built from oauth_authorize_url/oauth_client_id or started by the app at login_url, until the provider redirects to oauth_redirect_uri with a code. Pass success_selector and/or success_url_pattern to verify the login. Prefer credential_ref over username/password so the secret never appears in the conversation.",
 		map[string]any{
 			"type": "object",
 			"properties": map[string]any{
+				"credential_ref": map[string]any{
+					"description": "Reference to a stored credential, e.g. vault://github-bot. Supplies username and password server-side; explicit username/password arguments take precedence",
+					"type":        "string",
+				},
 				"login_url": map[string]any{
-					"description": "URL of the login page for form authentication",
+					"description": "URL of the login page (form), the page that starts the OAuth flow (oauth), or the origin the credentials apply to (basic)",
+					"type":        "string",
+				},
+				"oauth_authorize_url": map[string]any{
+					"description": "Authorization endpoint of the OAuth provider; the code request is built from it",
+					"type":        "string",
+				},
+				"oauth_client_id": map[string]any{
+					"description": "OAuth client ID, required with oauth_authorize_url",
+					"type":        "string",
+				},
+				"oauth_consent_selector": map[string]any{
+					"description": "Selector for the provider's consent/allow button, clicked if the provider asks",
+					"type":        "string",
+				},
+				"oauth_redirect_uri": map[string]any{
+					"description": "Redirect URI registered with the provider; the flow completes when the browser is sent there with a code",
+					"type":        "string",
+				},
+				"oauth_scope": map[string]any{
+					"description": "Space separated OAuth scopes to request",
 					"type":        "string",
 				},
 				"password": map[string]any{
@@ -44,9 +80,22 @@ func NewHandleAuthenticationTool(logger *zap.Logger, playwright playwright.Brows
 					"type":        "string",
 				},
 				"submit_selector": map[string]any{
-					"description": "Selector for submit button in form authentication",
+					"description": "Selector for submit button; if omitted, Enter is pressed in the last filled field",
 					"type":        "string",
 				},
+				"success_selector": map[string]any{
+					"description": "Selector that is visible only after a successful login",
+					"type":        "string",
+				},
+				"success_url_pattern": map[string]any{
+					"description": "URL glob (e.g. **/dashboard) or /regex/ the page must reach after a successful login",
+					"type":        "string",
+				},
+				"timeout": map[string]any{
+					"default":     30000,
+					"description": "Maximum time to wait for each step of the login in milliseconds",
+					"type":        "integer",
+				},
 				"type": map[string]any{
 					"description": "Authentication type (basic, form, oauth)",
 					"type":        "string",
@@ -66,16 +115,8 @@ func NewHandleAuthenticationTool(logger *zap.Logger, playwright playwright.Brows
 	)
 }
 
-// HandleAuthenticationHandler returns an explicit "not implemented" error
-// instead of the previous silent no-op TODO that returned a fake-success
-// payload. We still validate args["type"] so the caller gets a clearer
-// signal when they passed something garbage in addition to hitting an
-// unimplemented tool.
-//
-// The plumbing in internal/playwright.HandleAuthentication exists but the
-// wiring between this tool's args (login_url, *_selector, ...) and the
-// service's expected layout (selectors map[string]string) was never
-// completed. Until it is, fail loudly.
+// HandleAuthenticationHandler handles the handle_authentication tool execution.
+// Credentials are never logged or echoed back in the response.
 func (s *HandleAuthenticationTool) HandleAuthenticationHandler(ctx context.Context, args map[string]any) (string, error) {
 	authType, err := requiredString(args, "type")
 	if err != nil {
@@ -85,11 +126,120 @@ func (s *HandleAuthenticationTool) HandleAuthenticationHandler(ctx context.Conte
 		return "", fmt.Errorf("invalid auth type: %s. Must be one of: %v", authType, validAuthTypes)
 	}
 
-	s.logger.Warn("handle_authentication invoked but not implemented",
-		zap.String("auth_type", authType))
+	values := make(map[string]string, len(authStringArgs))
+	for _, key := range authStringArgs {
+		value, err := stringArg(args, key, "")
+		if err != nil {
+			return "", err
+		}
+		values[key] = value
+	}
 
-	return "", fmt.Errorf("handle_authentication is not yet implemented (auth_type=%s). "+
-		"For basic auth, configure HTTP credentials at the browser context level. "+
-		"For form login, compose navigate_to_url + fill_form + click_element instead. "+
-		"For OAuth, drive the flow manually with the same primitives", authType)
+	timeout, err := boundedIntArg(args, "timeout", defaultTimeoutMs, minTimeoutMs, maxTimeoutMs)
+	if err != nil {
+		return "", err
+	}
+
+	credentialRef := values["credential_ref"]
+	if credentialRef != "" {
+		if !secrets.Configured(s.secrets) {
+			return "", fmt.Errorf("credential_ref requires a credential vault; set BROWSER_CREDENTIALS_PATH")
+		}
+		credential, err := s.secrets.Resolve(credentialRef)
+		if err != nil {
+			return "", err
+		}
+		if values["username"] == "" {
+			values["username"] = credential.Username()
+		}
+		if values["password"] == "" {
+			values["password"] = credential.Password()
+		}
+	}
+
+	options := playwright.AuthenticationOptions{
+		Type:              authType,
+		Username:          values["username"],
+		Password:          values["password"],
+		LoginURL:          values["login_url"],
+		UsernameSelector:  values["username_selector"],
+		PasswordSelector:  values["password_selector"],
+		SubmitSelector:    values["submit_selector"],
+		SuccessSelector:   values["success_selector"],
+		SuccessURLPattern: values["success_url_pattern"],
+		Timeout:           time.Duration(timeout) * time.Millisecond,
+		OAuth: playwright.OAuthOptions{
+			AuthorizeURL:    values["oauth_authorize_url"],
+			ClientID:        values["oauth_client_id"],
+			RedirectURI:     values["oauth_redirect_uri"],
+			Scope:           values["oauth_scope"],
+			ConsentSelector: values["oauth_consent_selector"],
+		},
+	}
+
+	if err := validateAuthenticationRequirements(options); err != nil {
+		return "", err
+	}
+
+	s.logger.Info("handling authentication",
+		zap.String("auth_type", authType),
+		zap.String("login_url", options.LoginURL),
+		zap.String("credential_ref", credentialRef),
+		zap.Int("timeout_ms", timeout))
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	result, err := s.playwright.HandleAuthentication(ctx, session.ID, options)
+	if err != nil {
+		s.logger.Error("authentication failed",
+			zap.String("auth_type", authType),
+			zap.String("sessionID", session.ID),
+			zap.Error(err))
+		return "", fmt.Errorf("authentication failed: %w", err)
+	}
+
+	s.logger.Info("authentication completed",
+		zap.String("auth_type", authType),
+		zap.String("sessionID", session.ID),
+		zap.Bool("verified", result.Verified))
+
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"auth_type":  result.Type,
+		"verified":   result.Verified,
+		"final_url":  result.FinalURL,
+		"session_id": session.ID,
+		"message":    result.Message,
+	})
+}
+
+// validateAuthenticationRequirements validates type-specific requirements.
+// Standalone function (not a method) so it can be called from tests without
+// constructing a tool.
+func validateAuthenticationRequirements(options playwright.AuthenticationOptions) error {
+	switch options.Type {
+	case "basic":
+		if options.Username == "" {
+			return fmt.Errorf("username parameter is required for basic authentication")
+		}
+	case "form":
+		if options.UsernameSelector == "" && options.PasswordSelector == "" {
+			return fmt.Errorf("username_selector or password_selector parameter is required for form authentication")
+		}
+	case "oauth":
+		if options.OAuth.RedirectURI == "" {
+			return fmt.Errorf("oauth_redirect_uri parameter is required for oauth authentication")
+		}
+		if options.OAuth.AuthorizeURL == "" && options.LoginURL == "" {
+			return fmt.Errorf("oauth_authorize_url or login_url parameter is required for oauth authentication")
+		}
+		if options.OAuth.AuthorizeURL != "" && options.OAuth.ClientID == "" {
+			return fmt.Errorf("oauth_client_id parameter is required with oauth_authorize_url")
+		}
+	}
+	return nil
 }
@@ -2,16 +2,23 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"testing"
+	"time"
 
 	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
 
 	zap "go.uber.org/zap"
 
 	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+	secrets "github.com/inference-gateway/browser-agent/internal/secrets"
 )
 
-func TestHandleAuthenticationTool_HandleAuthenticationHandler(t *testing.T) {
+func TestHandleAuthenticationTool_HandleAuthenticationHandler_Validation(t *testing.T) {
 	logger := zap.NewNop()
 	mockPlaywright := &mocks.FakeBrowserAutomation{}
 	tool := &HandleAuthenticationTool{logger: logger, playwright: mockPlaywright}
@@ -32,19 +39,33 @@ func TestHandleAuthenticationTool_HandleAuthenticationHandler(t *testing.T) {
 			errorContains: "invalid auth type",
 		},
 		{
-			name:          "valid type still returns not-implemented",
+			name:          "basic without username",
+			args:          map[string]any{"type": "basic", "password": "p"},
+			errorContains: "username parameter is required",
+		},
+		{
+			name:          "form without selectors",
 			args:          map[string]any{"type": "form", "username": "u", "password": "p"},
-			errorContains: "not yet implemented",
+			errorContains: "username_selector or password_selector",
+		},
+		{
+			name:          "oauth without redirect uri",
+			args:          map[string]any{"type": "oauth", "login_url": "https://app.example.com/login"},
+			errorContains: "oauth_redirect_uri parameter is required",
 		},
 		{
-			name:          "basic returns not-implemented",
-			args:          map[string]any{"type": "basic"},
-			errorContains: "not yet implemented",
+			name:          "oauth without start url",
+			args:          map[string]any{"type": "oauth", "oauth_redirect_uri": "https://app.example.com/callback"},
+			errorContains: "oauth_authorize_url or login_url",
 		},
 		{
-			name:          "oauth returns not-implemented",
-			args:          map[string]any{"type": "oauth"},
-			errorContains: "not yet implemented",
+			name: "oauth authorize url without client id",
+			args: map[string]any{
+				"type":                "oauth",
+				"oauth_authorize_url": "https://idp.example.com/authorize",
+				"oauth_redirect_uri":  "https://app.example.com/callback",
+			},
+			errorContains: "oauth_client_id parameter is required",
 		},
 	}
 
@@ -57,10 +78,127 @@ func TestHandleAuthenticationTool_HandleAuthenticationHandler(t *testing.T) {
 		})
 	}
 
-	// Defense-in-depth: even when called, the tool MUST NOT touch the
-	// playwright service. Previously the tool was a no-op that bypassed
-	// the service entirely; the new explicit error preserves that
-	// no-side-effects property.
 	assert.Equal(t, 0, mockPlaywright.HandleAuthenticationCallCount(),
-		"handle_authentication must not call the playwright service while unimplemented")
+		"invalid arguments must be rejected before touching the browser")
+}
+
+func TestHandleAuthenticationTool_HandleAuthenticationHandler_Form(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	tool := &HandleAuthenticationTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "test-session"}, nil)
+	mockPlaywright.HandleAuthenticationReturns(&playwright.AuthenticationResult{
+		Type:     "form",
+		Verified: true,
+		FinalURL: "https://app.example.com/dashboard",
+		Message:  "login verified",
+	}, nil)
+
+	result, err := tool.HandleAuthenticationHandler(context.Background(), map[string]any{
+		"type":                "form",
+		"username":            "alice",
+		"password":            "s3cret",
+		"login_url":           "https://app.example.com/login",
+		"username_selector":   "#user",
+		"password_selector":   "#pass",
+		"submit_selector":     "button[type=submit]",
+		"success_url_pattern": "**/dashboard",
+		"timeout":             5000,
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, result, "s3cret", "the password must never be echoed back")
+
+	var parsed map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result), &parsed))
+	assert.Equal(t, true, parsed["success"])
+	assert.Equal(t, true, parsed["verified"])
+	assert.Equal(t, "https://app.example.com/dashboard", parsed["final_url"])
+
+	require.Equal(t, 1, mockPlaywright.HandleAuthenticationCallCount())
+	_, sessionID, options := mockPlaywright.HandleAuthenticationArgsForCall(0)
+	assert.Equal(t, "test-session", sessionID)
+	assert.Equal(t, "#user", options.UsernameSelector)
+	assert.Equal(t, "#pass", options.PasswordSelector)
+	assert.Equal(t, "**/dashboard", options.SuccessURLPattern)
+	assert.Equal(t, 5*time.Second, options.Timeout)
+}
+
+func TestHandleAuthenticationTool_HandleAuthenticationHandler_OAuth(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	tool := &HandleAuthenticationTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "test-session"}, nil)
+	mockPlaywright.HandleAuthenticationReturns(&playwright.AuthenticationResult{Type: "oauth", Verified: true}, nil)
+
+	_, err := tool.HandleAuthenticationHandler(context.Background(), map[string]any{
+		"type":                   "oauth",
+		"oauth_authorize_url":    "https://idp.example.com/authorize",
+		"oauth_client_id":        "browser-agent",
+		"oauth_redirect_uri":     "https://app.example.com/callback",
+		"oauth_scope":            "openid profile",
+		"oauth_consent_selector": "#allow",
+	})
+	require.NoError(t, err)
+
+	_, _, options := mockPlaywright.HandleAuthenticationArgsForCall(0)
+	assert.Equal(t, playwright.OAuthOptions{
+		AuthorizeURL:    "https://idp.example.com/authorize",
+		ClientID:        "browser-agent",
+		RedirectURI:     "https://app.example.com/callback",
+		Scope:           "openid profile",
+		ConsentSelector: "#allow",
+	}, options.OAuth)
+	assert.Equal(t, 30*time.Second, options.Timeout)
+}
+
+func TestHandleAuthenticationTool_HandleAuthenticationHandler_ServiceError(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	tool := &HandleAuthenticationTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "test-session"}, nil)
+	mockPlaywright.HandleAuthenticationReturns(nil, errors.New("success selector #welcome did not appear"))
+
+	result, err := tool.HandleAuthenticationHandler(context.Background(), map[string]any{
+		"type":     "basic",
+		"username": "alice",
+		"password": "s3cret",
+	})
+	assert.Empty(t, result)
+	assert.ErrorContains(t, err, "authentication failed")
+}
+
+func TestHandleAuthenticationTool_HandleAuthenticationHandler_CredentialRef(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "test-session"}, nil)
+	mockPlaywright.HandleAuthenticationReturns(&playwright.AuthenticationResult{Type: "basic"}, nil)
+
+	store := secrets.NewVault(map[string]secrets.Credential{
+		"github-bot": {"username": "octo-bot", "password": "hunter2-secret"},
+	})
+	tool := &HandleAuthenticationTool{logger: zap.NewNop(), playwright: mockPlaywright, secrets: store}
+
+	_, err := tool.HandleAuthenticationHandler(context.Background(), map[string]any{
+		"type":           "basic",
+		"credential_ref": "vault://github-bot",
+	})
+	require.NoError(t, err)
+
+	_, _, options := mockPlaywright.HandleAuthenticationArgsForCall(0)
+	assert.Equal(t, "octo-bot", options.Username)
+	assert.Equal(t, "hunter2-secret", options.Password)
+
+	_, err = tool.HandleAuthenticationHandler(context.Background(), map[string]any{
+		"type":           "basic",
+		"credential_ref": "vault://unknown",
+	})
+	assert.ErrorContains(t, err, "not found")
+
+	for _, store := range []secrets.CredentialStore{nil, secrets.NewVault(nil)} {
+		noVault := &HandleAuthenticationTool{logger: zap.NewNop(), playwright: mockPlaywright, secrets: store}
+		_, err = noVault.HandleAuthenticationHandler(context.Background(), map[string]any{
+			"type":           "basic",
+			"credential_ref": "vault://github-bot",
+		})
+		assert.ErrorContains(t, err, "requires a credential vault", "an empty vault points to BROWSER_CREDENTIALS_PATH")
+	}
 }
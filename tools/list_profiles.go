@@ -0,0 +1,50 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// ListProfilesTool struct holds the tool with dependencies
+type ListProfilesTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewListProfilesTool creates a new list_profiles tool
+func NewListProfilesTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &ListProfilesTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"list_profiles",
+		"List the browser profiles saved with save_session_state, with when each was saved",
+		map[string]any{
+			"type":       "object",
+			"properties": map[string]any{},
+		},
+		tool.ListProfilesHandler,
+	)
+}
+
+// ListProfilesHandler handles the list_profiles tool execution
+func (s *ListProfilesTool) ListProfilesHandler(ctx context.Context, args map[string]any) (string, error) {
+	profiles, err := s.playwright.ListProfiles(ctx)
+	if err != nil {
+		s.logger.Error("failed to list profiles", zap.Error(err))
+		return "", fmt.Errorf("failed to list profiles: %w", err)
+	}
+
+	return marshalResponse(map[string]any{
+		"success":  true,
+		"profiles": profiles,
+		"count":    len(profiles),
+	})
+}
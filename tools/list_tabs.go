@@ -0,0 +1,65 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// ListTabsTool struct holds the tool with dependencies
+type ListTabsTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewListTabsTool creates a new list_tabs tool
+func NewListTabsTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &ListTabsTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"list_tabs",
+		"List the open tabs and popups of the browser session, marking the active tab that other browser tools act on",
+		map[string]any{
+			"type":       "object",
+			"properties": map[string]any{},
+		},
+		tool.ListTabsHandler,
+	)
+}
+
+// ListTabsHandler handles the list_tabs tool execution
+func (s *ListTabsTool) ListTabsHandler(ctx context.Context, args map[string]any) (string, error) {
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	tabs, err := s.playwright.ListTabs(ctx, session.ID)
+	if err != nil {
+		s.logger.Error("failed to list tabs", zap.String("sessionID", session.ID), zap.Error(err))
+		return "", fmt.Errorf("failed to list tabs: %w", err)
+	}
+
+	activeTabID := ""
+	for _, tab := range tabs {
+		if tab.Active {
+			activeTabID = tab.ID
+		}
+	}
+
+	return marshalResponse(map[string]any{
+		"success":       true,
+		"tabs":          tabs,
+		"count":         len(tabs),
+		"active_tab_id": activeTabID,
+		"session_id":    session.ID,
+	})
+}
@@ -0,0 +1,265 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// Mouse actions
+const (
+	mouseHover          = "hover"
+	mouseScrollIntoView = "scroll_into_view"
+	mouseScroll         = "scroll"
+	mouseScrollToBottom = "scroll_to_bottom"
+	mouseDragAndDrop    = "drag_and_drop"
+	mouseClickAt        = "click_at"
+
+	maxScrollsLimit = 50
+)
+
+// mouseActions are the values of the action argument
+var mouseActions = []string{mouseHover, mouseScrollIntoView, mouseScroll, mouseScrollToBottom, mouseDragAndDrop, mouseClickAt}
+
+// defaultMaxScrolls is the default of max_scrolls
+var defaultMaxScrolls = playwright.DefaultMaxScrolls
+
+// MouseActionTool struct holds the tool with dependencies
+type MouseActionTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewMouseActionTool creates a new mouse_action tool
+func NewMouseActionTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &MouseActionTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"mouse_action",
+		"Pointer actions besides clicking an element: hover to open menus and tooltips, scroll_into_view, scroll by a delta, scroll_to_bottom to load an infinite feed, drag_and_drop between two elements, and click_at viewport coordinates taken from a screenshot",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"action": map[string]any{
+					"description": "Action to perform",
+					"enum":        mouseActions,
+					"type":        "string",
+				},
+				"button": map[string]any{
+					"default":     "left",
+					"description": "For click_at: mouse button to use (left, right, middle)",
+					"type":        "string",
+				},
+				"click_count": map[string]any{
+					"default":     defaultClickCount,
+					"description": "For click_at: number of clicks, 2 for a double click",
+					"type":        "integer",
+				},
+				"delta_x": map[string]any{
+					"default":     0,
+					"description": "For scroll: pixels to scroll right, negative for left",
+					"type":        "number",
+				},
+				"delta_y": map[string]any{
+					"default":     0,
+					"description": "For scroll: pixels to scroll down, negative for up",
+					"type":        "number",
+				},
+				"frame": map[string]any{
+					"description": frameDescription,
+					"type":        "string",
+				},
+				"max_scrolls": map[string]any{
+					"default":     defaultMaxScrolls,
+					"description": "For scroll_to_bottom: most times to scroll down while the feed keeps loading more",
+					"type":        "integer",
+				},
+				"selector": map[string]any{
+					"description": "Element to hover, scroll into view or drag, or a ref from get_page_snapshot such as ref=e12. For scroll and scroll_to_bottom, the scrollable container; the page when omitted",
+					"type":        "string",
+				},
+				"target_selector": map[string]any{
+					"description": "For drag_and_drop: element to drop onto",
+					"type":        "string",
+				},
+				"timeout": map[string]any{
+					"default":     defaultTimeoutMs,
+					"description": "Maximum time to wait for the elements in milliseconds",
+					"type":        "integer",
+				},
+				"x": map[string]any{
+					"description": "For click_at: CSS pixels from the left edge of the viewport; the same as the screenshot pixels of a viewport screenshot",
+					"type":        "number",
+				},
+				"y": map[string]any{
+					"description": "For click_at: CSS pixels from the top edge of the viewport",
+					"type":        "number",
+				},
+			},
+			"required": []string{"action"},
+		},
+		tool.MouseActionHandler,
+	)
+}
+
+// MouseActionHandler handles the mouse_action tool execution
+func (s *MouseActionTool) MouseActionHandler(ctx context.Context, args map[string]any) (string, error) {
+	action, err := requiredString(args, "action")
+	if err != nil {
+		return "", err
+	}
+	if !oneOf(action, mouseActions...) {
+		return "", fmt.Errorf("invalid action %q: use %s", action, strings.Join(mouseActions, ", "))
+	}
+
+	selector, err := stringArg(args, "selector", "")
+	if err != nil {
+		return "", err
+	}
+	if selector == "" && oneOf(action, mouseHover, mouseScrollIntoView, mouseDragAndDrop) {
+		return "", fmt.Errorf("selector is required for %s", action)
+	}
+
+	frame, err := stringArg(args, "frame", "")
+	if err != nil {
+		return "", err
+	}
+
+	timeout, err := boundedIntArg(args, "timeout", defaultTimeoutMs, minTimeoutMs, maxTimeoutMs)
+	if err != nil {
+		return "", err
+	}
+	timeoutDuration := time.Duration(timeout) * time.Millisecond
+
+	// Arguments of the other actions are checked before the session is
+	// needed
+	var (
+		target     string
+		deltaX     float64
+		deltaY     float64
+		maxScrolls int
+		x, y       float64
+		clickAt    playwright.ClickAtOptions
+	)
+	switch action {
+	case mouseDragAndDrop:
+		if target, err = requiredString(args, "target_selector"); err != nil {
+			return "", err
+		}
+	case mouseScroll:
+		if deltaX, err = floatArg(args, "delta_x", 0); err != nil {
+			return "", err
+		}
+		if deltaY, err = floatArg(args, "delta_y", 0); err != nil {
+			return "", err
+		}
+		if deltaX == 0 && deltaY == 0 {
+			return "", fmt.Errorf("scroll needs a non-zero delta_x or delta_y")
+		}
+	case mouseScrollToBottom:
+		if maxScrolls, err = boundedIntArg(args, "max_scrolls", defaultMaxScrolls, 1, maxScrollsLimit); err != nil {
+			return "", err
+		}
+	case mouseClickAt:
+		if args["x"] == nil || args["y"] == nil {
+			return "", fmt.Errorf("x and y are required for click_at")
+		}
+		if x, err = floatArg(args, "x", 0); err != nil {
+			return "", err
+		}
+		if y, err = floatArg(args, "y", 0); err != nil {
+			return "", err
+		}
+		if x < 0 || y < 0 {
+			return "", fmt.Errorf("x and y must not be negative, got (%g, %g)", x, y)
+		}
+		if clickAt.Button, err = stringArg(args, "button", "left"); err != nil {
+			return "", err
+		}
+		if !oneOf(clickAt.Button, validButtons...) {
+			return "", fmt.Errorf("invalid button value: %s. Must be one of: %v", clickAt.Button, validButtons)
+		}
+		if clickAt.ClickCount, err = boundedIntArg(args, "click_count", defaultClickCount, minClickCount, maxClickCount); err != nil {
+			return "", err
+		}
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	response := map[string]any{
+		"success":    true,
+		"action":     action,
+		"session_id": session.ID,
+	}
+	if selector != "" {
+		response["selector"] = selector
+	}
+
+	options := playwright.MouseOptions{Frame: frame, Timeout: timeoutDuration}
+	switch action {
+	case mouseHover:
+		err = s.playwright.Hover(ctx, session.ID, selector, options)
+		response["message"] = fmt.Sprintf("Hovering over %s", selector)
+	case mouseScrollIntoView:
+		err = s.playwright.ScrollIntoView(ctx, session.ID, selector, options)
+		response["message"] = fmt.Sprintf("Scrolled %s into view", selector)
+	case mouseDragAndDrop:
+		err = s.playwright.DragAndDrop(ctx, session.ID, selector, target, options)
+		response["target_selector"] = target
+		response["message"] = fmt.Sprintf("Dragged %s onto %s", selector, target)
+	case mouseClickAt:
+		err = s.playwright.ClickAt(ctx, session.ID, x, y, clickAt)
+		response["x"], response["y"] = x, y
+		response["message"] = fmt.Sprintf("Clicked at (%g, %g)", x, y)
+	case mouseScroll, mouseScrollToBottom:
+		var position *playwright.ScrollPosition
+		position, err = s.playwright.Scroll(ctx, session.ID, playwright.ScrollOptions{
+			Selector:   selector,
+			Frame:      frame,
+			DeltaX:     deltaX,
+			DeltaY:     deltaY,
+			ToBottom:   action == mouseScrollToBottom,
+			MaxScrolls: maxScrolls,
+			Timeout:    timeoutDuration,
+		})
+		if err == nil {
+			response["position"] = position
+			response["message"] = scrollMessage(position, action == mouseScrollToBottom)
+		}
+	}
+	if err != nil {
+		s.logger.Error("mouse action failed",
+			zap.String("sessionID", session.ID),
+			zap.String("action", action),
+			zap.String("selector", selector),
+			zap.Error(err))
+		return "", fmt.Errorf("%s failed: %w", action, err)
+	}
+
+	return marshalResponse(response)
+}
+
+// scrollMessage summarizes where a scroll ended
+func scrollMessage(position *playwright.ScrollPosition, toBottom bool) string {
+	message := fmt.Sprintf("Scrolled to y=%g of %g", position.Y, position.Height)
+	if toBottom {
+		message = fmt.Sprintf("Scrolled to the bottom; more content loaded %d times", position.Loads)
+	}
+	if position.AtBottom {
+		message += "; at the bottom"
+	}
+	return message
+}
@@ -0,0 +1,199 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+
+	zap "go.uber.org/zap"
+
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+func TestMouseActionTool_MouseActionHandler(t *testing.T) {
+	setupSession := func(m *mocks.FakeBrowserAutomation) {
+		m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+		m.ScrollReturns(&playwright.ScrollPosition{Y: 4200, Height: 5000, ViewportHeight: 800, AtBottom: true, Loads: 3}, nil)
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		setupMock     func(*mocks.FakeBrowserAutomation)
+		expectedError bool
+		errorContains string
+		verify        func(*testing.T, *mocks.FakeBrowserAutomation, map[string]any)
+	}{
+		{
+			name:      "hover",
+			args:      map[string]any{"action": "hover", "selector": "#menu", "timeout": 5000},
+			setupMock: setupSession,
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, _ map[string]any) {
+				_, sessionID, selector, options := m.HoverArgsForCall(0)
+				assert.Equal(t, "session-1", sessionID)
+				assert.Equal(t, "#menu", selector)
+				assert.Equal(t, playwright.MouseOptions{Timeout: 5 * time.Second}, options)
+			},
+		},
+		{
+			name:      "scroll into view",
+			args:      map[string]any{"action": "scroll_into_view", "selector": "ref=e7"},
+			setupMock: setupSession,
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, _ map[string]any) {
+				assert.Equal(t, 1, m.ScrollIntoViewCallCount())
+			},
+		},
+		{
+			name: "drag and drop in a frame",
+			args: map[string]any{
+				"action": "drag_and_drop", "selector": "#card-1", "target_selector": "#done", "frame": "board",
+			},
+			setupMock: setupSession,
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, _ map[string]any) {
+				_, _, source, target, options := m.DragAndDropArgsForCall(0)
+				assert.Equal(t, "#card-1", source)
+				assert.Equal(t, "#done", target)
+				assert.Equal(t, "board", options.Frame)
+			},
+		},
+		{
+			name:      "double click at coordinates",
+			args:      map[string]any{"action": "click_at", "x": 120.5, "y": 48, "click_count": 2},
+			setupMock: setupSession,
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, _ map[string]any) {
+				_, _, x, y, options := m.ClickAtArgsForCall(0)
+				assert.Equal(t, 120.5, x)
+				assert.Equal(t, float64(48), y)
+				assert.Equal(t, playwright.ClickAtOptions{Button: "left", ClickCount: 2}, options)
+			},
+		},
+		{
+			name:      "scroll by a delta",
+			args:      map[string]any{"action": "scroll", "delta_y": -300},
+			setupMock: setupSession,
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, _ map[string]any) {
+				_, _, scroll := m.ScrollArgsForCall(0)
+				assert.Equal(t, float64(-300), scroll.DeltaY)
+				assert.False(t, scroll.ToBottom)
+			},
+		},
+		{
+			name:      "scroll to the bottom of a feed",
+			args:      map[string]any{"action": "scroll_to_bottom", "selector": "#feed"},
+			setupMock: setupSession,
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, response map[string]any) {
+				_, _, scroll := m.ScrollArgsForCall(0)
+				assert.True(t, scroll.ToBottom)
+				assert.Equal(t, "#feed", scroll.Selector)
+				assert.Equal(t, playwright.DefaultMaxScrolls, scroll.MaxScrolls)
+				assert.Equal(t, "Scrolled to the bottom; more content loaded 3 times; at the bottom", response["message"])
+				assert.Equal(t, true, response["position"].(map[string]any)["at_bottom"])
+			},
+		},
+		{
+			name:          "missing action",
+			args:          map[string]any{},
+			expectedError: true,
+			errorContains: "action parameter is required",
+		},
+		{
+			name:          "invalid action",
+			args:          map[string]any{"action": "swipe"},
+			expectedError: true,
+			errorContains: "invalid action",
+		},
+		{
+			name:          "hover without a selector",
+			args:          map[string]any{"action": "hover"},
+			expectedError: true,
+			errorContains: "selector is required for hover",
+		},
+		{
+			name:          "drag and drop without a target",
+			args:          map[string]any{"action": "drag_and_drop", "selector": "#a"},
+			expectedError: true,
+			errorContains: "target_selector",
+		},
+		{
+			name:          "scroll without a delta",
+			args:          map[string]any{"action": "scroll"},
+			expectedError: true,
+			errorContains: "non-zero delta_x or delta_y",
+		},
+		{
+			name:          "non-numeric delta",
+			args:          map[string]any{"action": "scroll", "delta_y": "down"},
+			expectedError: true,
+			errorContains: "delta_y must be a number",
+		},
+		{
+			name:          "max_scrolls out of range",
+			args:          map[string]any{"action": "scroll_to_bottom", "max_scrolls": 500},
+			expectedError: true,
+			errorContains: "max_scrolls",
+		},
+		{
+			name:          "click_at without y",
+			args:          map[string]any{"action": "click_at", "x": 10},
+			expectedError: true,
+			errorContains: "x and y are required",
+		},
+		{
+			name:          "negative coordinates",
+			args:          map[string]any{"action": "click_at", "x": -1, "y": 10},
+			expectedError: true,
+			errorContains: "must not be negative",
+		},
+		{
+			name:          "invalid button",
+			args:          map[string]any{"action": "click_at", "x": 1, "y": 1, "button": "back"},
+			expectedError: true,
+			errorContains: "invalid button",
+		},
+		{
+			name: "hover failure",
+			args: map[string]any{"action": "hover", "selector": "#menu"},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.HoverReturns(errors.New("element is not visible"))
+			},
+			expectedError: true,
+			errorContains: "hover failed: element is not visible",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			if tt.setupMock != nil {
+				tt.setupMock(mockPlaywright)
+			}
+			tool := &MouseActionTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			result, err := tool.MouseActionHandler(context.Background(), tt.args)
+
+			if tt.expectedError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.Empty(t, result)
+				if tt.setupMock == nil {
+					assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			var response map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(result), &response), "response should be valid JSON")
+			assert.Equal(t, true, response["success"])
+			if tt.verify != nil {
+				tt.verify(t, mockPlaywright, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// historyActions are the values of the action argument
+var historyActions = playwright.HistoryActions
+
+// NavigateHistoryTool struct holds the tool with dependencies
+type NavigateHistoryTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewNavigateHistoryTool creates a new navigate_history tool
+func NewNavigateHistoryTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &NavigateHistoryTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"navigate_history",
+		"Go back or forward in the active tab's history, or reload the page, and wait for it to load. Returns the resulting URL, title and HTTP status",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"action": map[string]any{
+					"description": "back, forward or reload",
+					"enum":        historyActions,
+					"type":        "string",
+				},
+				"timeout": map[string]any{
+					"default":     defaultTimeoutMs,
+					"description": "Maximum navigation timeout in milliseconds",
+					"type":        "integer",
+				},
+				"wait_until": map[string]any{
+					"default":     "load",
+					"description": "When to consider navigation succeeded (domcontentloaded, load, networkidle)",
+					"type":        "string",
+				},
+			},
+			"required": []string{"action"},
+		},
+		tool.NavigateHistoryHandler,
+	)
+}
+
+// NavigateHistoryHandler handles the navigate_history tool execution
+func (s *NavigateHistoryTool) NavigateHistoryHandler(ctx context.Context, args map[string]any) (string, error) {
+	action, err := requiredString(args, "action")
+	if err != nil {
+		return "", err
+	}
+	if !oneOf(action, historyActions...) {
+		return "", fmt.Errorf("invalid action %q: use %s", action, strings.Join(historyActions, ", "))
+	}
+
+	waitUntil, err := stringArg(args, "wait_until", "load")
+	if err != nil {
+		return "", err
+	}
+	if !oneOf(waitUntil, validWaitConditions...) {
+		return "", fmt.Errorf("invalid wait_until value: %s. Must be one of: %v", waitUntil, validWaitConditions)
+	}
+
+	timeout, err := boundedIntArg(args, "timeout", defaultTimeoutMs, minTimeoutMs, maxTimeoutMs)
+	if err != nil {
+		return "", err
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	result, err := s.playwright.NavigateHistory(ctx, session.ID, action, waitUntil, time.Duration(timeout)*time.Millisecond)
+	if err != nil {
+		s.logger.Error("history navigation failed",
+			zap.String("action", action),
+			zap.String("sessionID", session.ID),
+			zap.Error(err))
+		return "", fmt.Errorf("%s failed: %w", action, err)
+	}
+
+	s.logger.Info("history navigation completed",
+		zap.String("action", action),
+		zap.String("url", result.URL),
+		zap.String("sessionID", session.ID))
+
+	message := fmt.Sprintf("Navigated %s to %s", action, result.URL)
+	if action == playwright.HistoryReload {
+		message = fmt.Sprintf("Reloaded %s", result.URL)
+	}
+	if result.Status > 0 {
+		message += fmt.Sprintf(" (HTTP %d)", result.Status)
+	}
+
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"action":     action,
+		"url":        result.URL,
+		"title":      result.Title,
+		"status":     result.Status,
+		"wait_until": waitUntil,
+		"session_id": session.ID,
+		"message":    message,
+	})
+}
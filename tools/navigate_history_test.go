@@ -0,0 +1,132 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+
+	zap "go.uber.org/zap"
+
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+func TestNavigateHistoryTool_NavigateHistoryHandler(t *testing.T) {
+	setupHistory := func(m *mocks.FakeBrowserAutomation) {
+		m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+		m.NavigateHistoryReturns(&playwright.NavigationResult{
+			URL:    "https://example.com/list",
+			Title:  "List",
+			Status: 200,
+		}, nil)
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		setupMock     func(*mocks.FakeBrowserAutomation)
+		expectedError bool
+		errorContains string
+		verify        func(*testing.T, *mocks.FakeBrowserAutomation, map[string]any)
+	}{
+		{
+			name: "back with explicit wait and timeout",
+			args: map[string]any{
+				"action":     "back",
+				"wait_until": "domcontentloaded",
+				"timeout":    5000,
+			},
+			setupMock: setupHistory,
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, response map[string]any) {
+				assert.Equal(t, "https://example.com/list", response["url"])
+				assert.Equal(t, "List", response["title"])
+				assert.Equal(t, float64(200), response["status"])
+				assert.Equal(t, "Navigated back to https://example.com/list (HTTP 200)", response["message"])
+
+				_, sessionID, action, waitUntil, timeout := m.NavigateHistoryArgsForCall(0)
+				assert.Equal(t, "session-1", sessionID)
+				assert.Equal(t, "back", action)
+				assert.Equal(t, "domcontentloaded", waitUntil)
+				assert.Equal(t, 5*time.Second, timeout)
+			},
+		},
+		{
+			name:      "reload with defaults",
+			args:      map[string]any{"action": "reload"},
+			setupMock: setupHistory,
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, _ map[string]any) {
+				_, _, _, waitUntil, timeout := m.NavigateHistoryArgsForCall(0)
+				assert.Equal(t, "load", waitUntil)
+				assert.Equal(t, defaultTimeoutMs*time.Millisecond, timeout)
+			},
+		},
+		{
+			name:          "missing action",
+			args:          map[string]any{},
+			expectedError: true,
+			errorContains: "action",
+		},
+		{
+			name:          "invalid action",
+			args:          map[string]any{"action": "up"},
+			expectedError: true,
+			errorContains: "invalid action",
+		},
+		{
+			name:          "invalid wait_until",
+			args:          map[string]any{"action": "back", "wait_until": "idle"},
+			expectedError: true,
+			errorContains: "invalid wait_until",
+		},
+		{
+			name:          "timeout out of range",
+			args:          map[string]any{"action": "back", "timeout": 0},
+			expectedError: true,
+			errorContains: "timeout",
+		},
+		{
+			name: "nothing to go forward to",
+			args: map[string]any{"action": "forward"},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.NavigateHistoryReturns(nil, errors.New("there is no page to go forward to"))
+			},
+			expectedError: true,
+			errorContains: "forward failed: there is no page to go forward to",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			if tt.setupMock != nil {
+				tt.setupMock(mockPlaywright)
+			}
+			tool := &NavigateHistoryTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			result, err := tool.NavigateHistoryHandler(context.Background(), tt.args)
+
+			if tt.expectedError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.Empty(t, result)
+				if tt.setupMock == nil {
+					assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			var response map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(result), &response), "response should be valid JSON")
+			assert.Equal(t, true, response["success"])
+			if tt.verify != nil {
+				tt.verify(t, mockPlaywright, response)
+			}
+		})
+	}
+}
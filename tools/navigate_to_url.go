@@ -15,6 +15,12 @@ import (
 
 var validWaitConditions = []string{"domcontentloaded", "load", "networkidle"}
 
+// The range of HTTP statuses fail_on_status accepts
+const (
+	minHTTPStatus = 100
+	maxHTTPStatus = 599
+)
+
 // NavigateToURLTool struct holds the tool with dependencies
 type NavigateToURLTool struct {
 	logger     *zap.Logger
@@ -29,10 +35,20 @@ func NewNavigateToURLTool(logger *zap.Logger, playwright playwright.BrowserAutom
 	}
 	return server.NewBasicTool(
 		"navigate_to_url",
-		"Navigate to a specific URL and wait for the page to fully load",
+		"Navigate to a specific URL and wait for the page to fully load. Returns the HTTP status, final URL after redirects, redirect chain, title, response headers and load timing",
 		map[string]any{
 			"type": "object",
 			"properties": map[string]any{
+				"fail_on_status": map[string]any{
+					"description": "Fail when the main response's HTTP status is at or above this, e.g. 400 for client and server errors. By default any status succeeds",
+					"maximum":     maxHTTPStatus,
+					"minimum":     minHTTPStatus,
+					"type":        "integer",
+				},
+				"profile": map[string]any{
+					"description": profileDescription,
+					"type":        "string",
+				},
 				"timeout": map[string]any{
 					"default":     defaultTimeoutMs,
 					"description": "Maximum navigation timeout in milliseconds",
@@ -80,6 +96,17 @@ func (s *NavigateToURLTool) NavigateToURLHandler(ctx context.Context, args map[s
 		return "", err
 	}
 
+	failOnStatus := 0
+	if _, ok := args["fail_on_status"]; ok {
+		if failOnStatus, err = boundedIntArg(args, "fail_on_status", 0, minHTTPStatus, maxHTTPStatus); err != nil {
+			return "", err
+		}
+	}
+
+	if ctx, err = profileContext(ctx, args); err != nil {
+		return "", err
+	}
+
 	s.logger.Info("navigating to URL",
 		zap.String("url", targetURL),
 		zap.String("wait_until", waitUntil),
@@ -92,7 +119,8 @@ func (s *NavigateToURLTool) NavigateToURLHandler(ctx context.Context, args map[s
 	}
 
 	timeoutDuration := time.Duration(timeout) * time.Millisecond
-	if err := s.playwright.NavigateToURL(ctx, session.ID, targetURL, waitUntil, timeoutDuration); err != nil {
+	result, err := s.playwright.NavigateToURL(ctx, session.ID, targetURL, waitUntil, timeoutDuration)
+	if err != nil {
 		s.logger.Error("navigation failed",
 			zap.String("url", targetURL),
 			zap.String("sessionID", session.ID),
@@ -100,22 +128,57 @@ func (s *NavigateToURLTool) NavigateToURLHandler(ctx context.Context, args map[s
 		return "", fmt.Errorf("navigation failed: %w", err)
 	}
 
+	if failOnStatus > 0 && result.Status >= failOnStatus {
+		s.logger.Warn("navigation returned a failing status",
+			zap.String("url", result.URL),
+			zap.Int("status", result.Status),
+			zap.String("sessionID", session.ID))
+		return "", fmt.Errorf("navigation to %s returned HTTP %d %s", result.URL, result.Status, result.StatusText)
+	}
+
 	s.logger.Info("navigation completed successfully",
 		zap.String("url", targetURL),
+		zap.String("final_url", result.URL),
+		zap.Int("status", result.Status),
 		zap.String("sessionID", session.ID))
 
 	return marshalResponse(map[string]any{
-		"success":    true,
-		"url":        targetURL,
-		"wait_until": waitUntil,
-		"timeout_ms": timeout,
-		"session_id": session.ID,
-		"message":    "Navigation completed successfully",
+		"success":       true,
+		"url":           result.URL,
+		"requested_url": targetURL,
+		"title":         result.Title,
+		"status":        result.Status,
+		"status_text":   result.StatusText,
+		"redirects":     result.Redirects,
+		"headers":       result.Headers,
+		"timing":        result.Timing,
+		"wait_until":    waitUntil,
+		"timeout_ms":    timeout,
+		"session_id":    session.ID,
+		"message":       navigationMessage(targetURL, result),
 	})
 }
 
+// navigationMessage summarizes where a navigation to requested ended up
+func navigationMessage(requested string, result *playwright.NavigationResult) string {
+	message := fmt.Sprintf("Navigated to %s", result.URL)
+	if result.Status > 0 {
+		message += fmt.Sprintf(" (HTTP %d)", result.Status)
+	}
+	if result.URL != requested {
+		message += fmt.Sprintf(", redirected from %s", requested)
+	}
+	return message
+}
+
 // validateAndNormalizeURL validates that the provided URL is well-formed and supported, returning the normalized URL
 func (s *NavigateToURLTool) validateAndNormalizeURL(urlStr string) (string, error) {
+	return normalizeBrowserURL(urlStr)
+}
+
+// normalizeBrowserURL defaults a missing scheme to https and rejects
+// anything but an http(s) URL with a host
+func normalizeBrowserURL(urlStr string) (string, error) {
 	parsedURL, err := url.Parse(urlStr)
 	if err != nil {
 		return "", fmt.Errorf("invalid URL format: %w", err)
@@ -7,6 +7,9 @@ import (
 	"testing"
 	"time"
 
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
 	zaptest "go.uber.org/zap/zaptest"
 
 	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
@@ -25,7 +28,7 @@ func TestNavigateToURLTool_NavigateToURLHandler(t *testing.T) {
 	}
 	mockPlaywright.GetOrCreateTaskSessionReturns(session, nil)
 	mockPlaywright.GetSessionReturns(session, nil)
-	mockPlaywright.NavigateToURLReturns(nil)
+	mockPlaywright.NavigateToURLReturns(&playwright.NavigationResult{URL: "https://example.com", Status: 200}, nil)
 
 	tool := &NavigateToURLTool{
 		logger:     logger,
@@ -160,6 +163,81 @@ func TestNavigateToURLTool_NavigateToURLHandler(t *testing.T) {
 	}
 }
 
+func TestNavigateToURLTool_NavigationResult(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+	mockPlaywright.NavigateToURLReturns(&playwright.NavigationResult{
+		URL:        "https://example.com/login?next=%2Faccount",
+		Title:      "Sign in",
+		Status:     200,
+		StatusText: "OK",
+		Redirects:  []playwright.Redirect{{URL: "https://example.com/account", Status: 302}},
+		Headers:    map[string]string{"content-type": "text/html"},
+		Timing:     &playwright.NavigationTiming{DurationMs: 120, ResponseStartMs: 40},
+	}, nil)
+	tool := &NavigateToURLTool{logger: zaptest.NewLogger(t), playwright: mockPlaywright}
+
+	result, err := tool.NavigateToURLHandler(context.Background(), map[string]any{
+		"url":            "https://example.com/account",
+		"fail_on_status": 400,
+	})
+	require.NoError(t, err)
+
+	var response map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result), &response))
+	assert.Equal(t, "https://example.com/login?next=%2Faccount", response["url"])
+	assert.Equal(t, "https://example.com/account", response["requested_url"])
+	assert.Equal(t, "Sign in", response["title"])
+	assert.Equal(t, float64(200), response["status"])
+	assert.Equal(t, []any{map[string]any{"url": "https://example.com/account", "status": float64(302)}}, response["redirects"])
+	assert.Equal(t, map[string]any{"content-type": "text/html"}, response["headers"])
+	assert.Equal(t, float64(120), response["timing"].(map[string]any)["duration_ms"])
+	assert.Equal(t, "Navigated to https://example.com/login?next=%2Faccount (HTTP 200), redirected from https://example.com/account", response["message"])
+}
+
+func TestNavigateToURLTool_FailOnStatus(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+	mockPlaywright.NavigateToURLReturns(&playwright.NavigationResult{
+		URL:        "https://example.com/missing",
+		Status:     404,
+		StatusText: "Not Found",
+	}, nil)
+	tool := &NavigateToURLTool{logger: zaptest.NewLogger(t), playwright: mockPlaywright}
+
+	_, err := tool.NavigateToURLHandler(context.Background(), map[string]any{"url": "https://example.com/missing"})
+	require.NoError(t, err, "any status succeeds without fail_on_status")
+
+	_, err = tool.NavigateToURLHandler(context.Background(), map[string]any{"url": "https://example.com/missing", "fail_on_status": 500})
+	require.NoError(t, err)
+
+	_, err = tool.NavigateToURLHandler(context.Background(), map[string]any{"url": "https://example.com/missing", "fail_on_status": 400})
+	assert.EqualError(t, err, "navigation to https://example.com/missing returned HTTP 404 Not Found")
+
+	_, err = tool.NavigateToURLHandler(context.Background(), map[string]any{"url": "https://example.com/missing", "fail_on_status": 42})
+	assert.ErrorContains(t, err, "fail_on_status must be between 100 and 599")
+	assert.Equal(t, 3, mockPlaywright.NavigateToURLCallCount(), "invalid arguments are rejected before the service is called")
+}
+
+func TestNavigateToURLTool_Profile(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+	mockPlaywright.NavigateToURLReturns(&playwright.NavigationResult{URL: "https://example.com/account"}, nil)
+	tool := &NavigateToURLTool{logger: zaptest.NewLogger(t), playwright: mockPlaywright}
+
+	_, err := tool.NavigateToURLHandler(context.Background(), map[string]any{"url": "https://example.com/account", "profile": "shop-admin"})
+	require.NoError(t, err)
+	assert.Equal(t, "shop-admin", playwright.ProfileFromContext(mockPlaywright.GetOrCreateTaskSessionArgsForCall(0)))
+
+	_, err = tool.NavigateToURLHandler(context.Background(), map[string]any{"url": "https://example.com/account"})
+	require.NoError(t, err)
+	assert.Empty(t, playwright.ProfileFromContext(mockPlaywright.GetOrCreateTaskSessionArgsForCall(1)))
+
+	_, err = tool.NavigateToURLHandler(context.Background(), map[string]any{"url": "https://example.com/account", "profile": "../secrets"})
+	assert.ErrorContains(t, err, "invalid profile name")
+	assert.Equal(t, 2, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+}
+
 func TestNavigateToURLTool_validateAndNormalizeURL(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	tool := &NavigateToURLTool{logger: logger}
@@ -0,0 +1,102 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// OpenTabTool struct holds the tool with dependencies
+type OpenTabTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewOpenTabTool creates a new open_tab tool
+func NewOpenTabTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &OpenTabTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"open_tab",
+		"Open a new tab in the browser session, optionally navigating it to a URL, and make it the active tab",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"timeout": map[string]any{
+					"default":     defaultTimeoutMs,
+					"description": "Maximum navigation timeout in milliseconds",
+					"type":        "integer",
+				},
+				"url": map[string]any{
+					"description": "URL to open in the new tab; leave empty for a blank tab",
+					"type":        "string",
+				},
+				"wait_until": map[string]any{
+					"default":     "load",
+					"description": "When to consider navigation succeeded (domcontentloaded, load, networkidle)",
+					"type":        "string",
+				},
+			},
+		},
+		tool.OpenTabHandler,
+	)
+}
+
+// OpenTabHandler handles the open_tab tool execution
+func (s *OpenTabTool) OpenTabHandler(ctx context.Context, args map[string]any) (string, error) {
+	rawURL, err := stringArg(args, "url", "")
+	if err != nil {
+		return "", err
+	}
+
+	targetURL := ""
+	if rawURL != "" {
+		targetURL, err = normalizeBrowserURL(rawURL)
+		if err != nil {
+			return "", fmt.Errorf("invalid URL: %w", err)
+		}
+	}
+
+	waitUntil, err := stringArg(args, "wait_until", "load")
+	if err != nil {
+		return "", err
+	}
+	if !oneOf(waitUntil, validWaitConditions...) {
+		return "", fmt.Errorf("invalid wait_until value: %s. Must be one of: %v", waitUntil, validWaitConditions)
+	}
+
+	timeout, err := boundedIntArg(args, "timeout", defaultTimeoutMs, minTimeoutMs, maxTimeoutMs)
+	if err != nil {
+		return "", err
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	tab, err := s.playwright.OpenTab(ctx, session.ID, targetURL, waitUntil, time.Duration(timeout)*time.Millisecond)
+	if err != nil {
+		s.logger.Error("failed to open tab",
+			zap.String("sessionID", session.ID),
+			zap.String("url", targetURL),
+			zap.Error(err))
+		return "", fmt.Errorf("failed to open tab: %w", err)
+	}
+
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"tab":        tab,
+		"session_id": session.ID,
+		"message":    fmt.Sprintf("Opened %s and made it the active tab", tab.ID),
+	})
+}
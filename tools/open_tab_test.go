@@ -0,0 +1,77 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+func TestOpenTabTool_OpenTabHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]any
+		expectedURL   string
+		expectedWait  string
+		errorContains string
+	}{
+		{
+			name:         "blank tab",
+			args:         map[string]any{},
+			expectedURL:  "",
+			expectedWait: "load",
+		},
+		{
+			name:         "url is normalized",
+			args:         map[string]any{"url": "example.com", "wait_until": "domcontentloaded"},
+			expectedURL:  "https://example.com",
+			expectedWait: "domcontentloaded",
+		},
+		{
+			name:          "unsupported scheme",
+			args:          map[string]any{"url": "file:///etc/passwd"},
+			errorContains: "unsupported URL scheme",
+		},
+		{
+			name:          "invalid wait_until",
+			args:          map[string]any{"wait_until": "never"},
+			errorContains: "invalid wait_until value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+			mockPlaywright.OpenTabReturns(&playwright.Tab{ID: "tab-3", Active: true}, nil)
+			tool := &OpenTabTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			result, err := tool.OpenTabHandler(context.Background(), tt.args)
+			if tt.errorContains != "" {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.Zero(t, mockPlaywright.OpenTabCallCount())
+				return
+			}
+			require.NoError(t, err)
+
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(result), &response))
+			assert.Equal(t, "tab-3", response["tab"].(map[string]any)["id"])
+
+			_, sessionID, url, waitUntil, timeout := mockPlaywright.OpenTabArgsForCall(0)
+			assert.Equal(t, "session-1", sessionID)
+			assert.Equal(t, tt.expectedURL, url)
+			assert.Equal(t, tt.expectedWait, waitUntil)
+			assert.Equal(t, 30*time.Second, timeout)
+		})
+	}
+}
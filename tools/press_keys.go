@@ -0,0 +1,140 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+const (
+	// maxKeyDelayMs bounds the delay between key events of press_keys and
+	// type_text
+	maxKeyDelayMs = 1000
+	maxKeyPresses = 100
+)
+
+// PressKeysTool struct holds the tool with dependencies
+type PressKeysTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewPressKeysTool creates a new press_keys tool
+func NewPressKeysTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &PressKeysTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"press_keys",
+		"Press keys and shortcuts in order, such as Enter to submit, Tab to move focus, ArrowDown to walk an autocomplete list, or Control+A then Backspace to clear a field. Keys go to the element matching selector, which is focused first, or to the focused element",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"delay": map[string]any{
+					"default":     0,
+					"description": "Time in milliseconds to hold each key down",
+					"type":        "integer",
+				},
+				"frame": map[string]any{
+					"description": frameDescription,
+					"type":        "string",
+				},
+				"keys": map[string]any{
+					"description": "Keys to press, in order. A key is a name such as Enter, Tab, Escape, Backspace, ArrowDown or F5, or a single character; join modifiers with +, e.g. Control+A, Shift+Tab, Meta+K or ControlOrMeta+V",
+					"items": map[string]any{
+						"type": "string",
+					},
+					"type": "array",
+				},
+				"selector": map[string]any{
+					"description": "Element to focus before pressing, or a ref from get_page_snapshot such as ref=e12",
+					"type":        "string",
+				},
+				"timeout": map[string]any{
+					"default":     defaultTimeoutMs,
+					"description": "Maximum time in milliseconds to wait for the element to focus",
+					"type":        "integer",
+				},
+			},
+			"required": []string{"keys"},
+		},
+		tool.PressKeysHandler,
+	)
+}
+
+// PressKeysHandler handles the press_keys tool execution
+func (s *PressKeysTool) PressKeysHandler(ctx context.Context, args map[string]any) (string, error) {
+	keys, err := stringSliceArg(args, "keys")
+	if err != nil {
+		return "", err
+	}
+	if len(keys) == 0 {
+		return "", fmt.Errorf("keys must name at least one key")
+	}
+	if len(keys) > maxKeyPresses {
+		return "", fmt.Errorf("keys must name at most %d keys, got %d", maxKeyPresses, len(keys))
+	}
+	chords := make([]string, 0, len(keys))
+	for _, key := range keys {
+		chord, err := playwright.NormalizeKeyChord(key)
+		if err != nil {
+			return "", fmt.Errorf("invalid key %q: %w", key, err)
+		}
+		chords = append(chords, chord)
+	}
+
+	selector, err := stringArg(args, "selector", "")
+	if err != nil {
+		return "", err
+	}
+
+	frame, err := stringArg(args, "frame", "")
+	if err != nil {
+		return "", err
+	}
+
+	delay, err := boundedIntArg(args, "delay", 0, 0, maxKeyDelayMs)
+	if err != nil {
+		return "", err
+	}
+
+	timeout, err := boundedIntArg(args, "timeout", defaultTimeoutMs, minTimeoutMs, maxTimeoutMs)
+	if err != nil {
+		return "", err
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	err = s.playwright.PressKeys(ctx, session.ID, chords, playwright.KeyboardOptions{
+		Selector: selector,
+		Frame:    frame,
+		Delay:    time.Duration(delay) * time.Millisecond,
+		Timeout:  time.Duration(timeout) * time.Millisecond,
+	})
+	if err != nil {
+		s.logger.Error("press_keys failed",
+			zap.String("sessionID", session.ID),
+			zap.Strings("keys", chords),
+			zap.Error(err))
+		return "", fmt.Errorf("press_keys failed: %w", err)
+	}
+
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"keys":       chords,
+		"selector":   selector,
+		"session_id": session.ID,
+		"message":    fmt.Sprintf("Pressed %d keys", len(chords)),
+	})
+}
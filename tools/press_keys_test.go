@@ -0,0 +1,115 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+
+	zap "go.uber.org/zap"
+
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+func TestPressKeysTool_PressKeysHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]any
+		setupMock     func(*mocks.FakeBrowserAutomation)
+		expectedError bool
+		errorContains string
+		verify        func(*testing.T, *mocks.FakeBrowserAutomation, map[string]any)
+	}{
+		{
+			name: "normalized keys in the focused element",
+			args: map[string]any{
+				"keys":     []any{"ctrl+a", "Backspace", "shift+Tab"},
+				"selector": "#search",
+				"delay":    20,
+			},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+			},
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, response map[string]any) {
+				assert.Equal(t, []any{"Control+A", "Backspace", "Shift+Tab"}, response["keys"])
+
+				_, sessionID, keys, options := m.PressKeysArgsForCall(0)
+				assert.Equal(t, "session-1", sessionID)
+				assert.Equal(t, []string{"Control+A", "Backspace", "Shift+Tab"}, keys)
+				assert.Equal(t, playwright.KeyboardOptions{
+					Selector: "#search",
+					Delay:    20 * time.Millisecond,
+					Timeout:  defaultTimeoutMs * time.Millisecond,
+				}, options)
+			},
+		},
+		{
+			name:          "missing keys",
+			args:          map[string]any{},
+			expectedError: true,
+			errorContains: "keys",
+		},
+		{
+			name:          "empty keys",
+			args:          map[string]any{"keys": []any{}},
+			expectedError: true,
+			errorContains: "at least one key",
+		},
+		{
+			name:          "unknown modifier",
+			args:          map[string]any{"keys": []any{"Hyper+K"}},
+			expectedError: true,
+			errorContains: "unknown modifier",
+		},
+		{
+			name:          "delay out of range",
+			args:          map[string]any{"keys": []any{"Enter"}, "delay": 5000},
+			expectedError: true,
+			errorContains: "delay",
+		},
+		{
+			name: "element cannot be focused",
+			args: map[string]any{"keys": []any{"Enter"}, "selector": "#missing"},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.PressKeysReturns(errors.New("failed to focus #missing"))
+			},
+			expectedError: true,
+			errorContains: "failed to focus #missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			if tt.setupMock != nil {
+				tt.setupMock(mockPlaywright)
+			}
+			tool := &PressKeysTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			result, err := tool.PressKeysHandler(context.Background(), tt.args)
+
+			if tt.expectedError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.Empty(t, result)
+				if tt.setupMock == nil {
+					assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			var response map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(result), &response), "response should be valid JSON")
+			assert.Equal(t, true, response["success"])
+			if tt.verify != nil {
+				tt.verify(t, mockPlaywright, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// Actions of route_requests besides the playwright route actions
+const (
+	routeActionList   = "list"
+	routeActionRemove = "remove"
+)
+
+// routeActions are the values of the action argument
+var routeActions = []string{
+	playwright.RouteAbort, playwright.RouteFulfill, playwright.RouteRewriteHeaders, routeActionList, routeActionRemove,
+}
+
+// abortErrorCodes are the network errors Playwright can abort a request with
+var abortErrorCodes = []string{
+	"aborted", "accessdenied", "addressunreachable", "blockedbyclient",
+	"blockedbyresponse", "connectionaborted", "connectionclosed",
+	"connectionfailed", "connectionrefused", "connectionreset",
+	"internetdisconnected", "namenotresolved", "timedout", "failed",
+}
+
+// RouteRequestsTool struct holds the tool with dependencies
+type RouteRequestsTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewRouteRequestsTool creates a new route_requests tool
+func NewRouteRequestsTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &RouteRequestsTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"route_requests",
+		"Intercept the requests of the browser session's pages. abort blocks matching requests (e.g. resource_types [image, font] or trackers to speed up scraping), fulfill answers them with a canned status and body (e.g. to test error states), and rewrite_headers changes their request headers. Rules match by url_pattern, resource_types and trackers, apply to every tab, and stay until removed; list and remove manage them",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"action": map[string]any{
+					"description": "What to do: add an abort, fulfill or rewrite_headers rule, list the rules, or remove rules",
+					"enum":        routeActions,
+					"type":        "string",
+				},
+				"body": map[string]any{
+					"description": "Response body for fulfill: a string, or a JSON object or array which is sent as application/json",
+				},
+				"content_type": map[string]any{
+					"description": "Content-Type of the fulfill response",
+					"type":        "string",
+				},
+				"error_code": map[string]any{
+					"default":     "blockedbyclient",
+					"description": "Network error reported for aborted requests",
+					"enum":        abortErrorCodes,
+					"type":        "string",
+				},
+				"headers": map[string]any{
+					"additionalProperties": map[string]any{"type": "string"},
+					"description":          "For fulfill, response headers; for rewrite_headers, request headers to set",
+					"type":                 "object",
+				},
+				"remove_headers": map[string]any{
+					"description": "Request headers to drop, for rewrite_headers",
+					"items":       map[string]any{"type": "string"},
+					"type":        "array",
+				},
+				"resource_types": map[string]any{
+					"description": "Resource types to match",
+					"items": map[string]any{
+						"enum": resourceTypes,
+						"type": "string",
+					},
+					"type": "array",
+				},
+				"route_ids": map[string]any{
+					"description": "IDs of the rules to remove; omit to remove every rule",
+					"items":       map[string]any{"type": "string"},
+					"type":        "array",
+				},
+				"status": map[string]any{
+					"default":     200,
+					"description": "HTTP status of the fulfill response",
+					"type":        "integer",
+				},
+				"times": map[string]any{
+					"description": "Remove the rule after it has handled this many requests; omit to keep it",
+					"type":        "integer",
+				},
+				"trackers": map[string]any{
+					"default":     false,
+					"description": "Match requests to well-known analytics and advertising hosts",
+					"type":        "boolean",
+				},
+				"url_pattern": map[string]any{
+					"description": "URL to match: a glob (**/api/orders*), a /regex/, or a substring",
+					"type":        "string",
+				},
+			},
+			"required": []string{"action"},
+		},
+		tool.RouteRequestsHandler,
+	)
+}
+
+// RouteRequestsHandler handles the route_requests tool execution
+func (s *RouteRequestsTool) RouteRequestsHandler(ctx context.Context, args map[string]any) (string, error) {
+	action, err := requiredString(args, "action")
+	if err != nil {
+		return "", err
+	}
+	if !oneOf(action, routeActions...) {
+		return "", fmt.Errorf("invalid action %q: use abort, fulfill, rewrite_headers, list or remove", action)
+	}
+
+	var (
+		rule     playwright.RouteRule
+		routeIDs []string
+	)
+	switch action {
+	case routeActionRemove:
+		if routeIDs, err = stringSliceArg(args, "route_ids"); err != nil {
+			return "", err
+		}
+	case routeActionList:
+	default:
+		if rule, err = parseRouteRule(action, args); err != nil {
+			return "", err
+		}
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	response := map[string]any{
+		"success":    true,
+		"session_id": session.ID,
+	}
+
+	switch action {
+	case routeActionRemove:
+		removed, err := s.playwright.RemoveRoutes(ctx, session.ID, routeIDs)
+		if err != nil {
+			return "", fmt.Errorf("failed to remove routes: %w", err)
+		}
+		response["removed"] = removed
+		response["message"] = fmt.Sprintf("Removed %d route(s)", len(removed))
+
+	case routeActionList:
+		response["message"] = "Rules are applied newest first"
+
+	default:
+		added, err := s.playwright.AddRoute(ctx, session.ID, rule)
+		if err != nil {
+			s.logger.Error("failed to add route",
+				zap.String("sessionID", session.ID),
+				zap.String("action", action),
+				zap.Error(err))
+			return "", fmt.Errorf("failed to add route: %w", err)
+		}
+		response["route"] = added
+		response["message"] = fmt.Sprintf("Added %s; it applies to requests made from now on, so reload the page to affect what is already loaded", added.ID)
+	}
+
+	routes, err := s.playwright.ListRoutes(ctx, session.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to list routes: %w", err)
+	}
+	response["routes"] = routes
+
+	return marshalResponse(response)
+}
+
+// parseRouteRule builds the rule for an abort, fulfill or rewrite_headers
+// action from the tool arguments
+func parseRouteRule(action string, args map[string]any) (playwright.RouteRule, error) {
+	rule := playwright.RouteRule{Action: action}
+	var err error
+
+	if rule.URLPattern, err = stringArg(args, "url_pattern", ""); err != nil {
+		return rule, err
+	}
+	if rule.ResourceTypes, err = resourceTypesArg(args); err != nil {
+		return rule, err
+	}
+	if rule.Trackers, err = boolArg(args, "trackers", false); err != nil {
+		return rule, err
+	}
+	if rule.Times, err = boundedIntArg(args, "times", 0, 0, 1000000); err != nil {
+		return rule, err
+	}
+	if rule.Headers, err = stringMapArg(args, "headers"); err != nil {
+		return rule, err
+	}
+
+	switch action {
+	case playwright.RouteAbort:
+		if rule.ErrorCode, err = stringArg(args, "error_code", ""); err != nil {
+			return rule, err
+		}
+		if rule.ErrorCode != "" && !oneOf(rule.ErrorCode, abortErrorCodes...) {
+			return rule, fmt.Errorf("invalid error_code %q", rule.ErrorCode)
+		}
+
+	case playwright.RouteFulfill:
+		if rule.Status, err = boundedIntArg(args, "status", 200, 100, 599); err != nil {
+			return rule, err
+		}
+		if rule.ContentType, err = stringArg(args, "content_type", ""); err != nil {
+			return rule, err
+		}
+		switch body := args["body"].(type) {
+		case nil:
+		case string:
+			rule.Body = body
+		default:
+			encoded, err := json.Marshal(body)
+			if err != nil {
+				return rule, fmt.Errorf("body must be a string or JSON value: %w", err)
+			}
+			rule.Body = string(encoded)
+			if rule.ContentType == "" {
+				rule.ContentType = "application/json"
+			}
+		}
+
+	case playwright.RouteRewriteHeaders:
+		if rule.RemoveHeaders, err = stringSliceArg(args, "remove_headers"); err != nil {
+			return rule, err
+		}
+	}
+
+	return rule, nil
+}
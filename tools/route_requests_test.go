@@ -0,0 +1,128 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+func TestRouteRequestsTool_RouteRequestsHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     map[string]any
+		expected playwright.RouteRule
+	}{
+		{
+			name: "block images and fonts",
+			args: map[string]any{"action": "abort", "resource_types": []any{"image", "font"}},
+			expected: playwright.RouteRule{
+				Action:        playwright.RouteAbort,
+				ResourceTypes: []string{"image", "font"},
+			},
+		},
+		{
+			name: "fulfill with a JSON body",
+			args: map[string]any{
+				"action":      "fulfill",
+				"url_pattern": "**/api/orders",
+				"status":      500,
+				"body":        map[string]any{"error": "boom"},
+				"times":       1,
+			},
+			expected: playwright.RouteRule{
+				Action:      playwright.RouteFulfill,
+				URLPattern:  "**/api/orders",
+				Status:      500,
+				ContentType: "application/json",
+				Body:        `{"error":"boom"}`,
+				Times:       1,
+			},
+		},
+		{
+			name: "rewrite headers",
+			args: map[string]any{
+				"action":         "rewrite_headers",
+				"url_pattern":    "/api/",
+				"headers":        map[string]any{"Authorization": "Bearer test"},
+				"remove_headers": []any{"Cookie"},
+			},
+			expected: playwright.RouteRule{
+				Action:        playwright.RouteRewriteHeaders,
+				URLPattern:    "/api/",
+				Headers:       map[string]string{"Authorization": "Bearer test"},
+				RemoveHeaders: []string{"Cookie"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+			mockPlaywright.AddRouteReturns(&playwright.RouteRule{ID: "route-1", Action: tt.expected.Action}, nil)
+			mockPlaywright.ListRoutesReturns([]playwright.RouteRule{{ID: "route-1", Action: tt.expected.Action}}, nil)
+			tool := &RouteRequestsTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			result, err := tool.RouteRequestsHandler(context.Background(), tt.args)
+			require.NoError(t, err)
+
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(result), &response))
+			assert.Equal(t, "route-1", response["route"].(map[string]any)["id"])
+			assert.Len(t, response["routes"], 1)
+
+			require.Equal(t, 1, mockPlaywright.AddRouteCallCount())
+			_, sessionID, rule := mockPlaywright.AddRouteArgsForCall(0)
+			assert.Equal(t, "session-1", sessionID)
+			assert.Equal(t, tt.expected, rule)
+		})
+	}
+}
+
+func TestRouteRequestsTool_RouteRequestsHandler_ListAndRemove(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+	mockPlaywright.RemoveRoutesReturns([]playwright.RouteRule{{ID: "route-2"}}, nil)
+	tool := &RouteRequestsTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+	result, err := tool.RouteRequestsHandler(context.Background(), map[string]any{"action": "remove", "route_ids": []any{"route-2"}})
+	require.NoError(t, err)
+	assert.Contains(t, result, "Removed 1 route(s)")
+	_, _, ids := mockPlaywright.RemoveRoutesArgsForCall(0)
+	assert.Equal(t, []string{"route-2"}, ids)
+
+	_, err = tool.RouteRequestsHandler(context.Background(), map[string]any{"action": "list"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, mockPlaywright.ListRoutesCallCount())
+	assert.Zero(t, mockPlaywright.AddRouteCallCount())
+}
+
+func TestRouteRequestsTool_RouteRequestsHandler_Errors(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+	mockPlaywright.AddRouteReturns(nil, errors.New("a route needs a url_pattern, resource_types or trackers to match requests"))
+	tool := &RouteRequestsTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+	_, err := tool.RouteRequestsHandler(context.Background(), map[string]any{"action": "redirect"})
+	assert.ErrorContains(t, err, "invalid action")
+
+	_, err = tool.RouteRequestsHandler(context.Background(), map[string]any{"action": "abort", "url_pattern": "*", "error_code": "nope"})
+	assert.ErrorContains(t, err, "invalid error_code")
+
+	_, err = tool.RouteRequestsHandler(context.Background(), map[string]any{"action": "fulfill", "url_pattern": "*", "status": 42})
+	assert.Error(t, err)
+	assert.Zero(t, mockPlaywright.AddRouteCallCount(), "invalid arguments are rejected before the service is called")
+
+	_, err = tool.RouteRequestsHandler(context.Background(), map[string]any{"action": "abort"})
+	assert.ErrorContains(t, err, "failed to add route")
+}
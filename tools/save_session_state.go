@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// SaveSessionStateTool struct holds the tool with dependencies
+type SaveSessionStateTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewSaveSessionStateTool creates a new save_session_state tool
+func NewSaveSessionStateTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &SaveSessionStateTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"save_session_state",
+		"Save the cookies, local storage and IndexedDB of the browser session as a named profile, replacing a profile of the same name. A later task can start logged in by passing the profile to navigate_to_url or configure_browser",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"name": map[string]any{
+					"description": "Profile name: up to 64 letters, digits, dots, dashes and underscores, starting with a letter or digit",
+					"type":        "string",
+				},
+			},
+			"required": []string{"name"},
+		},
+		tool.SaveSessionStateHandler,
+	)
+}
+
+// SaveSessionStateHandler handles the save_session_state tool execution
+func (s *SaveSessionStateTool) SaveSessionStateHandler(ctx context.Context, args map[string]any) (string, error) {
+	name, err := requiredString(args, "name")
+	if err != nil {
+		return "", err
+	}
+	if err := playwright.ValidateProfileName(name); err != nil {
+		return "", err
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	profile, err := s.playwright.SaveProfile(ctx, session.ID, name)
+	if err != nil {
+		s.logger.Error("failed to save session state",
+			zap.String("sessionID", session.ID),
+			zap.String("profile", name),
+			zap.Error(err))
+		return "", fmt.Errorf("save session state failed: %w", err)
+	}
+
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"profile":    profile,
+		"session_id": session.ID,
+		"message":    fmt.Sprintf("Saved the session state as profile %s", profile.Name),
+	})
+}
@@ -0,0 +1,107 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+
+	zap "go.uber.org/zap"
+
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+func TestSaveSessionStateTool_SaveSessionStateHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]any
+		setupMock     func(*mocks.FakeBrowserAutomation)
+		expectedError bool
+		errorContains string
+		verify        func(*testing.T, *mocks.FakeBrowserAutomation, map[string]any)
+	}{
+		{
+			name: "saves the session as a profile",
+			args: map[string]any{"name": "shop-admin"},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.SaveProfileReturns(&playwright.Profile{Name: "shop-admin", SavedAt: time.Now(), Size: 2048}, nil)
+			},
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, response map[string]any) {
+				assert.Equal(t, "shop-admin", response["profile"].(map[string]any)["name"])
+				assert.Equal(t, "Saved the session state as profile shop-admin", response["message"])
+				_, sessionID, name := m.SaveProfileArgsForCall(0)
+				assert.Equal(t, "session-1", sessionID)
+				assert.Equal(t, "shop-admin", name)
+			},
+		},
+		{
+			name:          "missing name",
+			args:          map[string]any{},
+			expectedError: true,
+			errorContains: "name",
+		},
+		{
+			name:          "empty name",
+			args:          map[string]any{"name": ""},
+			expectedError: true,
+			errorContains: "name",
+		},
+		{
+			name:          "name with a path",
+			args:          map[string]any{"name": "../etc/passwd"},
+			expectedError: true,
+			errorContains: "invalid profile name",
+		},
+		{
+			name:          "hidden name",
+			args:          map[string]any{"name": ".hidden"},
+			expectedError: true,
+			errorContains: "invalid profile name",
+		},
+		{
+			name: "save fails",
+			args: map[string]any{"name": "shop-admin"},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.SaveProfileReturns(nil, errors.New("failed to save storage state: context closed"))
+			},
+			expectedError: true,
+			errorContains: "save session state failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			if tt.setupMock != nil {
+				tt.setupMock(mockPlaywright)
+			}
+			tool := &SaveSessionStateTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			result, err := tool.SaveSessionStateHandler(context.Background(), tt.args)
+
+			if tt.expectedError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.Empty(t, result)
+				if tt.setupMock == nil {
+					assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			var response map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(result), &response), "response should be valid JSON")
+			assert.Equal(t, true, response["success"])
+			if tt.verify != nil {
+				tt.verify(t, mockPlaywright, response)
+			}
+		})
+	}
+}
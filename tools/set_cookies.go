@@ -0,0 +1,186 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// sameSiteValues are the values of a cookie's same_site
+var sameSiteValues = playwright.SameSiteValues
+
+// SetCookiesTool struct holds the tool with dependencies
+type SetCookiesTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewSetCookiesTool creates a new set_cookies tool
+func NewSetCookiesTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &SetCookiesTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"set_cookies",
+		"Add cookies to the browser session, replacing cookies with the same name, domain and path, e.g. to turn on feature flags before loading a page. They apply to the next request, so reload a page that is already open",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"cookies": map[string]any{
+					"description": "Cookies to set. Each needs a url, or a domain (with an optional path, / by default)",
+					"items": map[string]any{
+						"properties": map[string]any{
+							"domain": map[string]any{
+								"description": "Domain the cookie is for; a leading dot includes subdomains",
+								"type":        "string",
+							},
+							"expires": map[string]any{
+								"description": "Expiry as a Unix time in seconds. By default a session cookie",
+								"type":        "number",
+							},
+							"http_only": map[string]any{
+								"default":     false,
+								"description": "Hide the cookie from page scripts",
+								"type":        "boolean",
+							},
+							"name": map[string]any{
+								"description": "Cookie name",
+								"type":        "string",
+							},
+							"path": map[string]any{
+								"description": "Path the cookie is for, with domain",
+								"type":        "string",
+							},
+							"same_site": map[string]any{
+								"description": "SameSite attribute",
+								"enum":        sameSiteValues,
+								"type":        "string",
+							},
+							"secure": map[string]any{
+								"description": "Only send the cookie over https. Implied by an https url",
+								"type":        "boolean",
+							},
+							"url": map[string]any{
+								"description": "URL the cookie is for, instead of domain and path",
+								"type":        "string",
+							},
+							"value": map[string]any{
+								"description": "Cookie value",
+								"type":        "string",
+							},
+						},
+						"required": []string{"name", "value"},
+						"type":     "object",
+					},
+					"type": "array",
+				},
+			},
+			"required": []string{"cookies"},
+		},
+		tool.SetCookiesHandler,
+	)
+}
+
+// SetCookiesHandler handles the set_cookies tool execution
+func (s *SetCookiesTool) SetCookiesHandler(ctx context.Context, args map[string]any) (string, error) {
+	rawCookies, present, err := sliceArg(args, "cookies")
+	if err != nil {
+		return "", err
+	}
+	if !present || len(rawCookies) == 0 {
+		return "", fmt.Errorf("at least one cookie is required")
+	}
+
+	cookies := make([]playwright.Cookie, 0, len(rawCookies))
+	for i, raw := range rawCookies {
+		cookie, err := parseCookie(raw)
+		if err != nil {
+			return "", fmt.Errorf("cookie %d: %w", i, err)
+		}
+		cookies = append(cookies, cookie)
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	if err := s.playwright.SetCookies(ctx, session.ID, cookies); err != nil {
+		s.logger.Error("failed to set cookies", zap.String("sessionID", session.ID), zap.Error(err))
+		return "", fmt.Errorf("set cookies failed: %w", err)
+	}
+
+	names := make([]string, 0, len(cookies))
+	for _, cookie := range cookies {
+		names = append(names, cookie.Name)
+	}
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"count":      len(cookies),
+		"names":      names,
+		"session_id": session.ID,
+		"message":    fmt.Sprintf("Set %d cookie(s)", len(cookies)),
+	})
+}
+
+// parseCookie validates one entry of the cookies argument
+func parseCookie(raw any) (playwright.Cookie, error) {
+	var cookie playwright.Cookie
+	args, ok := raw.(map[string]any)
+	if !ok {
+		return cookie, fmt.Errorf("must be an object, got %T", raw)
+	}
+
+	var err error
+	if cookie.Name, err = requiredString(args, "name"); err != nil {
+		return cookie, err
+	}
+	if _, ok := args["value"]; !ok {
+		return cookie, fmt.Errorf("value parameter is required")
+	}
+	if cookie.Value, err = stringArg(args, "value", ""); err != nil {
+		return cookie, err
+	}
+	if cookie.URL, err = stringArg(args, "url", ""); err != nil {
+		return cookie, err
+	}
+	if cookie.Domain, err = stringArg(args, "domain", ""); err != nil {
+		return cookie, err
+	}
+	if cookie.Path, err = stringArg(args, "path", ""); err != nil {
+		return cookie, err
+	}
+	switch {
+	case cookie.URL == "" && cookie.Domain == "":
+		return cookie, fmt.Errorf("a url or a domain is required")
+	case cookie.URL != "" && (cookie.Domain != "" || cookie.Path != ""):
+		return cookie, fmt.Errorf("give either a url or a domain and path, not both")
+	case cookie.URL != "":
+		if cookie.URL, err = normalizeBrowserURL(cookie.URL); err != nil {
+			return cookie, err
+		}
+	}
+	if cookie.Expires, err = floatArg(args, "expires", 0); err != nil {
+		return cookie, err
+	}
+	if cookie.HTTPOnly, err = boolArg(args, "http_only", false); err != nil {
+		return cookie, err
+	}
+	if cookie.Secure, err = boolArg(args, "secure", false); err != nil {
+		return cookie, err
+	}
+	if cookie.SameSite, err = stringArg(args, "same_site", ""); err != nil {
+		return cookie, err
+	}
+	if cookie.SameSite != "" && !oneOf(cookie.SameSite, sameSiteValues...) {
+		return cookie, fmt.Errorf("invalid same_site value: %s. Must be one of: %v", cookie.SameSite, sameSiteValues)
+	}
+	return cookie, nil
+}
@@ -0,0 +1,94 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+)
+
+func TestSetCookiesTool_SetCookiesHandler(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+	tool := &SetCookiesTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+	result, err := tool.SetCookiesHandler(context.Background(), map[string]any{
+		"cookies": []any{
+			map[string]any{"name": "ff_new_checkout", "value": "on", "url": "app.example.com"},
+			map[string]any{"name": "ff_beta", "value": "", "domain": ".example.com", "http_only": true, "same_site": "Strict"},
+		},
+	})
+	require.NoError(t, err)
+
+	var response map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result), &response))
+	assert.Equal(t, "Set 2 cookie(s)", response["message"])
+	assert.Equal(t, []any{"ff_new_checkout", "ff_beta"}, response["names"])
+
+	_, sessionID, cookies := mockPlaywright.SetCookiesArgsForCall(0)
+	assert.Equal(t, "task-1", sessionID)
+	assert.Equal(t, []playwright.Cookie{
+		{Name: "ff_new_checkout", Value: "on", URL: "https://app.example.com"},
+		{Name: "ff_beta", Domain: ".example.com", HTTPOnly: true, SameSite: "Strict"},
+	}, cookies)
+}
+
+func TestSetCookiesTool_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    map[string]any
+		wantErr string
+	}{
+		{name: "no cookies", args: map[string]any{}, wantErr: "at least one cookie is required"},
+		{name: "empty list", args: map[string]any{"cookies": []any{}}, wantErr: "at least one cookie is required"},
+		{name: "not an object", args: map[string]any{"cookies": []any{"a=b"}}, wantErr: "cookie 0: must be an object"},
+		{
+			name:    "missing value",
+			args:    map[string]any{"cookies": []any{map[string]any{"name": "a", "domain": "example.com"}}},
+			wantErr: "cookie 0: value parameter is required",
+		},
+		{
+			name:    "no url or domain",
+			args:    map[string]any{"cookies": []any{map[string]any{"name": "a", "value": "b"}}},
+			wantErr: "cookie 0: a url or a domain is required",
+		},
+		{
+			name:    "url and domain",
+			args:    map[string]any{"cookies": []any{map[string]any{"name": "a", "value": "b", "url": "https://example.com", "domain": "example.com"}}},
+			wantErr: "cookie 0: give either a url or a domain and path, not both",
+		},
+		{
+			name:    "invalid same_site",
+			args:    map[string]any{"cookies": []any{map[string]any{"name": "a", "value": "b", "domain": "example.com", "same_site": "lax"}}},
+			wantErr: "cookie 0: invalid same_site value: lax",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			tool := &SetCookiesTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			_, err := tool.SetCookiesHandler(context.Background(), tt.args)
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+		})
+	}
+
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+	mockPlaywright.SetCookiesReturns(errors.New("invalid cookie fields"))
+	tool := &SetCookiesTool{logger: zap.NewNop(), playwright: mockPlaywright}
+	_, err := tool.SetCookiesHandler(context.Background(), map[string]any{
+		"cookies": []any{map[string]any{"name": "a", "value": "b", "domain": "example.com"}},
+	})
+	assert.EqualError(t, err, "set cookies failed: invalid cookie fields")
+}
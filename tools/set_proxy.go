@@ -0,0 +1,169 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+	proxy "github.com/inference-gateway/browser-agent/internal/proxy"
+	secrets "github.com/inference-gateway/browser-agent/internal/secrets"
+)
+
+// proxyActions are the values of the action argument
+var proxyActions = playwright.ProxyActions
+
+// SetProxyTool struct holds the tool with dependencies
+type SetProxyTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+	secrets    secrets.CredentialStore
+}
+
+// NewSetProxyTool creates a new set_proxy tool
+func NewSetProxyTool(logger *zap.Logger, playwright playwright.BrowserAutomation, secrets secrets.CredentialStore) server.Tool {
+	tool := &SetProxyTool{
+		logger:     logger,
+		playwright: playwright,
+		secrets:    secrets,
+	}
+	return server.NewBasicTool(
+		"set_proxy",
+		"Change the proxy the current task's browser and Fetch requests go through by recreating the browser context. set: use the given proxy server, which must be one of the configured proxies or listed in BROWSER_PROXY_ALLOWED; rotate: move to the next proxy configured with BROWSER_PROXY_SERVER and BROWSER_PROXY_ROTATION, e.g. after being rate limited or blocked; direct: stop using a proxy. Cookies and localStorage are kept and the current page is loaded again, but other tabs and sessionStorage are lost. Prefer credential_ref over username/password so the secret never appears in the conversation",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"action": map[string]any{
+					"description": "set a proxy server, rotate to the next configured proxy, or connect directly",
+					"enum":        proxyActions,
+					"type":        "string",
+				},
+				"bypass": map[string]any{
+					"description": "Hosts reached without the proxy with action set: a host name, host:port, or a domain starting with \".\" for all its subdomains",
+					"items": map[string]any{
+						"type": "string",
+					},
+					"type": "array",
+				},
+				"credential_ref": map[string]any{
+					"description": "Reference to a stored credential for the proxy, e.g. vault://corp-proxy. Explicit username/password arguments take precedence",
+					"type":        "string",
+				},
+				"password": map[string]any{
+					"description": "Proxy password with action set",
+					"type":        "string",
+				},
+				"server": map[string]any{
+					"description": "Proxy URL with action set, e.g. http://proxy.example.com:3128 or socks5://127.0.0.1:1080. Defaults to http:// without a scheme",
+					"type":        "string",
+				},
+				"username": map[string]any{
+					"description": "Proxy user name with action set",
+					"type":        "string",
+				},
+			},
+			"required": []string{"action"},
+		},
+		tool.SetProxyHandler,
+	)
+}
+
+// SetProxyHandler handles the set_proxy tool execution
+func (s *SetProxyTool) SetProxyHandler(ctx context.Context, args map[string]any) (string, error) {
+	change, err := s.proxyChangeArgs(args)
+	if err != nil {
+		return "", err
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	settings, err := s.playwright.SetProxy(ctx, session.ID, change)
+	if err != nil {
+		s.logger.Error("failed to set proxy", zap.String("sessionID", session.ID), zap.Error(err))
+		return "", fmt.Errorf("set proxy failed: %w", err)
+	}
+
+	if settings == nil {
+		return marshalResponse(map[string]any{
+			"success":    true,
+			"proxy":      nil,
+			"session_id": session.ID,
+			"message":    "Browser and fetch requests now connect directly",
+		})
+	}
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"proxy":      settings,
+		"session_id": session.ID,
+		"message":    fmt.Sprintf("Browser and fetch requests now go through %s", settings.Server),
+	})
+}
+
+// proxyChangeArgs reads and validates the set_proxy arguments, resolving
+// credential_ref
+func (s *SetProxyTool) proxyChangeArgs(args map[string]any) (playwright.ProxyChange, error) {
+	var change playwright.ProxyChange
+	action, err := requiredString(args, "action")
+	if err != nil {
+		return change, err
+	}
+	if !oneOf(action, proxyActions...) {
+		return change, fmt.Errorf("invalid action: %s. Must be one of: %v", action, proxyActions)
+	}
+	change.Action = action
+
+	proxyServer, err := stringArg(args, "server", "")
+	if err != nil {
+		return change, err
+	}
+	if action != playwright.ProxyActionSet {
+		if proxyServer != "" {
+			return change, fmt.Errorf("server is only used with action set")
+		}
+		return change, nil
+	}
+
+	bypass, err := stringSliceArg(args, "bypass")
+	if err != nil {
+		return change, err
+	}
+	username, err := stringArg(args, "username", "")
+	if err != nil {
+		return change, err
+	}
+	password, err := stringArg(args, "password", "")
+	if err != nil {
+		return change, err
+	}
+	credentialRef, err := stringArg(args, "credential_ref", "")
+	if err != nil {
+		return change, err
+	}
+	if credentialRef != "" {
+		if !secrets.Configured(s.secrets) {
+			return change, fmt.Errorf("credential_ref requires a credential vault; set BROWSER_CREDENTIALS_PATH")
+		}
+		credential, err := s.secrets.Resolve(credentialRef)
+		if err != nil {
+			return change, err
+		}
+		if username == "" {
+			username = credential.Username()
+		}
+		if password == "" {
+			password = credential.Password()
+		}
+	}
+
+	if change.Proxy, err = proxy.NewSettings(proxyServer, bypass, username, password); err != nil {
+		return change, err
+	}
+	return change, nil
+}
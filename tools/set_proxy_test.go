@@ -0,0 +1,135 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+	proxy "github.com/inference-gateway/browser-agent/internal/proxy"
+	secrets "github.com/inference-gateway/browser-agent/internal/secrets"
+)
+
+func TestSetProxyTool_SetProxyHandler(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+	mockPlaywright.SetProxyReturns(&proxy.Settings{Server: "http://proxy.example.com:3128", Username: "bot", Password: "s3cret"}, nil)
+	tool := &SetProxyTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+	result, err := tool.SetProxyHandler(context.Background(), map[string]any{
+		"action":   "set",
+		"bypass":   []any{".internal.example.com"},
+		"password": "s3cret",
+		"server":   "proxy.example.com:3128",
+		"username": "bot",
+	})
+	require.NoError(t, err)
+
+	assert.NotContains(t, result, "s3cret", "the proxy password is never returned")
+	var response map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result), &response))
+	assert.Equal(t, "Browser and fetch requests now go through http://proxy.example.com:3128", response["message"])
+
+	_, sessionID, change := mockPlaywright.SetProxyArgsForCall(0)
+	assert.Equal(t, "task-1", sessionID)
+	assert.Equal(t, playwright.ProxyChange{
+		Action: playwright.ProxyActionSet,
+		Proxy: &proxy.Settings{
+			Server:   "http://proxy.example.com:3128",
+			Bypass:   []string{".internal.example.com"},
+			Username: "bot",
+			Password: "s3cret",
+		},
+	}, change)
+}
+
+func TestSetProxyTool_RotateAndDirect(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+	tool := &SetProxyTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+	mockPlaywright.SetProxyReturns(&proxy.Settings{Server: "http://exit-2.example.com:3128"}, nil)
+	_, err := tool.SetProxyHandler(context.Background(), map[string]any{"action": "rotate"})
+	require.NoError(t, err)
+	_, _, change := mockPlaywright.SetProxyArgsForCall(0)
+	assert.Equal(t, playwright.ProxyChange{Action: playwright.ProxyActionRotate}, change)
+
+	mockPlaywright.SetProxyReturns(nil, nil)
+	result, err := tool.SetProxyHandler(context.Background(), map[string]any{"action": "direct"})
+	require.NoError(t, err)
+	var response map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result), &response))
+	assert.Nil(t, response["proxy"])
+	assert.Equal(t, "Browser and fetch requests now connect directly", response["message"])
+}
+
+func TestSetProxyTool_CredentialRef(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+	mockPlaywright.SetProxyReturns(&proxy.Settings{Server: "http://proxy.example.com:3128"}, nil)
+
+	store := secrets.NewVault(map[string]secrets.Credential{
+		"corp-proxy": {"username": "proxy-bot", "password": "hunter2-secret"},
+	})
+	tool := &SetProxyTool{logger: zap.NewNop(), playwright: mockPlaywright, secrets: store}
+
+	_, err := tool.SetProxyHandler(context.Background(), map[string]any{
+		"action":         "set",
+		"credential_ref": "vault://corp-proxy",
+		"server":         "http://proxy.example.com:3128",
+	})
+	require.NoError(t, err)
+	_, _, change := mockPlaywright.SetProxyArgsForCall(0)
+	assert.Equal(t, "proxy-bot", change.Proxy.Username)
+	assert.Equal(t, "hunter2-secret", change.Proxy.Password)
+
+	for _, store := range []secrets.CredentialStore{nil, secrets.NewVault(nil)} {
+		noVault := &SetProxyTool{logger: zap.NewNop(), playwright: mockPlaywright, secrets: store}
+		_, err = noVault.SetProxyHandler(context.Background(), map[string]any{
+			"action":         "set",
+			"credential_ref": "vault://corp-proxy",
+			"server":         "http://proxy.example.com:3128",
+		})
+		assert.ErrorContains(t, err, "requires a credential vault", "an empty vault points to BROWSER_CREDENTIALS_PATH")
+	}
+}
+
+func TestSetProxyTool_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    map[string]any
+		wantErr string
+	}{
+		{name: "missing action", args: map[string]any{}, wantErr: "action"},
+		{name: "invalid action", args: map[string]any{"action": "cycle"}, wantErr: "invalid action: cycle"},
+		{name: "set without server", args: map[string]any{"action": "set"}, wantErr: "proxy server is required"},
+		{name: "server with rotate", args: map[string]any{"action": "rotate", "server": "proxy.example.com"}, wantErr: "server is only used with action set"},
+		{name: "unsupported scheme", args: map[string]any{"action": "set", "server": "ftp://proxy.example.com"}, wantErr: "unsupported proxy scheme"},
+		{name: "bypass not strings", args: map[string]any{"action": "set", "server": "proxy.example.com", "bypass": []any{1}}, wantErr: "bypass must contain only strings"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			tool := &SetProxyTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			_, err := tool.SetProxyHandler(context.Background(), tt.args)
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+		})
+	}
+
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+	mockPlaywright.SetProxyReturns(nil, errors.New("no proxies configured to rotate through"))
+	tool := &SetProxyTool{logger: zap.NewNop(), playwright: mockPlaywright}
+	_, err := tool.SetProxyHandler(context.Background(), map[string]any{"action": "rotate"})
+	assert.EqualError(t, err, "set proxy failed: no proxies configured to rotate through")
+}
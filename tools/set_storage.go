@@ -0,0 +1,112 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// SetStorageTool struct holds the tool with dependencies
+type SetStorageTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewSetStorageTool creates a new set_storage tool
+func NewSetStorageTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &SetStorageTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"set_storage",
+		"Change the localStorage or sessionStorage of the active tab's origin: clear it, remove keys and set items, in that order. Returns the entries afterwards. The page only notices on its next read, so reload it when it caches settings at startup",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"clear": map[string]any{
+					"default":     false,
+					"description": "Remove every entry first",
+					"type":        "boolean",
+				},
+				"items": map[string]any{
+					"additionalProperties": map[string]any{"type": "string"},
+					"description":          "Entries to set, as key to string value; serialize objects as JSON",
+					"type":                 "object",
+				},
+				"origin": map[string]any{
+					"description": "Origin the change is meant for, checked against the active tab's origin",
+					"type":        "string",
+				},
+				"remove": map[string]any{
+					"description": "Keys to remove",
+					"items":       map[string]any{"type": "string"},
+					"type":        "array",
+				},
+				"type": map[string]any{
+					"default":     defaultStorageType,
+					"description": "local for localStorage, session for sessionStorage",
+					"enum":        storageTypes,
+					"type":        "string",
+				},
+			},
+		},
+		tool.SetStorageHandler,
+	)
+}
+
+// SetStorageHandler handles the set_storage tool execution
+func (s *SetStorageTool) SetStorageHandler(ctx context.Context, args map[string]any) (string, error) {
+	storageType, origin, err := storageArgs(args)
+	if err != nil {
+		return "", err
+	}
+	items, err := stringMapArg(args, "items")
+	if err != nil {
+		return "", err
+	}
+	remove, err := stringSliceArg(args, "remove")
+	if err != nil {
+		return "", err
+	}
+	clear, err := boolArg(args, "clear", false)
+	if err != nil {
+		return "", err
+	}
+	if len(items) == 0 && len(remove) == 0 && !clear {
+		return "", fmt.Errorf("nothing to change: give items, remove or clear")
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	storage, err := s.playwright.SetStorage(ctx, session.ID, playwright.StorageUpdate{
+		Type:   storageType,
+		Origin: origin,
+		Set:    items,
+		Remove: remove,
+		Clear:  clear,
+	})
+	if err != nil {
+		s.logger.Error("failed to set storage", zap.String("sessionID", session.ID), zap.Error(err))
+		return "", fmt.Errorf("set storage failed: %w", err)
+	}
+
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"origin":     storage.Origin,
+		"type":       storage.Type,
+		"items":      storage.Items,
+		"count":      len(storage.Items),
+		"session_id": session.ID,
+		"message":    fmt.Sprintf("Updated %sStorage of %s", storage.Type, storage.Origin),
+	})
+}
@@ -0,0 +1,79 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+)
+
+func TestSetStorageTool_SetStorageHandler(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+	mockPlaywright.SetStorageReturns(&playwright.WebStorage{
+		Origin: "https://app.example.com",
+		Type:   playwright.SessionStorage,
+		Items:  map[string]string{"flags": `{"checkout":true}`},
+	}, nil)
+	tool := &SetStorageTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+	result, err := tool.SetStorageHandler(context.Background(), map[string]any{
+		"clear":  true,
+		"items":  map[string]any{"flags": `{"checkout":true}`},
+		"remove": []any{"cart"},
+		"type":   "session",
+	})
+	require.NoError(t, err)
+
+	var response map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result), &response))
+	assert.Equal(t, "Updated sessionStorage of https://app.example.com", response["message"])
+	assert.Equal(t, float64(1), response["count"])
+
+	_, sessionID, update := mockPlaywright.SetStorageArgsForCall(0)
+	assert.Equal(t, "task-1", sessionID)
+	assert.Equal(t, playwright.StorageUpdate{
+		Type:   playwright.SessionStorage,
+		Set:    map[string]string{"flags": `{"checkout":true}`},
+		Remove: []string{"cart"},
+		Clear:  true,
+	}, update)
+}
+
+func TestSetStorageTool_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    map[string]any
+		wantErr string
+	}{
+		{name: "nothing to change", args: map[string]any{}, wantErr: "nothing to change"},
+		{name: "invalid type", args: map[string]any{"type": "indexeddb", "clear": true}, wantErr: "invalid type value: indexeddb"},
+		{name: "non-string value", args: map[string]any{"items": map[string]any{"count": 3}}, wantErr: "items"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			tool := &SetStorageTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			_, err := tool.SetStorageHandler(context.Background(), tt.args)
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+		})
+	}
+
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "task-1"}, nil)
+	mockPlaywright.SetStorageReturns(nil, errors.New("the active tab has no web origin; navigate to the site first"))
+	tool := &SetStorageTool{logger: zap.NewNop(), playwright: mockPlaywright}
+	_, err := tool.SetStorageHandler(context.Background(), map[string]any{"clear": true})
+	assert.EqualError(t, err, "set storage failed: the active tab has no web origin; navigate to the site first")
+}
@@ -0,0 +1,71 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// SwitchTabTool struct holds the tool with dependencies
+type SwitchTabTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewSwitchTabTool creates a new switch_tab tool
+func NewSwitchTabTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &SwitchTabTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"switch_tab",
+		"Make another tab the active tab, so subsequent browser tools act on it",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"tab_id": map[string]any{
+					"description": "ID of the tab to activate, as returned by list_tabs (e.g. tab-2)",
+					"type":        "string",
+				},
+			},
+			"required": []string{"tab_id"},
+		},
+		tool.SwitchTabHandler,
+	)
+}
+
+// SwitchTabHandler handles the switch_tab tool execution
+func (s *SwitchTabTool) SwitchTabHandler(ctx context.Context, args map[string]any) (string, error) {
+	tabID, err := requiredString(args, "tab_id")
+	if err != nil {
+		return "", err
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	tab, err := s.playwright.SwitchTab(ctx, session.ID, tabID)
+	if err != nil {
+		s.logger.Error("failed to switch tab",
+			zap.String("sessionID", session.ID),
+			zap.String("tabID", tabID),
+			zap.Error(err))
+		return "", fmt.Errorf("failed to switch tab: %w", err)
+	}
+
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"tab":        tab,
+		"session_id": session.ID,
+		"message":    fmt.Sprintf("Switched to %s", tab.ID),
+	})
+}
@@ -10,7 +10,6 @@ import (
 	zap "go.uber.org/zap"
 
 	server "github.com/inference-gateway/adk/server"
-	types "github.com/inference-gateway/adk/types"
 
 	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
 )
@@ -200,43 +199,9 @@ func (s *TakeScreenshotTool) getCurrentTimestamp() string {
 
 // createArtifactFromScreenshot creates an artifact from the screenshot file
 func (s *TakeScreenshotTool) createArtifactFromScreenshot(ctx context.Context, filePath, imageType string) (url string, artifactID string, err error) {
-	task, ok := ctx.Value(server.TaskContextKey).(*types.Task)
-	if !ok {
-		return "", "", fmt.Errorf("task not found in context")
-	}
-
-	artifactService, ok := ctx.Value(server.ArtifactServiceContextKey).(server.ArtifactService)
-	if !ok || artifactService == nil {
-		return "", "", fmt.Errorf("artifact service not available")
-	}
-
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		return "", "", fmt.Errorf("failed to read screenshot file: %w", err)
-	}
-
-	mimeType := s.getMimeType(imageType)
-
 	filename := filepath.Base(filePath)
-	artifact, err := artifactService.CreateFileArtifact(
-		task.ContextID,
+	return createFileArtifact(ctx, filePath, filename,
 		fmt.Sprintf("Screenshot - %s", filename),
 		fmt.Sprintf("Screenshot captured at %s", s.getCurrentTimestamp()),
-		filename,
-		data,
-		&mimeType,
-	)
-	if err != nil {
-		return "", "", fmt.Errorf("failed to create artifact: %w", err)
-	}
-
-	artifactService.AddArtifactToTask(task, artifact)
-
-	if len(artifact.Parts) > 0 {
-		if artifact.Parts[0].File != nil && artifact.Parts[0].File.FileWithURI != nil {
-			return *artifact.Parts[0].File.FileWithURI, artifact.ArtifactID, nil
-		}
-	}
-
-	return "", artifact.ArtifactID, nil
+		s.getMimeType(imageType))
 }
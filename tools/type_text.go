@@ -0,0 +1,124 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// maxTypeTextLength bounds the characters type_text types in one call
+const maxTypeTextLength = 10000
+
+// TypeTextTool struct holds the tool with dependencies
+type TypeTextTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewTypeTextTool creates a new type_text tool
+func NewTypeTextTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &TypeTextTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"type_text",
+		"Type text one character at a time, with real key events, into the element matching selector or into the focused element. Use it for autocomplete inputs, search-as-you-type boxes and rich text editors that ignore fill_form; follow with press_keys to pick a suggestion or submit",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"delay": map[string]any{
+					"default":     0,
+					"description": "Time in milliseconds between characters; 50 to 100 suits widgets that debounce input",
+					"type":        "integer",
+				},
+				"frame": map[string]any{
+					"description": frameDescription,
+					"type":        "string",
+				},
+				"selector": map[string]any{
+					"description": "Element to focus and type into, or a ref from get_page_snapshot such as ref=e12",
+					"type":        "string",
+				},
+				"text": map[string]any{
+					"description": "Text to type; it is added at the cursor, so clear the field first with press_keys Control+A, Backspace if needed",
+					"type":        "string",
+				},
+				"timeout": map[string]any{
+					"default":     defaultTimeoutMs,
+					"description": "Maximum time in milliseconds to wait for the element, on top of the typing delay",
+					"type":        "integer",
+				},
+			},
+			"required": []string{"text"},
+		},
+		tool.TypeTextHandler,
+	)
+}
+
+// TypeTextHandler handles the type_text tool execution
+func (s *TypeTextTool) TypeTextHandler(ctx context.Context, args map[string]any) (string, error) {
+	text, err := requiredString(args, "text")
+	if err != nil {
+		return "", err
+	}
+	length := utf8.RuneCountInString(text)
+	if length > maxTypeTextLength {
+		return "", fmt.Errorf("text must be at most %d characters, got %d", maxTypeTextLength, length)
+	}
+
+	selector, err := stringArg(args, "selector", "")
+	if err != nil {
+		return "", err
+	}
+
+	frame, err := stringArg(args, "frame", "")
+	if err != nil {
+		return "", err
+	}
+
+	delay, err := boundedIntArg(args, "delay", 0, 0, maxKeyDelayMs)
+	if err != nil {
+		return "", err
+	}
+
+	timeout, err := boundedIntArg(args, "timeout", defaultTimeoutMs, minTimeoutMs, maxTimeoutMs)
+	if err != nil {
+		return "", err
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	err = s.playwright.TypeText(ctx, session.ID, text, playwright.KeyboardOptions{
+		Selector: selector,
+		Frame:    frame,
+		Delay:    time.Duration(delay) * time.Millisecond,
+		Timeout:  time.Duration(timeout) * time.Millisecond,
+	})
+	if err != nil {
+		s.logger.Error("type_text failed",
+			zap.String("sessionID", session.ID),
+			zap.String("selector", selector),
+			zap.Error(err))
+		return "", fmt.Errorf("type_text failed: %w", err)
+	}
+
+	return marshalResponse(map[string]any{
+		"success":    true,
+		"characters": length,
+		"selector":   selector,
+		"session_id": session.ID,
+		"message":    fmt.Sprintf("Typed %d characters", length),
+	})
+}
@@ -0,0 +1,113 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+
+	zap "go.uber.org/zap"
+
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+func TestTypeTextTool_TypeTextHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]any
+		setupMock     func(*mocks.FakeBrowserAutomation)
+		expectedError bool
+		errorContains string
+		verify        func(*testing.T, *mocks.FakeBrowserAutomation, map[string]any)
+	}{
+		{
+			name: "types into an element in a frame",
+			args: map[string]any{
+				"text":     "Zürich",
+				"selector": "ref=e4",
+				"frame":    "search",
+				"delay":    75,
+				"timeout":  5000,
+			},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+			},
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, response map[string]any) {
+				assert.Equal(t, float64(6), response["characters"], "characters are counted, not bytes")
+
+				_, sessionID, text, options := m.TypeTextArgsForCall(0)
+				assert.Equal(t, "session-1", sessionID)
+				assert.Equal(t, "Zürich", text)
+				assert.Equal(t, playwright.KeyboardOptions{
+					Selector: "ref=e4",
+					Frame:    "search",
+					Delay:    75 * time.Millisecond,
+					Timeout:  5 * time.Second,
+				}, options)
+			},
+		},
+		{
+			name:          "missing text",
+			args:          map[string]any{},
+			expectedError: true,
+			errorContains: "text parameter is required",
+		},
+		{
+			name:          "text too long",
+			args:          map[string]any{"text": strings.Repeat("a", maxTypeTextLength+1)},
+			expectedError: true,
+			errorContains: "at most",
+		},
+		{
+			name:          "negative delay",
+			args:          map[string]any{"text": "a", "delay": -1},
+			expectedError: true,
+			errorContains: "delay",
+		},
+		{
+			name: "element is not editable",
+			args: map[string]any{"text": "a", "selector": "h1"},
+			setupMock: func(m *mocks.FakeBrowserAutomation) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.TypeTextReturns(errors.New("element is not editable"))
+			},
+			expectedError: true,
+			errorContains: "not editable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			if tt.setupMock != nil {
+				tt.setupMock(mockPlaywright)
+			}
+			tool := &TypeTextTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			result, err := tool.TypeTextHandler(context.Background(), tt.args)
+
+			if tt.expectedError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.Empty(t, result)
+				if tt.setupMock == nil {
+					assert.Zero(t, mockPlaywright.GetOrCreateTaskSessionCallCount(), "invalid arguments are rejected before the service is called")
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			var response map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(result), &response), "response should be valid JSON")
+			assert.Equal(t, true, response["success"])
+			if tt.verify != nil {
+				tt.verify(t, mockPlaywright, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	envconfig "github.com/sethvargo/go-envconfig"
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// maxUploadBytes is the most Playwright accepts for the files of one input
+const maxUploadBytes = 50 << 20
+
+// uploadSources resolves the entries of a file field's files argument. Each
+// entry is the ID of an artifact of the current task or a local path.
+type uploadSources struct {
+	// read checks local paths against the Read tool's allowed roots, so
+	// the agent cannot upload what it could not read. Local paths are
+	// refused while the Read tool is disabled or has no allowed roots.
+	read *ReadTool
+	// denied are files and directories never uploaded, whatever the
+	// allowed roots: the credential vault, the state key and saved state
+	denied []string
+	// err disables local paths when their restrictions could not be loaded
+	err    error
+	client *http.Client
+}
+
+// newUploadSources restricts local paths like the Read tool and denies the
+// agent's own secrets, taken from the browser service's configuration. When
+// either configuration is unavailable, local paths are refused rather than
+// left unrestricted.
+func newUploadSources(logger *zap.Logger, automation playwright.BrowserAutomation) uploadSources {
+	var u uploadSources
+	var readCfg ReadConfig
+	if err := envconfig.Process(context.Background(), &readCfg); err != nil {
+		logger.Error("failed to load Read config; local file uploads are disabled", zap.Error(err))
+		u.err = fmt.Errorf("failed to load Read config: %w", err)
+	}
+	u.read = &ReadTool{logger: logger, cfg: readCfg}
+
+	cfg := automation.GetConfig()
+	if cfg == nil {
+		logger.Error("browser config is unavailable; local file uploads are disabled")
+		u.err = fmt.Errorf("browser config is unavailable")
+		return u
+	}
+	u.denied = playwright.SensitivePaths(cfg)
+	return u
+}
+
+// resolve loads every entry into memory, keeping the total under
+// maxUploadBytes
+func (u *uploadSources) resolve(ctx context.Context, entries []string) ([]playwright.UploadFile, error) {
+	files := make([]playwright.UploadFile, 0, len(entries))
+	total := 0
+	for _, entry := range entries {
+		var (
+			file playwright.UploadFile
+			err  error
+		)
+		if artifact := taskArtifact(ctx, entry); artifact != nil {
+			file, err = u.fromArtifact(ctx, artifact)
+		} else {
+			file, err = u.fromPath(entry)
+		}
+		if err != nil {
+			return nil, err
+		}
+		total += len(file.Buffer)
+		if total > maxUploadBytes {
+			return nil, fmt.Errorf("files exceed the %d MB upload limit", maxUploadBytes>>20)
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// fromPath reads a local file within the allowed roots of an enabled Read
+// tool
+func (u *uploadSources) fromPath(path string) (playwright.UploadFile, error) {
+	if u.err != nil {
+		return playwright.UploadFile{}, fmt.Errorf("local file uploads are disabled: %w", u.err)
+	}
+	absolute, err := filepath.Abs(path)
+	if err != nil {
+		return playwright.UploadFile{}, fmt.Errorf("invalid path %q: %w", path, err)
+	}
+	switch {
+	case u.read == nil || !u.read.cfg.Enabled:
+		return playwright.UploadFile{}, fmt.Errorf("local file uploads are disabled: the Read tool is disabled (TOOLS_READ_ENABLED)")
+	case len(u.read.cfg.AllowedRoots) == 0:
+		return playwright.UploadFile{}, fmt.Errorf("local file uploads are disabled: set TOOLS_READ_ALLOWED_ROOTS to the directories files may be uploaded from")
+	}
+	if err := u.read.validatePath(absolute); err != nil {
+		return playwright.UploadFile{}, err
+	}
+	if u.isDenied(absolute) {
+		return playwright.UploadFile{}, fmt.Errorf("%q holds credentials or browser state and cannot be uploaded", path)
+	}
+
+	info, err := os.Stat(absolute)
+	if err != nil {
+		return playwright.UploadFile{}, fmt.Errorf("%q is neither an artifact of this task nor a readable file: %w", path, err)
+	}
+	if info.IsDir() {
+		return playwright.UploadFile{}, fmt.Errorf("%q is a directory", path)
+	}
+	if info.Size() > maxUploadBytes {
+		return playwright.UploadFile{}, fmt.Errorf("%q exceeds the %d MB upload limit", path, maxUploadBytes>>20)
+	}
+
+	data, err := os.ReadFile(absolute)
+	if err != nil {
+		return playwright.UploadFile{}, fmt.Errorf("failed to read %q: %w", path, err)
+	}
+	return playwright.UploadFile{
+		Name:     filepath.Base(absolute),
+		MimeType: mime.TypeByExtension(filepath.Ext(absolute)),
+		Buffer:   data,
+	}, nil
+}
+
+// isDenied reports whether path is, or is inside, a denied path. Symbolic
+// links are resolved on both sides so a link cannot lead around the list.
+func (u *uploadSources) isDenied(path string) bool {
+	candidates := []string{path}
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		candidates = append(candidates, resolved)
+	}
+	for _, denied := range u.denied {
+		root, err := filepath.Abs(denied)
+		if err != nil {
+			continue
+		}
+		roots := []string{root}
+		if resolved, err := filepath.EvalSymlinks(denied); err == nil {
+			roots = append(roots, resolved)
+		}
+		for _, candidate := range candidates {
+			for _, root := range roots {
+				if candidate == root || strings.HasPrefix(candidate, root+string(filepath.Separator)) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// fromArtifact loads the first file part of an artifact, inline or from
+// its URI
+func (u *uploadSources) fromArtifact(ctx context.Context, artifact *types.Artifact) (playwright.UploadFile, error) {
+	for _, part := range artifact.Parts {
+		if part.File == nil {
+			continue
+		}
+		file := playwright.UploadFile{Name: part.File.Name, MimeType: part.File.MediaType}
+		if file.Name == "" {
+			file.Name = artifact.ArtifactID
+		}
+
+		switch {
+		case part.File.FileWithBytes != nil:
+			data, err := base64.StdEncoding.DecodeString(*part.File.FileWithBytes)
+			if err != nil {
+				return file, fmt.Errorf("artifact %s has invalid content: %w", artifact.ArtifactID, err)
+			}
+			file.Buffer = data
+		case part.File.FileWithURI != nil:
+			data, err := u.fetch(ctx, *part.File.FileWithURI)
+			if err != nil {
+				return file, fmt.Errorf("failed to load artifact %s: %w", artifact.ArtifactID, err)
+			}
+			file.Buffer = data
+		default:
+			continue
+		}
+		return file, nil
+	}
+	return playwright.UploadFile{}, fmt.Errorf("artifact %s has no file content", artifact.ArtifactID)
+}
+
+// fetch downloads an artifact from the artifacts server
+func (u *uploadSources) fetch(ctx context.Context, uri string) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := u.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = response.Body.Close() }()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", response.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(response.Body, maxUploadBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxUploadBytes {
+		return nil, fmt.Errorf("artifact exceeds the %d MB upload limit", maxUploadBytes>>20)
+	}
+	return data, nil
+}
+
+// taskArtifact returns the artifact of the task in ctx with the given ID,
+// or nil
+func taskArtifact(ctx context.Context, artifactID string) *types.Artifact {
+	task, ok := ctx.Value(server.TaskContextKey).(*types.Task)
+	if !ok || task == nil {
+		return nil
+	}
+	if artifactService, ok := ctx.Value(server.ArtifactServiceContextKey).(server.ArtifactService); ok && artifactService != nil {
+		if artifact, found := artifactService.GetArtifactByID(task, artifactID); found {
+			return artifact
+		}
+		return nil
+	}
+	for i := range task.Artifacts {
+		if task.Artifacts[i].ArtifactID == artifactID {
+			return &task.Artifacts[i]
+		}
+	}
+	return nil
+}
@@ -13,7 +13,7 @@ import (
 )
 
 var (
-	validWaitConditionTypes = []string{"selector", "navigation", "function", "timeout", "networkidle"}
+	validWaitConditionTypes = playwright.WaitConditions
 	validSelectorStates     = []string{"visible", "hidden", "attached", "detached"}
 )
 
@@ -31,25 +31,40 @@ func NewWaitForConditionTool(logger *zap.Logger, playwright playwright.BrowserAu
 	}
 	return server.NewBasicTool(
 		"wait_for_condition",
-		"Wait for specific conditions before proceeding with automation",
+		"Wait for specific conditions before proceeding with automation: an element, a navigation, a URL, a network response, text on the page, a script, network idle or a fixed time",
 		map[string]any{
 			"type": "object",
 			"properties": map[string]any{
 				"condition": map[string]any{
-					"description": "Type of condition (selector, navigation, function, timeout, networkidle)",
+					"description": "Type of condition: selector (an element reaches state), navigation (the page navigates to url_pattern and loads; without url_pattern only the load state is waited for), url (the URL matches url_pattern, including single-page app route changes), response (a response matching url_pattern and status arrives), text (text appears, or disappears with state hidden), function, timeout or networkidle",
+					"enum":        validWaitConditionTypes,
 					"type":        "string",
 				},
 				"custom_function": map[string]any{
 					"description": "Custom JavaScript function to evaluate for 'function' condition",
 					"type":        "string",
 				},
+				"frame": map[string]any{
+					"description": frameDescription,
+					"type":        "string",
+				},
 				"selector": map[string]any{
 					"description": "Selector to wait for if condition is 'selector'",
 					"type":        "string",
 				},
 				"state": map[string]any{
 					"default":     "visible",
-					"description": "State to wait for (visible, hidden, attached, detached)",
+					"description": "State to wait for (visible, hidden, attached, detached) for the selector and text conditions",
+					"type":        "string",
+				},
+				"status": map[string]any{
+					"description": "HTTP status the response condition waits for; any status when omitted",
+					"maximum":     maxHTTPStatus,
+					"minimum":     minHTTPStatus,
+					"type":        "integer",
+				},
+				"text": map[string]any{
+					"description": "Text to wait for if condition is 'text', matched as a case-insensitive substring",
 					"type":        "string",
 				},
 				"timeout": map[string]any{
@@ -57,6 +72,15 @@ func NewWaitForConditionTool(logger *zap.Logger, playwright playwright.BrowserAu
 					"description": "Maximum time to wait in milliseconds",
 					"type":        "integer",
 				},
+				"url_pattern": map[string]any{
+					"description": "URL to wait for with the navigation, url and response conditions - a glob such as **/checkout/*, a /regex/, or a substring of the URL",
+					"type":        "string",
+				},
+				"wait_until": map[string]any{
+					"default":     "load",
+					"description": "Load state the navigation condition waits for (domcontentloaded, load, networkidle)",
+					"type":        "string",
+				},
 			},
 			"required": []string{"condition"},
 		},
@@ -86,6 +110,11 @@ func (s *WaitForConditionTool) WaitForConditionHandler(ctx context.Context, args
 		return "", err
 	}
 
+	frame, err := stringArg(args, "frame", "")
+	if err != nil {
+		return "", err
+	}
+
 	state, err := stringArg(args, "state", "visible")
 	if err != nil {
 		return "", err
@@ -104,13 +133,50 @@ func (s *WaitForConditionTool) WaitForConditionHandler(ctx context.Context, args
 		return "", err
 	}
 
-	if err := validateConditionRequirements(condition, selector, customFunction); err != nil {
+	urlPattern, err := stringArg(args, "url_pattern", "")
+	if err != nil {
+		return "", err
+	}
+
+	text, err := stringArg(args, "text", "")
+	if err != nil {
+		return "", err
+	}
+
+	status := 0
+	if _, ok := args["status"]; ok {
+		if status, err = boundedIntArg(args, "status", 0, minHTTPStatus, maxHTTPStatus); err != nil {
+			return "", err
+		}
+	}
+
+	waitUntil, err := stringArg(args, "wait_until", "load")
+	if err != nil {
+		return "", err
+	}
+	if !oneOf(waitUntil, validWaitConditions...) {
+		return "", fmt.Errorf("invalid wait_until value: %s. Must be one of: %v", waitUntil, validWaitConditions)
+	}
+
+	options := playwright.WaitOptions{
+		Selector:       selector,
+		Frame:          frame,
+		State:          state,
+		CustomFunction: customFunction,
+		URLPattern:     urlPattern,
+		Status:         status,
+		Text:           text,
+		WaitUntil:      waitUntil,
+		Timeout:        time.Duration(timeout) * time.Millisecond,
+	}
+	if err := validateConditionRequirements(condition, options); err != nil {
 		return "", err
 	}
 
 	s.logger.Info("waiting for condition",
 		zap.String("condition", condition),
 		zap.String("selector", selector),
+		zap.String("url_pattern", urlPattern),
 		zap.String("state", state),
 		zap.Int("timeout_ms", timeout))
 
@@ -120,13 +186,14 @@ func (s *WaitForConditionTool) WaitForConditionHandler(ctx context.Context, args
 		return "", fmt.Errorf("failed to get browser session: %w", err)
 	}
 
-	timeoutDuration := time.Duration(timeout) * time.Millisecond
 	startTime := time.Now()
 
-	if err := s.playwright.WaitForCondition(ctx, session.ID, condition, selector, state, timeoutDuration, customFunction); err != nil {
+	result, err := s.playwright.WaitForCondition(ctx, session.ID, condition, options)
+	if err != nil {
 		s.logger.Error("wait condition failed",
 			zap.String("condition", condition),
 			zap.String("selector", selector),
+			zap.String("url_pattern", urlPattern),
 			zap.String("sessionID", session.ID),
 			zap.Error(err))
 		return "", fmt.Errorf("wait condition failed: %w", err)
@@ -139,7 +206,7 @@ func (s *WaitForConditionTool) WaitForConditionHandler(ctx context.Context, args
 		zap.String("sessionID", session.ID),
 		zap.Int64("actual_wait_ms", actualWaitTime))
 
-	return marshalResponse(map[string]any{
+	response := map[string]any{
 		"success":         true,
 		"condition":       condition,
 		"selector":        selector,
@@ -148,23 +215,44 @@ func (s *WaitForConditionTool) WaitForConditionHandler(ctx context.Context, args
 		"actual_wait_ms":  actualWaitTime,
 		"session_id":      session.ID,
 		"custom_function": customFunction,
+		"url":             result.URL,
 		"message":         "Wait condition completed successfully",
-	})
+	}
+	switch condition {
+	case playwright.WaitNavigation, playwright.WaitURL:
+		response["url_pattern"] = urlPattern
+		response["wait_until"] = waitUntil
+	case playwright.WaitResponse:
+		response["url_pattern"] = urlPattern
+		response["status"] = result.Status
+		response["message"] = fmt.Sprintf("Received HTTP %d from %s", result.Status, result.URL)
+	case playwright.WaitText:
+		response["text"] = text
+	}
+	return marshalResponse(response)
 }
 
 // validateConditionRequirements validates condition-specific requirements.
 // Standalone function (not a method) so it can be called from tests without
 // constructing a tool.
-func validateConditionRequirements(condition, selector, customFunction string) error {
+func validateConditionRequirements(condition string, options playwright.WaitOptions) error {
 	switch condition {
-	case "selector":
-		if selector == "" {
+	case playwright.WaitSelector:
+		if options.Selector == "" {
 			return fmt.Errorf("selector parameter is required for selector condition")
 		}
-	case "function":
-		if customFunction == "" {
+	case playwright.WaitFunction:
+		if options.CustomFunction == "" {
 			return fmt.Errorf("custom_function parameter is required for function condition")
 		}
+	case playwright.WaitText:
+		if options.Text == "" {
+			return fmt.Errorf("text parameter is required for text condition")
+		}
+	case playwright.WaitURL, playwright.WaitResponse:
+		if options.URLPattern == "" {
+			return fmt.Errorf("url_pattern parameter is required for %s condition", condition)
+		}
 	}
 	return nil
 }
@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	zap "go.uber.org/zap"
 
 	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
 
 	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
 
@@ -25,24 +27,29 @@ func TestWaitForConditionTool_NewWaitForConditionTool(t *testing.T) {
 
 func TestWaitForConditionTool_ValidateConditionRequirements(t *testing.T) {
 	tests := []struct {
-		name           string
-		condition      string
-		selector       string
-		customFunction string
-		shouldError    bool
+		name        string
+		condition   string
+		options     playwright.WaitOptions
+		shouldError bool
 	}{
-		{"selector with selector", "selector", ".button", "", false},
-		{"selector without selector", "selector", "", "", true},
-		{"function with function", "function", "", "() => true", false},
-		{"function without function", "function", "", "", true},
-		{"navigation no requirements", "navigation", "", "", false},
-		{"timeout no requirements", "timeout", "", "", false},
-		{"networkidle no requirements", "networkidle", "", "", false},
+		{"selector with selector", "selector", playwright.WaitOptions{Selector: ".button"}, false},
+		{"selector without selector", "selector", playwright.WaitOptions{}, true},
+		{"function with function", "function", playwright.WaitOptions{CustomFunction: "() => true"}, false},
+		{"function without function", "function", playwright.WaitOptions{}, true},
+		{"navigation no requirements", "navigation", playwright.WaitOptions{}, false},
+		{"timeout no requirements", "timeout", playwright.WaitOptions{}, false},
+		{"networkidle no requirements", "networkidle", playwright.WaitOptions{}, false},
+		{"text with text", "text", playwright.WaitOptions{Text: "Order placed"}, false},
+		{"text without text", "text", playwright.WaitOptions{}, true},
+		{"url with pattern", "url", playwright.WaitOptions{URLPattern: "**/dashboard"}, false},
+		{"url without pattern", "url", playwright.WaitOptions{}, true},
+		{"response with pattern", "response", playwright.WaitOptions{URLPattern: "/api/orders"}, false},
+		{"response without pattern", "response", playwright.WaitOptions{Status: 201}, true},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validateConditionRequirements(tt.condition, tt.selector, tt.customFunction)
+			err := validateConditionRequirements(tt.condition, tt.options)
 			if tt.shouldError {
 				assert.Error(t, err)
 			} else {
@@ -62,7 +69,7 @@ func TestWaitForConditionTool_WaitForConditionHandler_Success(t *testing.T) {
 
 	session := &playwright.BrowserSession{ID: "test-session"}
 	mockPlaywright.GetOrCreateTaskSessionReturns(session, nil)
-	mockPlaywright.WaitForConditionReturns(nil)
+	mockPlaywright.WaitForConditionReturns(&playwright.WaitResult{URL: "https://example.com"}, nil)
 
 	args := map[string]any{
 		"condition": "selector",
@@ -166,7 +173,7 @@ func TestWaitForConditionTool_WaitForConditionHandler_DefaultValues(t *testing.T
 
 	session := &playwright.BrowserSession{ID: "test-session"}
 	mockPlaywright.GetOrCreateTaskSessionReturns(session, nil)
-	mockPlaywright.WaitForConditionReturns(nil)
+	mockPlaywright.WaitForConditionReturns(&playwright.WaitResult{URL: "https://example.com"}, nil)
 
 	result, err := tool.WaitForConditionHandler(context.Background(), map[string]any{
 		"condition": "selector",
@@ -191,7 +198,7 @@ func TestWaitForConditionTool_NetworkidleDoesNotInjectJS(t *testing.T) {
 	mockPlaywright := &mocks.FakeBrowserAutomation{}
 	session := &playwright.BrowserSession{ID: "test-session"}
 	mockPlaywright.GetOrCreateTaskSessionReturns(session, nil)
-	mockPlaywright.WaitForConditionReturns(nil)
+	mockPlaywright.WaitForConditionReturns(&playwright.WaitResult{URL: "https://example.com"}, nil)
 
 	tool := &WaitForConditionTool{logger: logger, playwright: mockPlaywright}
 	_, err := tool.WaitForConditionHandler(context.Background(), map[string]any{
@@ -202,8 +209,81 @@ func TestWaitForConditionTool_NetworkidleDoesNotInjectJS(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 1, mockPlaywright.WaitForConditionCallCount())
 
-	_, _, gotCondition, _, _, _, gotCustomFn := mockPlaywright.WaitForConditionArgsForCall(0)
+	_, _, gotCondition, gotOptions := mockPlaywright.WaitForConditionArgsForCall(0)
 	assert.Equal(t, "networkidle", gotCondition,
 		"networkidle should pass through as the condition name, not be rewritten to 'function'")
-	assert.Empty(t, gotCustomFn, "no custom JS should be injected for networkidle")
+	assert.Empty(t, gotOptions.CustomFunction, "no custom JS should be injected for networkidle")
+}
+
+func TestWaitForConditionTool_ResponseCondition(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "test-session"}, nil)
+	mockPlaywright.WaitForConditionReturns(&playwright.WaitResult{URL: "https://shop.example/api/orders", Status: 201}, nil)
+	tool := &WaitForConditionTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+	result, err := tool.WaitForConditionHandler(context.Background(), map[string]any{
+		"condition":   "response",
+		"url_pattern": "**/api/orders",
+		"status":      201,
+		"timeout":     5000,
+	})
+	require.NoError(t, err)
+
+	var parsed map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result), &parsed))
+	assert.Equal(t, "https://shop.example/api/orders", parsed["url"])
+	assert.Equal(t, float64(201), parsed["status"])
+	assert.Equal(t, "Received HTTP 201 from https://shop.example/api/orders", parsed["message"])
+
+	_, sessionID, condition, options := mockPlaywright.WaitForConditionArgsForCall(0)
+	assert.Equal(t, "test-session", sessionID)
+	assert.Equal(t, "response", condition)
+	assert.Equal(t, "**/api/orders", options.URLPattern)
+	assert.Equal(t, 201, options.Status)
+	assert.Equal(t, 5*time.Second, options.Timeout)
+}
+
+func TestWaitForConditionTool_NavigationCondition(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "test-session"}, nil)
+	mockPlaywright.WaitForConditionReturns(&playwright.WaitResult{URL: "https://shop.example/checkout/done"}, nil)
+	tool := &WaitForConditionTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+	result, err := tool.WaitForConditionHandler(context.Background(), map[string]any{
+		"condition":   "navigation",
+		"url_pattern": "**/checkout/*",
+		"wait_until":  "domcontentloaded",
+	})
+	require.NoError(t, err)
+
+	var parsed map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result), &parsed))
+	assert.Equal(t, "https://shop.example/checkout/done", parsed["url"])
+
+	_, _, _, options := mockPlaywright.WaitForConditionArgsForCall(0)
+	assert.Equal(t, "**/checkout/*", options.URLPattern)
+	assert.Equal(t, "domcontentloaded", options.WaitUntil)
+}
+
+func TestWaitForConditionTool_InvalidNewArguments(t *testing.T) {
+	mockPlaywright := &mocks.FakeBrowserAutomation{}
+	mockPlaywright.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "test-session"}, nil)
+	tool := &WaitForConditionTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+	_, err := tool.WaitForConditionHandler(context.Background(), map[string]any{"condition": "text"})
+	assert.ErrorContains(t, err, "text parameter is required")
+
+	_, err = tool.WaitForConditionHandler(context.Background(), map[string]any{"condition": "url"})
+	assert.ErrorContains(t, err, "url_pattern parameter is required")
+
+	_, err = tool.WaitForConditionHandler(context.Background(), map[string]any{
+		"condition": "response", "url_pattern": "/api", "status": 42,
+	})
+	assert.ErrorContains(t, err, "status must be between 100 and 599")
+
+	_, err = tool.WaitForConditionHandler(context.Background(), map[string]any{
+		"condition": "navigation", "wait_until": "commit",
+	})
+	assert.ErrorContains(t, err, "invalid wait_until")
+	assert.Equal(t, 0, mockPlaywright.WaitForConditionCallCount(), "invalid arguments are rejected before the service is called")
 }
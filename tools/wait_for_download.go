@@ -0,0 +1,127 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+// WaitForDownloadTool struct holds the tool with dependencies
+type WaitForDownloadTool struct {
+	logger     *zap.Logger
+	playwright playwright.BrowserAutomation
+}
+
+// NewWaitForDownloadTool creates a new wait_for_download tool
+func NewWaitForDownloadTool(logger *zap.Logger, playwright playwright.BrowserAutomation) server.Tool {
+	tool := &WaitForDownloadTool{
+		logger:     logger,
+		playwright: playwright,
+	}
+	return server.NewBasicTool(
+		"wait_for_download",
+		"Click an element that starts a file download (an export button, a download link) and wait for the file. The file is saved for the task and returned as a downloadable artifact, with its size, MIME type and sha256. Without a selector, waits for a download the page starts by itself",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"frame": map[string]any{
+					"description": frameDescription,
+					"type":        "string",
+				},
+				"selector": map[string]any{
+					"description": "Element to click to start the download",
+					"type":        "string",
+				},
+				"timeout": map[string]any{
+					"default":     defaultTimeoutMs,
+					"description": "Maximum time in milliseconds for the download to start and finish",
+					"type":        "integer",
+				},
+			},
+		},
+		tool.WaitForDownloadHandler,
+	)
+}
+
+// WaitForDownloadHandler handles the wait_for_download tool execution
+func (s *WaitForDownloadTool) WaitForDownloadHandler(ctx context.Context, args map[string]any) (string, error) {
+	selector, err := stringArg(args, "selector", "")
+	if err != nil {
+		return "", err
+	}
+
+	frame, err := stringArg(args, "frame", "")
+	if err != nil {
+		return "", err
+	}
+
+	timeout, err := boundedIntArg(args, "timeout", defaultTimeoutMs, minTimeoutMs, maxTimeoutMs)
+	if err != nil {
+		return "", err
+	}
+
+	session, err := s.playwright.GetOrCreateTaskSession(ctx)
+	if err != nil {
+		s.logger.Error("failed to get browser session", zap.Error(err))
+		return "", fmt.Errorf("failed to get browser session: %w", err)
+	}
+
+	download, err := s.playwright.WaitForDownload(ctx, session.ID, playwright.DownloadOptions{
+		Selector: selector,
+		Frame:    frame,
+		Timeout:  time.Duration(timeout) * time.Millisecond,
+	})
+	if err != nil {
+		s.logger.Error("download failed",
+			zap.String("sessionID", session.ID),
+			zap.String("selector", selector),
+			zap.Error(err))
+		return "", fmt.Errorf("download failed: %w", err)
+	}
+
+	response := map[string]any{
+		"success":            true,
+		"path":               download.Path,
+		"filename":           download.Filename,
+		"suggested_filename": download.SuggestedFilename,
+		"source_url":         download.URL,
+		"size":               download.Size,
+		"mime_type":          download.MimeType,
+		"sha256":             download.SHA256,
+		"session_id":         session.ID,
+	}
+	if download.TabID != "" {
+		response["tab_id"] = download.TabID
+	}
+
+	artifactURL, artifactID, err := createFileArtifact(ctx, download.Path, download.Filename,
+		fmt.Sprintf("Download - %s", download.Filename),
+		fmt.Sprintf("Downloaded from %s at %s", download.URL, time.Now().Format(time.RFC3339)),
+		download.MimeType)
+	if err != nil {
+		s.logger.Debug("artifact creation skipped or failed, returning file path only",
+			zap.Error(err),
+			zap.String("path", download.Path))
+		response["message"] = fmt.Sprintf("Downloaded %s (%d bytes) to %s", download.Filename, download.Size, download.Path)
+		return marshalResponse(response)
+	}
+
+	// The artifact holds the file now, so the local copy goes with the
+	// session; downloads only returned by path above are kept
+	if err := s.playwright.DownloadStored(ctx, session.ID, download.Path); err != nil {
+		s.logger.Warn("failed to mark download as stored",
+			zap.Error(err),
+			zap.String("path", download.Path))
+	}
+
+	response["artifact_id"] = artifactID
+	response["url"] = artifactURL
+	response["message"] = fmt.Sprintf("Downloaded %s (%d bytes). Download URL: %s", download.Filename, download.Size, artifactURL)
+	return marshalResponse(response)
+}
@@ -0,0 +1,153 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	adkmocks "github.com/inference-gateway/adk/server/mocks"
+	types "github.com/inference-gateway/adk/types"
+
+	mocks "github.com/inference-gateway/browser-agent/internal/playwright/mocks"
+
+	playwright "github.com/inference-gateway/browser-agent/internal/playwright"
+)
+
+func TestWaitForDownloadTool_WaitForDownloadHandler(t *testing.T) {
+	uri := "http://localhost:8081/artifacts/ctx-1/report.csv"
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		setupMock     func(m *mocks.FakeBrowserAutomation, dir string)
+		withArtifacts bool
+		expectedError bool
+		errorContains string
+		verify        func(t *testing.T, m *mocks.FakeBrowserAutomation, artifacts *adkmocks.FakeArtifactService, dir string, response map[string]any)
+	}{
+		{
+			name: "download stored as a task artifact",
+			args: map[string]any{"selector": "#export", "timeout": 5000},
+			setupMock: func(m *mocks.FakeBrowserAutomation, dir string) {
+				path := filepath.Join(dir, "report.csv")
+				require.NoError(t, os.WriteFile(path, []byte("id,total\n1,9.50\n"), 0644))
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.WaitForDownloadReturns(&playwright.Download{
+					Path:              path,
+					Filename:          "report.csv",
+					SuggestedFilename: "report.csv",
+					URL:               "https://shop.example.com/export",
+					Size:              16,
+					MimeType:          "text/csv",
+					SHA256:            "abc123",
+				}, nil)
+			},
+			withArtifacts: true,
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, artifacts *adkmocks.FakeArtifactService, dir string, response map[string]any) {
+				assert.Equal(t, "artifact-1", response["artifact_id"])
+				assert.Equal(t, uri, response["url"])
+				assert.Equal(t, "abc123", response["sha256"])
+				assert.Equal(t, "text/csv", response["mime_type"])
+				assert.Equal(t, float64(16), response["size"])
+
+				_, sessionID, options := m.WaitForDownloadArgsForCall(0)
+				assert.Equal(t, "session-1", sessionID)
+				assert.Equal(t, playwright.DownloadOptions{Selector: "#export", Timeout: 5 * time.Second}, options)
+
+				contextID, _, _, filename, data, mimeType := artifacts.CreateFileArtifactArgsForCall(0)
+				assert.Equal(t, "ctx-1", contextID)
+				assert.Equal(t, "report.csv", filename)
+				assert.Equal(t, "id,total\n1,9.50\n", string(data))
+				assert.Equal(t, "text/csv", *mimeType)
+				assert.Equal(t, 1, artifacts.AddArtifactToTaskCallCount())
+
+				require.Equal(t, 1, m.DownloadStoredCallCount(), "the stored file is deleted with the session")
+				_, sessionID, path := m.DownloadStoredArgsForCall(0)
+				assert.Equal(t, "session-1", sessionID)
+				assert.Equal(t, filepath.Join(dir, "report.csv"), path)
+			},
+		},
+		{
+			name: "download without an artifact service",
+			args: map[string]any{},
+			setupMock: func(m *mocks.FakeBrowserAutomation, dir string) {
+				path := filepath.Join(dir, "invoice.pdf")
+				require.NoError(t, os.WriteFile(path, []byte("%PDF-1.7"), 0644))
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.WaitForDownloadReturns(&playwright.Download{Path: path, Filename: "invoice.pdf", Size: 8}, nil)
+			},
+			verify: func(t *testing.T, m *mocks.FakeBrowserAutomation, _ *adkmocks.FakeArtifactService, dir string, response map[string]any) {
+				assert.Contains(t, response["message"], "Downloaded invoice.pdf (8 bytes) to "+filepath.Join(dir, "invoice.pdf"))
+				assert.NotContains(t, response, "artifact_id")
+				assert.Zero(t, m.DownloadStoredCallCount(), "a file only returned by path is kept")
+			},
+		},
+		{
+			name:          "timeout out of range",
+			args:          map[string]any{"timeout": 0},
+			expectedError: true,
+			errorContains: "timeout",
+		},
+		{
+			name: "no download started",
+			args: map[string]any{"selector": "#export"},
+			setupMock: func(m *mocks.FakeBrowserAutomation, _ string) {
+				m.GetOrCreateTaskSessionReturns(&playwright.BrowserSession{ID: "session-1"}, nil)
+				m.WaitForDownloadReturns(nil, errors.New("no download started within 1s"))
+			},
+			expectedError: true,
+			errorContains: "no download started",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			mockPlaywright := &mocks.FakeBrowserAutomation{}
+			if tt.setupMock != nil {
+				tt.setupMock(mockPlaywright, dir)
+			}
+			tool := &WaitForDownloadTool{logger: zap.NewNop(), playwright: mockPlaywright}
+
+			ctx := context.Background()
+			artifactService := &adkmocks.FakeArtifactService{}
+			if tt.withArtifacts {
+				artifactService.CreateFileArtifactReturns(types.Artifact{
+					ArtifactID: "artifact-1",
+					Parts:      []types.Part{{File: &types.FilePart{FileWithURI: &uri}}},
+				}, nil)
+				ctx = context.WithValue(ctx, server.TaskContextKey, &types.Task{ID: "task-1", ContextID: "ctx-1"})
+				ctx = context.WithValue(ctx, server.ArtifactServiceContextKey, server.ArtifactService(artifactService))
+			}
+
+			result, err := tool.WaitForDownloadHandler(ctx, tt.args)
+
+			if tt.expectedError {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.Empty(t, result)
+				if tt.setupMock == nil {
+					assert.Zero(t, mockPlaywright.WaitForDownloadCallCount(), "invalid arguments are rejected before the service is called")
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			var response map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(result), &response), "response should be valid JSON")
+			assert.Equal(t, true, response["success"])
+			if tt.verify != nil {
+				tt.verify(t, mockPlaywright, artifactService, dir, response)
+			}
+		})
+	}
+}